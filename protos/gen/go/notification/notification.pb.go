@@ -26,6 +26,10 @@ type NotifyPositionSoonRequest struct {
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	QueueTitle    string                 `protobuf:"bytes,2,opt,name=queue_title,json=queueTitle,proto3" json:"queue_title,omitempty"`
 	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	QueueId       int64                  `protobuf:"varint,4,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	ParticipantId int64                  `protobuf:"varint,5,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // queue entry; a new entry resets what was notified before
+	NotifyTop     int32                  `protobuf:"varint,6,opt,name=notify_top,json=notifyTop,proto3" json:"notify_top,omitempty"`             // head size of the queue, default 3
+	Added         bool                   `protobuf:"varint,7,opt,name=added,proto3" json:"added,omitempty"`                                      // participant was just added by a moderator
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,27 +85,2340 @@ func (x *NotifyPositionSoonRequest) GetPosition() int32 {
 	return 0
 }
 
+func (x *NotifyPositionSoonRequest) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *NotifyPositionSoonRequest) GetParticipantId() int64 {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return 0
+}
+
+func (x *NotifyPositionSoonRequest) GetNotifyTop() int32 {
+	if x != nil {
+		return x.NotifyTop
+	}
+	return 0
+}
+
+func (x *NotifyPositionSoonRequest) GetAdded() bool {
+	if x != nil {
+		return x.Added
+	}
+	return false
+}
+
 type NotifyPositionSoonResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	NotificationIds []int64                `protobuf:"varint,2,rep,packed,name=notification_ids,json=notificationIds,proto3" json:"notification_ids,omitempty"` // one per channel, empty if nothing changed for the user
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NotifyPositionSoonResponse) Reset() {
+	*x = NotifyPositionSoonResponse{}
+	mi := &file_notification_notification_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyPositionSoonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyPositionSoonResponse) ProtoMessage() {}
+
+func (x *NotifyPositionSoonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyPositionSoonResponse.ProtoReflect.Descriptor instead.
+func (*NotifyPositionSoonResponse) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NotifyPositionSoonResponse) GetNotificationIds() []int64 {
+	if x != nil {
+		return x.NotificationIds
+	}
+	return nil
+}
+
+type NotifyQueueOpenedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []int64                `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	QueueTitle    string                 `protobuf:"bytes,2,opt,name=queue_title,json=queueTitle,proto3" json:"queue_title,omitempty"`
+	QueueId       int64                  `protobuf:"varint,3,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifyQueueOpenedRequest) Reset() {
+	*x = NotifyQueueOpenedRequest{}
+	mi := &file_notification_notification_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyQueueOpenedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyQueueOpenedRequest) ProtoMessage() {}
+
+func (x *NotifyQueueOpenedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyQueueOpenedRequest.ProtoReflect.Descriptor instead.
+func (*NotifyQueueOpenedRequest) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NotifyQueueOpenedRequest) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *NotifyQueueOpenedRequest) GetQueueTitle() string {
+	if x != nil {
+		return x.QueueTitle
+	}
+	return ""
+}
+
+func (x *NotifyQueueOpenedRequest) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+type NotifyQueueOpenedResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	NotificationIds []int64                `protobuf:"varint,1,rep,packed,name=notification_ids,json=notificationIds,proto3" json:"notification_ids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NotifyQueueOpenedResponse) Reset() {
+	*x = NotifyQueueOpenedResponse{}
+	mi := &file_notification_notification_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyQueueOpenedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyQueueOpenedResponse) ProtoMessage() {}
+
+func (x *NotifyQueueOpenedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyQueueOpenedResponse.ProtoReflect.Descriptor instead.
+func (*NotifyQueueOpenedResponse) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *NotifyQueueOpenedResponse) GetNotificationIds() []int64 {
+	if x != nil {
+		return x.NotificationIds
+	}
+	return nil
+}
+
+type NotifyQueueArchivedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []int64                `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	QueueTitle    string                 `protobuf:"bytes,2,opt,name=queue_title,json=queueTitle,proto3" json:"queue_title,omitempty"`
+	QueueId       int64                  `protobuf:"varint,3,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifyQueueArchivedRequest) Reset() {
+	*x = NotifyQueueArchivedRequest{}
+	mi := &file_notification_notification_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyQueueArchivedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyQueueArchivedRequest) ProtoMessage() {}
+
+func (x *NotifyQueueArchivedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyQueueArchivedRequest.ProtoReflect.Descriptor instead.
+func (*NotifyQueueArchivedRequest) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NotifyQueueArchivedRequest) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *NotifyQueueArchivedRequest) GetQueueTitle() string {
+	if x != nil {
+		return x.QueueTitle
+	}
+	return ""
+}
+
+func (x *NotifyQueueArchivedRequest) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+type NotifyQueueArchivedResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	NotificationIds []int64                `protobuf:"varint,1,rep,packed,name=notification_ids,json=notificationIds,proto3" json:"notification_ids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NotifyQueueArchivedResponse) Reset() {
+	*x = NotifyQueueArchivedResponse{}
+	mi := &file_notification_notification_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyQueueArchivedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyQueueArchivedResponse) ProtoMessage() {}
+
+func (x *NotifyQueueArchivedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyQueueArchivedResponse.ProtoReflect.Descriptor instead.
+func (*NotifyQueueArchivedResponse) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *NotifyQueueArchivedResponse) GetNotificationIds() []int64 {
+	if x != nil {
+		return x.NotificationIds
+	}
+	return nil
+}
+
+type NotifyParticipantRemovedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	QueueId       int64                  `protobuf:"varint,2,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	QueueTitle    string                 `protobuf:"bytes,3,opt,name=queue_title,json=queueTitle,proto3" json:"queue_title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifyParticipantRemovedRequest) Reset() {
+	*x = NotifyParticipantRemovedRequest{}
+	mi := &file_notification_notification_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyParticipantRemovedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyParticipantRemovedRequest) ProtoMessage() {}
+
+func (x *NotifyParticipantRemovedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyParticipantRemovedRequest.ProtoReflect.Descriptor instead.
+func (*NotifyParticipantRemovedRequest) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *NotifyParticipantRemovedRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *NotifyParticipantRemovedRequest) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *NotifyParticipantRemovedRequest) GetQueueTitle() string {
+	if x != nil {
+		return x.QueueTitle
+	}
+	return ""
+}
+
+type NotifyParticipantRemovedResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	NotificationIds []int64                `protobuf:"varint,1,rep,packed,name=notification_ids,json=notificationIds,proto3" json:"notification_ids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NotifyParticipantRemovedResponse) Reset() {
+	*x = NotifyParticipantRemovedResponse{}
+	mi := &file_notification_notification_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyParticipantRemovedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyParticipantRemovedResponse) ProtoMessage() {}
+
+func (x *NotifyParticipantRemovedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyParticipantRemovedResponse.ProtoReflect.Descriptor instead.
+func (*NotifyParticipantRemovedResponse) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *NotifyParticipantRemovedResponse) GetNotificationIds() []int64 {
+	if x != nil {
+		return x.NotificationIds
+	}
+	return nil
+}
+
+type NotifyCalledRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	QueueId        int64                  `protobuf:"varint,2,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	QueueTitle     string                 `protobuf:"bytes,3,opt,name=queue_title,json=queueTitle,proto3" json:"queue_title,omitempty"`
+	TimeoutSeconds int32                  `protobuf:"varint,4,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // time to check in
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NotifyCalledRequest) Reset() {
+	*x = NotifyCalledRequest{}
+	mi := &file_notification_notification_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyCalledRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyCalledRequest) ProtoMessage() {}
+
+func (x *NotifyCalledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyCalledRequest.ProtoReflect.Descriptor instead.
+func (*NotifyCalledRequest) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *NotifyCalledRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *NotifyCalledRequest) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *NotifyCalledRequest) GetQueueTitle() string {
+	if x != nil {
+		return x.QueueTitle
+	}
+	return ""
+}
+
+func (x *NotifyCalledRequest) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+type NotifyCalledResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	NotificationIds []int64                `protobuf:"varint,1,rep,packed,name=notification_ids,json=notificationIds,proto3" json:"notification_ids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NotifyCalledResponse) Reset() {
+	*x = NotifyCalledResponse{}
+	mi := &file_notification_notification_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyCalledResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyCalledResponse) ProtoMessage() {}
+
+func (x *NotifyCalledResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyCalledResponse.ProtoReflect.Descriptor instead.
+func (*NotifyCalledResponse) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *NotifyCalledResponse) GetNotificationIds() []int64 {
+	if x != nil {
+		return x.NotificationIds
+	}
+	return nil
+}
+
+type NotifySwapRequestedRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // receiver of the request
+	QueueId        int64                  `protobuf:"varint,2,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	QueueTitle     string                 `protobuf:"bytes,3,opt,name=queue_title,json=queueTitle,proto3" json:"queue_title,omitempty"`
+	RequestId      int64                  `protobuf:"varint,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	FromName       string                 `protobuf:"bytes,5,opt,name=from_name,json=fromName,proto3" json:"from_name,omitempty"`
+	Position       int32                  `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`                                   // the requester's place the receiver would take
+	TimeoutSeconds int32                  `protobuf:"varint,7,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // time to answer
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NotifySwapRequestedRequest) Reset() {
+	*x = NotifySwapRequestedRequest{}
+	mi := &file_notification_notification_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifySwapRequestedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifySwapRequestedRequest) ProtoMessage() {}
+
+func (x *NotifySwapRequestedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifySwapRequestedRequest.ProtoReflect.Descriptor instead.
+func (*NotifySwapRequestedRequest) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *NotifySwapRequestedRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *NotifySwapRequestedRequest) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *NotifySwapRequestedRequest) GetQueueTitle() string {
+	if x != nil {
+		return x.QueueTitle
+	}
+	return ""
+}
+
+func (x *NotifySwapRequestedRequest) GetRequestId() int64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *NotifySwapRequestedRequest) GetFromName() string {
+	if x != nil {
+		return x.FromName
+	}
+	return ""
+}
+
+func (x *NotifySwapRequestedRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *NotifySwapRequestedRequest) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+type NotifySwapRequestedResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	NotificationIds []int64                `protobuf:"varint,1,rep,packed,name=notification_ids,json=notificationIds,proto3" json:"notification_ids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NotifySwapRequestedResponse) Reset() {
+	*x = NotifySwapRequestedResponse{}
+	mi := &file_notification_notification_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifySwapRequestedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifySwapRequestedResponse) ProtoMessage() {}
+
+func (x *NotifySwapRequestedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifySwapRequestedResponse.ProtoReflect.Descriptor instead.
+func (*NotifySwapRequestedResponse) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *NotifySwapRequestedResponse) GetNotificationIds() []int64 {
+	if x != nil {
+		return x.NotificationIds
+	}
+	return nil
+}
+
+type NotifySwapAnsweredRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // requester
+	QueueId       int64                  `protobuf:"varint,2,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	QueueTitle    string                 `protobuf:"bytes,3,opt,name=queue_title,json=queueTitle,proto3" json:"queue_title,omitempty"`
+	Accepted      bool                   `protobuf:"varint,4,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Position      int32                  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"` // the requester's new place if accepted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifySwapAnsweredRequest) Reset() {
+	*x = NotifySwapAnsweredRequest{}
+	mi := &file_notification_notification_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifySwapAnsweredRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifySwapAnsweredRequest) ProtoMessage() {}
+
+func (x *NotifySwapAnsweredRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifySwapAnsweredRequest.ProtoReflect.Descriptor instead.
+func (*NotifySwapAnsweredRequest) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *NotifySwapAnsweredRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *NotifySwapAnsweredRequest) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *NotifySwapAnsweredRequest) GetQueueTitle() string {
+	if x != nil {
+		return x.QueueTitle
+	}
+	return ""
+}
+
+func (x *NotifySwapAnsweredRequest) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *NotifySwapAnsweredRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type NotifySwapAnsweredResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	NotificationIds []int64                `protobuf:"varint,1,rep,packed,name=notification_ids,json=notificationIds,proto3" json:"notification_ids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NotifySwapAnsweredResponse) Reset() {
+	*x = NotifySwapAnsweredResponse{}
+	mi := &file_notification_notification_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifySwapAnsweredResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifySwapAnsweredResponse) ProtoMessage() {}
+
+func (x *NotifySwapAnsweredResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifySwapAnsweredResponse.ProtoReflect.Descriptor instead.
+func (*NotifySwapAnsweredResponse) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *NotifySwapAnsweredResponse) GetNotificationIds() []int64 {
+	if x != nil {
+		return x.NotificationIds
+	}
+	return nil
+}
+
+type SetContactRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TelegramUsername string                 `protobuf:"bytes,2,opt,name=telegram_username,json=telegramUsername,proto3" json:"telegram_username,omitempty"` // without @
+	ChatId           string                 `protobuf:"bytes,3,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`                               // optional explicit chat_id
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SetContactRequest) Reset() {
+	*x = SetContactRequest{}
+	mi := &file_notification_notification_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetContactRequest) ProtoMessage() {}
+
+func (x *SetContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetContactRequest.ProtoReflect.Descriptor instead.
+func (*SetContactRequest) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetContactRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetContactRequest) GetTelegramUsername() string {
+	if x != nil {
+		return x.TelegramUsername
+	}
+	return ""
+}
+
+func (x *SetContactRequest) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+type SetContactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetContactResponse) Reset() {
+	*x = SetContactResponse{}
+	mi := &file_notification_notification_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetContactResponse) ProtoMessage() {}
+
+func (x *SetContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetContactResponse.ProtoReflect.Descriptor instead.
+func (*SetContactResponse) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{15}
+}
+
+type GetContactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetContactRequest) Reset() {
+	*x = GetContactRequest{}
+	mi := &file_notification_notification_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactRequest) ProtoMessage() {}
+
+func (x *GetContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContactRequest.ProtoReflect.Descriptor instead.
+func (*GetContactRequest) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetContactRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ContactDTO struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TelegramUsername string                 `protobuf:"bytes,2,opt,name=telegram_username,json=telegramUsername,proto3" json:"telegram_username,omitempty"`
+	ChatLinked       bool                   `protobuf:"varint,3,opt,name=chat_linked,json=chatLinked,proto3" json:"chat_linked,omitempty"` // chat is bound through the bot, messages can be delivered
+	UpdatedAt        int64                  `protobuf:"varint,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`    // unix seconds
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ContactDTO) Reset() {
+	*x = ContactDTO{}
+	mi := &file_notification_notification_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContactDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContactDTO) ProtoMessage() {}
+
+func (x *ContactDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContactDTO.ProtoReflect.Descriptor instead.
+func (*ContactDTO) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ContactDTO) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ContactDTO) GetTelegramUsername() string {
+	if x != nil {
+		return x.TelegramUsername
+	}
+	return ""
+}
+
+func (x *ContactDTO) GetChatLinked() bool {
+	if x != nil {
+		return x.ChatLinked
+	}
+	return false
+}
+
+func (x *ContactDTO) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type GetContactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contact       *ContactDTO            `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetContactResponse) Reset() {
+	*x = GetContactResponse{}
+	mi := &file_notification_notification_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactResponse) ProtoMessage() {}
+
+func (x *GetContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContactResponse.ProtoReflect.Descriptor instead.
+func (*GetContactResponse) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetContactResponse) GetContact() *ContactDTO {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+type UnlinkContactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkContactRequest) Reset() {
+	*x = UnlinkContactRequest{}
+	mi := &file_notification_notification_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkContactRequest) ProtoMessage() {}
+
+func (x *UnlinkContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkContactRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkContactRequest) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UnlinkContactRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type UnlinkContactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkContactResponse) Reset() {
+	*x = UnlinkContactResponse{}
+	mi := &file_notification_notification_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkContactResponse) ProtoMessage() {}
+
+func (x *UnlinkContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkContactResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkContactResponse) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{20}
+}
+
+type CreateLinkTokenRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TelegramUsername string                 `protobuf:"bytes,2,opt,name=telegram_username,json=telegramUsername,proto3" json:"telegram_username,omitempty"` // optional, prefilled username
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateLinkTokenRequest) Reset() {
+	*x = CreateLinkTokenRequest{}
+	mi := &file_notification_notification_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLinkTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLinkTokenRequest) ProtoMessage() {}
+
+func (x *CreateLinkTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLinkTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateLinkTokenRequest) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateLinkTokenRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateLinkTokenRequest) GetTelegramUsername() string {
+	if x != nil {
+		return x.TelegramUsername
+	}
+	return ""
+}
+
+type CreateLinkTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Link          string                 `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`                             // https://t.me/<bot>?start=<token> if bot name known
+	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // unix seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLinkTokenResponse) Reset() {
+	*x = CreateLinkTokenResponse{}
+	mi := &file_notification_notification_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLinkTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLinkTokenResponse) ProtoMessage() {}
+
+func (x *CreateLinkTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLinkTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateLinkTokenResponse) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateLinkTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateLinkTokenResponse) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *CreateLinkTokenResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type BindByTokenRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Token            string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ChatId           string                 `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	TelegramUsername string                 `protobuf:"bytes,3,opt,name=telegram_username,json=telegramUsername,proto3" json:"telegram_username,omitempty"` // from message.from.username, optional
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BindByTokenRequest) Reset() {
+	*x = BindByTokenRequest{}
+	mi := &file_notification_notification_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BindByTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BindByTokenRequest) ProtoMessage() {}
+
+func (x *BindByTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BindByTokenRequest.ProtoReflect.Descriptor instead.
+func (*BindByTokenRequest) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BindByTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *BindByTokenRequest) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+func (x *BindByTokenRequest) GetTelegramUsername() string {
+	if x != nil {
+		return x.TelegramUsername
+	}
+	return ""
+}
+
+type BindByTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BindByTokenResponse) Reset() {
+	*x = BindByTokenResponse{}
+	mi := &file_notification_notification_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BindByTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BindByTokenResponse) ProtoMessage() {}
+
+func (x *BindByTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BindByTokenResponse.ProtoReflect.Descriptor instead.
+func (*BindByTokenResponse) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{24}
+}
+
+type ResolveChatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChatId        string                 `protobuf:"bytes,1,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveChatRequest) Reset() {
+	*x = ResolveChatRequest{}
+	mi := &file_notification_notification_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveChatRequest) ProtoMessage() {}
+
+func (x *ResolveChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveChatRequest.ProtoReflect.Descriptor instead.
+func (*ResolveChatRequest) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ResolveChatRequest) GetChatId() string {
+	if x != nil {
+		return x.ChatId
+	}
+	return ""
+}
+
+type ResolveChatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveChatResponse) Reset() {
+	*x = ResolveChatResponse{}
+	mi := &file_notification_notification_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveChatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveChatResponse) ProtoMessage() {}
+
+func (x *ResolveChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveChatResponse.ProtoReflect.Descriptor instead.
+func (*ResolveChatResponse) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ResolveChatResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type NotificationDTO struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // pending, sent or failed
+	Attempts      int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError     string                 `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	NextAttemptAt int64                  `protobuf:"varint,8,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	SentAt        int64                  `protobuf:"varint,11,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"` // 0 until sent
+	Channel       string                 `protobuf:"bytes,12,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationDTO) Reset() {
+	*x = NotificationDTO{}
+	mi := &file_notification_notification_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationDTO) ProtoMessage() {}
+
+func (x *NotificationDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationDTO.ProtoReflect.Descriptor instead.
+func (*NotificationDTO) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *NotificationDTO) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *NotificationDTO) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *NotificationDTO) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *NotificationDTO) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *NotificationDTO) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *NotificationDTO) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *NotificationDTO) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *NotificationDTO) GetNextAttemptAt() int64 {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return 0
+}
+
+func (x *NotificationDTO) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *NotificationDTO) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *NotificationDTO) GetSentAt() int64 {
+	if x != nil {
+		return x.SentAt
+	}
+	return 0
+}
+
+func (x *NotificationDTO) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+type GetDeliveryStatusRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NotificationId int64                  `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	UserId         int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // optional owner check
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetDeliveryStatusRequest) Reset() {
+	*x = GetDeliveryStatusRequest{}
+	mi := &file_notification_notification_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeliveryStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeliveryStatusRequest) ProtoMessage() {}
+
+func (x *GetDeliveryStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeliveryStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetDeliveryStatusRequest) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetDeliveryStatusRequest) GetNotificationId() int64 {
+	if x != nil {
+		return x.NotificationId
+	}
+	return 0
+}
+
+func (x *GetDeliveryStatusRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetDeliveryStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notification  *NotificationDTO       `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeliveryStatusResponse) Reset() {
+	*x = GetDeliveryStatusResponse{}
+	mi := &file_notification_notification_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeliveryStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeliveryStatusResponse) ProtoMessage() {}
+
+func (x *GetDeliveryStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeliveryStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetDeliveryStatusResponse) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetDeliveryStatusResponse) GetNotification() *NotificationDTO {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+type ListNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // optional
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                      // optional: pending, sent or failed
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                       // default 50, max 200
+	BeforeId      int64                  `protobuf:"varint,4,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"` // optional cursor
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
+	mi := &file_notification_notification_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsRequest) ProtoMessage() {}
+
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListNotificationsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListNotificationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetBeforeId() int64 {
+	if x != nil {
+		return x.BeforeId
+	}
+	return 0
+}
+
+type ListNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*NotificationDTO     `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	NextBeforeId  int64                  `protobuf:"varint,2,opt,name=next_before_id,json=nextBeforeId,proto3" json:"next_before_id,omitempty"` // 0 when there are no more pages
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsResponse) Reset() {
+	*x = ListNotificationsResponse{}
+	mi := &file_notification_notification_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsResponse) ProtoMessage() {}
+
+func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListNotificationsResponse) GetNotifications() []*NotificationDTO {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *ListNotificationsResponse) GetNextBeforeId() int64 {
+	if x != nil {
+		return x.NextBeforeId
+	}
+	return 0
+}
+
+type ChannelDTO struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"` // email, webhook URL or push endpoint; empty for telegram
+	Enabled       bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	HasSecret     bool                   `protobuf:"varint,4,opt,name=has_secret,json=hasSecret,proto3" json:"has_secret,omitempty"` // webhook requests are signed
+	UpdatedAt     int64                  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelDTO) Reset() {
+	*x = ChannelDTO{}
+	mi := &file_notification_notification_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelDTO) ProtoMessage() {}
+
+func (x *ChannelDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelDTO.ProtoReflect.Descriptor instead.
+func (*ChannelDTO) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ChannelDTO) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ChannelDTO) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ChannelDTO) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ChannelDTO) GetHasSecret() bool {
+	if x != nil {
+		return x.HasSecret
+	}
+	return false
+}
+
+func (x *ChannelDTO) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type SetChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Channel       string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Secret        string                 `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"` // optional webhook signing secret
+	P256Dh        string                 `protobuf:"bytes,5,opt,name=p256dh,proto3" json:"p256dh,omitempty"` // web push subscription keys
+	Auth          string                 `protobuf:"bytes,6,opt,name=auth,proto3" json:"auth,omitempty"`
+	Enabled       bool                   `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChannelRequest) Reset() {
+	*x = SetChannelRequest{}
+	mi := &file_notification_notification_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChannelRequest) ProtoMessage() {}
+
+func (x *SetChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChannelRequest.ProtoReflect.Descriptor instead.
+func (*SetChannelRequest) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SetChannelRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetChannelRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *SetChannelRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SetChannelRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *SetChannelRequest) GetP256Dh() string {
+	if x != nil {
+		return x.P256Dh
+	}
+	return ""
+}
+
+func (x *SetChannelRequest) GetAuth() string {
+	if x != nil {
+		return x.Auth
+	}
+	return ""
+}
+
+func (x *SetChannelRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type SetChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *ChannelDTO            `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChannelResponse) Reset() {
+	*x = SetChannelResponse{}
+	mi := &file_notification_notification_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChannelResponse) ProtoMessage() {}
+
+func (x *SetChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChannelResponse.ProtoReflect.Descriptor instead.
+func (*SetChannelResponse) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetChannelResponse) GetChannel() *ChannelDTO {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+type ListChannelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChannelsRequest) Reset() {
+	*x = ListChannelsRequest{}
+	mi := &file_notification_notification_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChannelsRequest) ProtoMessage() {}
+
+func (x *ListChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChannelsRequest.ProtoReflect.Descriptor instead.
+func (*ListChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListChannelsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListChannelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channels      []*ChannelDTO          `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChannelsResponse) Reset() {
+	*x = ListChannelsResponse{}
+	mi := &file_notification_notification_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChannelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChannelsResponse) ProtoMessage() {}
+
+func (x *ListChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChannelsResponse.ProtoReflect.Descriptor instead.
+func (*ListChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListChannelsResponse) GetChannels() []*ChannelDTO {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type DeleteChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Channel       string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChannelRequest) Reset() {
+	*x = DeleteChannelRequest{}
+	mi := &file_notification_notification_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChannelRequest) ProtoMessage() {}
+
+func (x *DeleteChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChannelRequest.ProtoReflect.Descriptor instead.
+func (*DeleteChannelRequest) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DeleteChannelRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteChannelRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+type DeleteChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChannelResponse) Reset() {
+	*x = DeleteChannelResponse{}
+	mi := &file_notification_notification_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChannelResponse) ProtoMessage() {}
+
+func (x *DeleteChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChannelResponse.ProtoReflect.Descriptor instead.
+func (*DeleteChannelResponse) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{38}
+}
+
+type GetWebPushKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebPushKeyRequest) Reset() {
+	*x = GetWebPushKeyRequest{}
+	mi := &file_notification_notification_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebPushKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebPushKeyRequest) ProtoMessage() {}
+
+func (x *GetWebPushKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebPushKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetWebPushKeyRequest) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{39}
+}
+
+type GetWebPushKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PublicKey     string                 `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"` // empty if web push is not configured
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebPushKeyResponse) Reset() {
+	*x = GetWebPushKeyResponse{}
+	mi := &file_notification_notification_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebPushKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebPushKeyResponse) ProtoMessage() {}
+
+func (x *GetWebPushKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebPushKeyResponse.ProtoReflect.Descriptor instead.
+func (*GetWebPushKeyResponse) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetWebPushKeyResponse) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+type PreferencesDTO struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Locale           string                 `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`                                               // language of notification texts, e.g. ru or en
+	AvailableLocales []string               `protobuf:"bytes,2,rep,name=available_locales,json=availableLocales,proto3" json:"available_locales,omitempty"`   // read-only
+	UpdatedAt        int64                  `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                       // unix seconds, 0 if never saved
+	DisabledKinds    []string               `protobuf:"bytes,4,rep,name=disabled_kinds,json=disabledKinds,proto3" json:"disabled_kinds,omitempty"`            // notification kinds the user does not want
+	AvailableKinds   []string               `protobuf:"bytes,5,rep,name=available_kinds,json=availableKinds,proto3" json:"available_kinds,omitempty"`         // read-only
+	NotifyTop        int32                  `protobuf:"varint,6,opt,name=notify_top,json=notifyTop,proto3" json:"notify_top,omitempty"`                       // narrows the queue's head size, never widens it; 0 keeps it
+	QuietFrom        string                 `protobuf:"bytes,7,opt,name=quiet_from,json=quietFrom,proto3" json:"quiet_from,omitempty"`                        // HH:MM, empty if there are no quiet hours
+	QuietTo          string                 `protobuf:"bytes,8,opt,name=quiet_to,json=quietTo,proto3" json:"quiet_to,omitempty"`                              // HH:MM; may be earlier than quiet_from to span midnight
+	Timezone         string                 `protobuf:"bytes,9,opt,name=timezone,proto3" json:"timezone,omitempty"`                                           // IANA name quiet hours are taken in, default Europe/Moscow
+	MutedQueueIds    []int64                `protobuf:"varint,10,rep,packed,name=muted_queue_ids,json=mutedQueueIds,proto3" json:"muted_queue_ids,omitempty"` // read-only, see MuteQueue
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PreferencesDTO) Reset() {
+	*x = PreferencesDTO{}
+	mi := &file_notification_notification_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreferencesDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferencesDTO) ProtoMessage() {}
+
+func (x *PreferencesDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferencesDTO.ProtoReflect.Descriptor instead.
+func (*PreferencesDTO) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PreferencesDTO) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *PreferencesDTO) GetAvailableLocales() []string {
+	if x != nil {
+		return x.AvailableLocales
+	}
+	return nil
+}
+
+func (x *PreferencesDTO) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *PreferencesDTO) GetDisabledKinds() []string {
+	if x != nil {
+		return x.DisabledKinds
+	}
+	return nil
+}
+
+func (x *PreferencesDTO) GetAvailableKinds() []string {
+	if x != nil {
+		return x.AvailableKinds
+	}
+	return nil
+}
+
+func (x *PreferencesDTO) GetNotifyTop() int32 {
+	if x != nil {
+		return x.NotifyTop
+	}
+	return 0
+}
+
+func (x *PreferencesDTO) GetQuietFrom() string {
+	if x != nil {
+		return x.QuietFrom
+	}
+	return ""
+}
+
+func (x *PreferencesDTO) GetQuietTo() string {
+	if x != nil {
+		return x.QuietTo
+	}
+	return ""
+}
+
+func (x *PreferencesDTO) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *PreferencesDTO) GetMutedQueueIds() []int64 {
+	if x != nil {
+		return x.MutedQueueIds
+	}
+	return nil
+}
+
+type GetPreferencesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NotifyPositionSoonResponse) Reset() {
-	*x = NotifyPositionSoonResponse{}
-	mi := &file_notification_notification_proto_msgTypes[1]
+func (x *GetPreferencesRequest) Reset() {
+	*x = GetPreferencesRequest{}
+	mi := &file_notification_notification_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NotifyPositionSoonResponse) String() string {
+func (x *GetPreferencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NotifyPositionSoonResponse) ProtoMessage() {}
+func (*GetPreferencesRequest) ProtoMessage() {}
 
-func (x *NotifyPositionSoonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_notification_proto_msgTypes[1]
+func (x *GetPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -112,35 +2429,40 @@ func (x *NotifyPositionSoonResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NotifyPositionSoonResponse.ProtoReflect.Descriptor instead.
-func (*NotifyPositionSoonResponse) Descriptor() ([]byte, []int) {
-	return file_notification_notification_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use GetPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{42}
 }
 
-type SetContactRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	UserId           int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	TelegramUsername string                 `protobuf:"bytes,2,opt,name=telegram_username,json=telegramUsername,proto3" json:"telegram_username,omitempty"` // without @
-	ChatId           string                 `protobuf:"bytes,3,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`                               // optional explicit chat_id
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *GetPreferencesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
 }
 
-func (x *SetContactRequest) Reset() {
-	*x = SetContactRequest{}
-	mi := &file_notification_notification_proto_msgTypes[2]
+type GetPreferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Preferences   *PreferencesDTO        `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPreferencesResponse) Reset() {
+	*x = GetPreferencesResponse{}
+	mi := &file_notification_notification_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetContactRequest) String() string {
+func (x *GetPreferencesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetContactRequest) ProtoMessage() {}
+func (*GetPreferencesResponse) ProtoMessage() {}
 
-func (x *SetContactRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_notification_proto_msgTypes[2]
+func (x *GetPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -151,53 +2473,132 @@ func (x *SetContactRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetContactRequest.ProtoReflect.Descriptor instead.
-func (*SetContactRequest) Descriptor() ([]byte, []int) {
-	return file_notification_notification_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use GetPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *SetContactRequest) GetUserId() int64 {
+func (x *GetPreferencesResponse) GetPreferences() *PreferencesDTO {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type UpdatePreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Locale        string                 `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	DisabledKinds []string               `protobuf:"bytes,3,rep,name=disabled_kinds,json=disabledKinds,proto3" json:"disabled_kinds,omitempty"`
+	NotifyTop     int32                  `protobuf:"varint,4,opt,name=notify_top,json=notifyTop,proto3" json:"notify_top,omitempty"`
+	QuietFrom     string                 `protobuf:"bytes,5,opt,name=quiet_from,json=quietFrom,proto3" json:"quiet_from,omitempty"`
+	QuietTo       string                 `protobuf:"bytes,6,opt,name=quiet_to,json=quietTo,proto3" json:"quiet_to,omitempty"`
+	Timezone      string                 `protobuf:"bytes,7,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePreferencesRequest) Reset() {
+	*x = UpdatePreferencesRequest{}
+	mi := &file_notification_notification_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePreferencesRequest) ProtoMessage() {}
+
+func (x *UpdatePreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpdatePreferencesRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *SetContactRequest) GetTelegramUsername() string {
+func (x *UpdatePreferencesRequest) GetLocale() string {
 	if x != nil {
-		return x.TelegramUsername
+		return x.Locale
 	}
 	return ""
 }
 
-func (x *SetContactRequest) GetChatId() string {
+func (x *UpdatePreferencesRequest) GetDisabledKinds() []string {
 	if x != nil {
-		return x.ChatId
+		return x.DisabledKinds
+	}
+	return nil
+}
+
+func (x *UpdatePreferencesRequest) GetNotifyTop() int32 {
+	if x != nil {
+		return x.NotifyTop
+	}
+	return 0
+}
+
+func (x *UpdatePreferencesRequest) GetQuietFrom() string {
+	if x != nil {
+		return x.QuietFrom
 	}
 	return ""
 }
 
-type SetContactResponse struct {
+func (x *UpdatePreferencesRequest) GetQuietTo() string {
+	if x != nil {
+		return x.QuietTo
+	}
+	return ""
+}
+
+func (x *UpdatePreferencesRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type UpdatePreferencesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Preferences   *PreferencesDTO        `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetContactResponse) Reset() {
-	*x = SetContactResponse{}
-	mi := &file_notification_notification_proto_msgTypes[3]
+func (x *UpdatePreferencesResponse) Reset() {
+	*x = UpdatePreferencesResponse{}
+	mi := &file_notification_notification_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetContactResponse) String() string {
+func (x *UpdatePreferencesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetContactResponse) ProtoMessage() {}
+func (*UpdatePreferencesResponse) ProtoMessage() {}
 
-func (x *SetContactResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_notification_proto_msgTypes[3]
+func (x *UpdatePreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -208,34 +2609,41 @@ func (x *SetContactResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetContactResponse.ProtoReflect.Descriptor instead.
-func (*SetContactResponse) Descriptor() ([]byte, []int) {
-	return file_notification_notification_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use UpdatePreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{45}
 }
 
-type CreateLinkTokenRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	UserId           int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	TelegramUsername string                 `protobuf:"bytes,2,opt,name=telegram_username,json=telegramUsername,proto3" json:"telegram_username,omitempty"` // optional, prefilled username
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *UpdatePreferencesResponse) GetPreferences() *PreferencesDTO {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
 }
 
-func (x *CreateLinkTokenRequest) Reset() {
-	*x = CreateLinkTokenRequest{}
-	mi := &file_notification_notification_proto_msgTypes[4]
+type MuteQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	QueueId       int64                  `protobuf:"varint,2,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteQueueRequest) Reset() {
+	*x = MuteQueueRequest{}
+	mi := &file_notification_notification_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateLinkTokenRequest) String() string {
+func (x *MuteQueueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateLinkTokenRequest) ProtoMessage() {}
+func (*MuteQueueRequest) ProtoMessage() {}
 
-func (x *CreateLinkTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_notification_proto_msgTypes[4]
+func (x *MuteQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -246,48 +2654,46 @@ func (x *CreateLinkTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateLinkTokenRequest.ProtoReflect.Descriptor instead.
-func (*CreateLinkTokenRequest) Descriptor() ([]byte, []int) {
-	return file_notification_notification_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use MuteQueueRequest.ProtoReflect.Descriptor instead.
+func (*MuteQueueRequest) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *CreateLinkTokenRequest) GetUserId() int64 {
+func (x *MuteQueueRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *CreateLinkTokenRequest) GetTelegramUsername() string {
+func (x *MuteQueueRequest) GetQueueId() int64 {
 	if x != nil {
-		return x.TelegramUsername
+		return x.QueueId
 	}
-	return ""
+	return 0
 }
 
-type CreateLinkTokenResponse struct {
+type MuteQueueResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Link          string                 `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"` // https://t.me/<bot>?start=<token> if bot name known
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateLinkTokenResponse) Reset() {
-	*x = CreateLinkTokenResponse{}
-	mi := &file_notification_notification_proto_msgTypes[5]
+func (x *MuteQueueResponse) Reset() {
+	*x = MuteQueueResponse{}
+	mi := &file_notification_notification_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateLinkTokenResponse) String() string {
+func (x *MuteQueueResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateLinkTokenResponse) ProtoMessage() {}
+func (*MuteQueueResponse) ProtoMessage() {}
 
-func (x *CreateLinkTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_notification_proto_msgTypes[5]
+func (x *MuteQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -298,49 +2704,34 @@ func (x *CreateLinkTokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateLinkTokenResponse.ProtoReflect.Descriptor instead.
-func (*CreateLinkTokenResponse) Descriptor() ([]byte, []int) {
-	return file_notification_notification_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *CreateLinkTokenResponse) GetToken() string {
-	if x != nil {
-		return x.Token
-	}
-	return ""
-}
-
-func (x *CreateLinkTokenResponse) GetLink() string {
-	if x != nil {
-		return x.Link
-	}
-	return ""
+// Deprecated: Use MuteQueueResponse.ProtoReflect.Descriptor instead.
+func (*MuteQueueResponse) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{47}
 }
 
-type BindByTokenRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Token            string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	ChatId           string                 `protobuf:"bytes,2,opt,name=chat_id,json=chatId,proto3" json:"chat_id,omitempty"`
-	TelegramUsername string                 `protobuf:"bytes,3,opt,name=telegram_username,json=telegramUsername,proto3" json:"telegram_username,omitempty"` // from message.from.username, optional
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type UnmuteQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	QueueId       int64                  `protobuf:"varint,2,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BindByTokenRequest) Reset() {
-	*x = BindByTokenRequest{}
-	mi := &file_notification_notification_proto_msgTypes[6]
+func (x *UnmuteQueueRequest) Reset() {
+	*x = UnmuteQueueRequest{}
+	mi := &file_notification_notification_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BindByTokenRequest) String() string {
+func (x *UnmuteQueueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BindByTokenRequest) ProtoMessage() {}
+func (*UnmuteQueueRequest) ProtoMessage() {}
 
-func (x *BindByTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_notification_proto_msgTypes[6]
+func (x *UnmuteQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -351,53 +2742,46 @@ func (x *BindByTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BindByTokenRequest.ProtoReflect.Descriptor instead.
-func (*BindByTokenRequest) Descriptor() ([]byte, []int) {
-	return file_notification_notification_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *BindByTokenRequest) GetToken() string {
-	if x != nil {
-		return x.Token
-	}
-	return ""
+// Deprecated: Use UnmuteQueueRequest.ProtoReflect.Descriptor instead.
+func (*UnmuteQueueRequest) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *BindByTokenRequest) GetChatId() string {
+func (x *UnmuteQueueRequest) GetUserId() int64 {
 	if x != nil {
-		return x.ChatId
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *BindByTokenRequest) GetTelegramUsername() string {
+func (x *UnmuteQueueRequest) GetQueueId() int64 {
 	if x != nil {
-		return x.TelegramUsername
+		return x.QueueId
 	}
-	return ""
+	return 0
 }
 
-type BindByTokenResponse struct {
+type UnmuteQueueResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BindByTokenResponse) Reset() {
-	*x = BindByTokenResponse{}
-	mi := &file_notification_notification_proto_msgTypes[7]
+func (x *UnmuteQueueResponse) Reset() {
+	*x = UnmuteQueueResponse{}
+	mi := &file_notification_notification_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BindByTokenResponse) String() string {
+func (x *UnmuteQueueResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BindByTokenResponse) ProtoMessage() {}
+func (*UnmuteQueueResponse) ProtoMessage() {}
 
-func (x *BindByTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_notification_proto_msgTypes[7]
+func (x *UnmuteQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_notification_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -408,44 +2792,242 @@ func (x *BindByTokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BindByTokenResponse.ProtoReflect.Descriptor instead.
-func (*BindByTokenResponse) Descriptor() ([]byte, []int) {
-	return file_notification_notification_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use UnmuteQueueResponse.ProtoReflect.Descriptor instead.
+func (*UnmuteQueueResponse) Descriptor() ([]byte, []int) {
+	return file_notification_notification_proto_rawDescGZIP(), []int{49}
 }
 
 var File_notification_notification_proto protoreflect.FileDescriptor
 
 const file_notification_notification_proto_rawDesc = "" +
 	"\n" +
-	"\x1fnotification/notification.proto\x12\fnotification\"q\n" +
+	"\x1fnotification/notification.proto\x12\fnotification\"\xe8\x01\n" +
 	"\x19NotifyPositionSoonRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
 	"\vqueue_title\x18\x02 \x01(\tR\n" +
 	"queueTitle\x12\x1a\n" +
-	"\bposition\x18\x03 \x01(\x05R\bposition\"\x1c\n" +
-	"\x1aNotifyPositionSoonResponse\"r\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\x12\x19\n" +
+	"\bqueue_id\x18\x04 \x01(\x03R\aqueueId\x12%\n" +
+	"\x0eparticipant_id\x18\x05 \x01(\x03R\rparticipantId\x12\x1d\n" +
+	"\n" +
+	"notify_top\x18\x06 \x01(\x05R\tnotifyTop\x12\x14\n" +
+	"\x05added\x18\a \x01(\bR\x05added\"M\n" +
+	"\x1aNotifyPositionSoonResponse\x12)\n" +
+	"\x10notification_ids\x18\x02 \x03(\x03R\x0fnotificationIdsJ\x04\b\x01\x10\x02\"q\n" +
+	"\x18NotifyQueueOpenedRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x03R\auserIds\x12\x1f\n" +
+	"\vqueue_title\x18\x02 \x01(\tR\n" +
+	"queueTitle\x12\x19\n" +
+	"\bqueue_id\x18\x03 \x01(\x03R\aqueueId\"F\n" +
+	"\x19NotifyQueueOpenedResponse\x12)\n" +
+	"\x10notification_ids\x18\x01 \x03(\x03R\x0fnotificationIds\"s\n" +
+	"\x1aNotifyQueueArchivedRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x03R\auserIds\x12\x1f\n" +
+	"\vqueue_title\x18\x02 \x01(\tR\n" +
+	"queueTitle\x12\x19\n" +
+	"\bqueue_id\x18\x03 \x01(\x03R\aqueueId\"H\n" +
+	"\x1bNotifyQueueArchivedResponse\x12)\n" +
+	"\x10notification_ids\x18\x01 \x03(\x03R\x0fnotificationIds\"v\n" +
+	"\x1fNotifyParticipantRemovedRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bqueue_id\x18\x02 \x01(\x03R\aqueueId\x12\x1f\n" +
+	"\vqueue_title\x18\x03 \x01(\tR\n" +
+	"queueTitle\"M\n" +
+	" NotifyParticipantRemovedResponse\x12)\n" +
+	"\x10notification_ids\x18\x01 \x03(\x03R\x0fnotificationIds\"\x93\x01\n" +
+	"\x13NotifyCalledRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bqueue_id\x18\x02 \x01(\x03R\aqueueId\x12\x1f\n" +
+	"\vqueue_title\x18\x03 \x01(\tR\n" +
+	"queueTitle\x12'\n" +
+	"\x0ftimeout_seconds\x18\x04 \x01(\x05R\x0etimeoutSeconds\"A\n" +
+	"\x14NotifyCalledResponse\x12)\n" +
+	"\x10notification_ids\x18\x01 \x03(\x03R\x0fnotificationIds\"\xf2\x01\n" +
+	"\x1aNotifySwapRequestedRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bqueue_id\x18\x02 \x01(\x03R\aqueueId\x12\x1f\n" +
+	"\vqueue_title\x18\x03 \x01(\tR\n" +
+	"queueTitle\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x04 \x01(\x03R\trequestId\x12\x1b\n" +
+	"\tfrom_name\x18\x05 \x01(\tR\bfromName\x12\x1a\n" +
+	"\bposition\x18\x06 \x01(\x05R\bposition\x12'\n" +
+	"\x0ftimeout_seconds\x18\a \x01(\x05R\x0etimeoutSeconds\"H\n" +
+	"\x1bNotifySwapRequestedResponse\x12)\n" +
+	"\x10notification_ids\x18\x01 \x03(\x03R\x0fnotificationIds\"\xa8\x01\n" +
+	"\x19NotifySwapAnsweredRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bqueue_id\x18\x02 \x01(\x03R\aqueueId\x12\x1f\n" +
+	"\vqueue_title\x18\x03 \x01(\tR\n" +
+	"queueTitle\x12\x1a\n" +
+	"\baccepted\x18\x04 \x01(\bR\baccepted\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\x05R\bposition\"G\n" +
+	"\x1aNotifySwapAnsweredResponse\x12)\n" +
+	"\x10notification_ids\x18\x01 \x03(\x03R\x0fnotificationIds\"r\n" +
 	"\x11SetContactRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12+\n" +
 	"\x11telegram_username\x18\x02 \x01(\tR\x10telegramUsername\x12\x17\n" +
 	"\achat_id\x18\x03 \x01(\tR\x06chatId\"\x14\n" +
-	"\x12SetContactResponse\"^\n" +
+	"\x12SetContactResponse\",\n" +
+	"\x11GetContactRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\x92\x01\n" +
+	"\n" +
+	"ContactDTO\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12+\n" +
+	"\x11telegram_username\x18\x02 \x01(\tR\x10telegramUsername\x12\x1f\n" +
+	"\vchat_linked\x18\x03 \x01(\bR\n" +
+	"chatLinked\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\x03R\tupdatedAt\"H\n" +
+	"\x12GetContactResponse\x122\n" +
+	"\acontact\x18\x01 \x01(\v2\x18.notification.ContactDTOR\acontact\"/\n" +
+	"\x14UnlinkContactRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\x17\n" +
+	"\x15UnlinkContactResponse\"^\n" +
 	"\x16CreateLinkTokenRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12+\n" +
-	"\x11telegram_username\x18\x02 \x01(\tR\x10telegramUsername\"C\n" +
+	"\x11telegram_username\x18\x02 \x01(\tR\x10telegramUsername\"b\n" +
 	"\x17CreateLinkTokenResponse\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
-	"\x04link\x18\x02 \x01(\tR\x04link\"p\n" +
+	"\x04link\x18\x02 \x01(\tR\x04link\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\x03R\texpiresAt\"p\n" +
 	"\x12BindByTokenRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12\x17\n" +
 	"\achat_id\x18\x02 \x01(\tR\x06chatId\x12+\n" +
 	"\x11telegram_username\x18\x03 \x01(\tR\x10telegramUsername\"\x15\n" +
-	"\x13BindByTokenResponse2\xfc\x02\n" +
+	"\x13BindByTokenResponse\"-\n" +
+	"\x12ResolveChatRequest\x12\x17\n" +
+	"\achat_id\x18\x01 \x01(\tR\x06chatId\".\n" +
+	"\x13ResolveChatResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\xce\x02\n" +
+	"\x0fNotificationDTO\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\a \x01(\tR\tlastError\x12&\n" +
+	"\x0fnext_attempt_at\x18\b \x01(\x03R\rnextAttemptAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\x03R\tupdatedAt\x12\x17\n" +
+	"\asent_at\x18\v \x01(\x03R\x06sentAt\x12\x18\n" +
+	"\achannel\x18\f \x01(\tR\achannel\"\\\n" +
+	"\x18GetDeliveryStatusRequest\x12'\n" +
+	"\x0fnotification_id\x18\x01 \x01(\x03R\x0enotificationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"^\n" +
+	"\x19GetDeliveryStatusResponse\x12A\n" +
+	"\fnotification\x18\x01 \x01(\v2\x1d.notification.NotificationDTOR\fnotification\"~\n" +
+	"\x18ListNotificationsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1b\n" +
+	"\tbefore_id\x18\x04 \x01(\x03R\bbeforeId\"\x86\x01\n" +
+	"\x19ListNotificationsResponse\x12C\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x1d.notification.NotificationDTOR\rnotifications\x12$\n" +
+	"\x0enext_before_id\x18\x02 \x01(\x03R\fnextBeforeId\"\x98\x01\n" +
+	"\n" +
+	"ChannelDTO\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x12\x1d\n" +
+	"\n" +
+	"has_secret\x18\x04 \x01(\bR\thasSecret\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\x03R\tupdatedAt\"\xbe\x01\n" +
+	"\x11SetChannelRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x12\x16\n" +
+	"\x06p256dh\x18\x05 \x01(\tR\x06p256dh\x12\x12\n" +
+	"\x04auth\x18\x06 \x01(\tR\x04auth\x12\x18\n" +
+	"\aenabled\x18\a \x01(\bR\aenabled\"H\n" +
+	"\x12SetChannelResponse\x122\n" +
+	"\achannel\x18\x01 \x01(\v2\x18.notification.ChannelDTOR\achannel\".\n" +
+	"\x13ListChannelsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"L\n" +
+	"\x14ListChannelsResponse\x124\n" +
+	"\bchannels\x18\x01 \x03(\v2\x18.notification.ChannelDTOR\bchannels\"I\n" +
+	"\x14DeleteChannelRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\"\x17\n" +
+	"\x15DeleteChannelResponse\"\x16\n" +
+	"\x14GetWebPushKeyRequest\"6\n" +
+	"\x15GetWebPushKeyResponse\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x01 \x01(\tR\tpublicKey\"\xe1\x02\n" +
+	"\x0ePreferencesDTO\x12\x16\n" +
+	"\x06locale\x18\x01 \x01(\tR\x06locale\x12+\n" +
+	"\x11available_locales\x18\x02 \x03(\tR\x10availableLocales\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\x03R\tupdatedAt\x12%\n" +
+	"\x0edisabled_kinds\x18\x04 \x03(\tR\rdisabledKinds\x12'\n" +
+	"\x0favailable_kinds\x18\x05 \x03(\tR\x0eavailableKinds\x12\x1d\n" +
+	"\n" +
+	"notify_top\x18\x06 \x01(\x05R\tnotifyTop\x12\x1d\n" +
+	"\n" +
+	"quiet_from\x18\a \x01(\tR\tquietFrom\x12\x19\n" +
+	"\bquiet_to\x18\b \x01(\tR\aquietTo\x12\x1a\n" +
+	"\btimezone\x18\t \x01(\tR\btimezone\x12&\n" +
+	"\x0fmuted_queue_ids\x18\n" +
+	" \x03(\x03R\rmutedQueueIds\"0\n" +
+	"\x15GetPreferencesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"X\n" +
+	"\x16GetPreferencesResponse\x12>\n" +
+	"\vpreferences\x18\x01 \x01(\v2\x1c.notification.PreferencesDTOR\vpreferences\"\xe7\x01\n" +
+	"\x18UpdatePreferencesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06locale\x18\x02 \x01(\tR\x06locale\x12%\n" +
+	"\x0edisabled_kinds\x18\x03 \x03(\tR\rdisabledKinds\x12\x1d\n" +
+	"\n" +
+	"notify_top\x18\x04 \x01(\x05R\tnotifyTop\x12\x1d\n" +
+	"\n" +
+	"quiet_from\x18\x05 \x01(\tR\tquietFrom\x12\x19\n" +
+	"\bquiet_to\x18\x06 \x01(\tR\aquietTo\x12\x1a\n" +
+	"\btimezone\x18\a \x01(\tR\btimezone\"[\n" +
+	"\x19UpdatePreferencesResponse\x12>\n" +
+	"\vpreferences\x18\x01 \x01(\v2\x1c.notification.PreferencesDTOR\vpreferences\"F\n" +
+	"\x10MuteQueueRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bqueue_id\x18\x02 \x01(\x03R\aqueueId\"\x13\n" +
+	"\x11MuteQueueResponse\"H\n" +
+	"\x12UnmuteQueueRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bqueue_id\x18\x02 \x01(\x03R\aqueueId\"\x15\n" +
+	"\x13UnmuteQueueResponse2\x81\x11\n" +
 	"\fNotification\x12g\n" +
-	"\x12NotifyPositionSoon\x12'.notification.NotifyPositionSoonRequest\x1a(.notification.NotifyPositionSoonResponse\x12O\n" +
+	"\x12NotifyPositionSoon\x12'.notification.NotifyPositionSoonRequest\x1a(.notification.NotifyPositionSoonResponse\x12d\n" +
+	"\x11NotifyQueueOpened\x12&.notification.NotifyQueueOpenedRequest\x1a'.notification.NotifyQueueOpenedResponse\x12j\n" +
+	"\x13NotifyQueueArchived\x12(.notification.NotifyQueueArchivedRequest\x1a).notification.NotifyQueueArchivedResponse\x12y\n" +
+	"\x18NotifyParticipantRemoved\x12-.notification.NotifyParticipantRemovedRequest\x1a..notification.NotifyParticipantRemovedResponse\x12U\n" +
+	"\fNotifyCalled\x12!.notification.NotifyCalledRequest\x1a\".notification.NotifyCalledResponse\x12j\n" +
+	"\x13NotifySwapRequested\x12(.notification.NotifySwapRequestedRequest\x1a).notification.NotifySwapRequestedResponse\x12g\n" +
+	"\x12NotifySwapAnswered\x12'.notification.NotifySwapAnsweredRequest\x1a(.notification.NotifySwapAnsweredResponse\x12O\n" +
 	"\n" +
-	"SetContact\x12\x1f.notification.SetContactRequest\x1a .notification.SetContactResponse\x12^\n" +
+	"SetContact\x12\x1f.notification.SetContactRequest\x1a .notification.SetContactResponse\x12O\n" +
+	"\n" +
+	"GetContact\x12\x1f.notification.GetContactRequest\x1a .notification.GetContactResponse\x12X\n" +
+	"\rUnlinkContact\x12\".notification.UnlinkContactRequest\x1a#.notification.UnlinkContactResponse\x12^\n" +
 	"\x0fCreateLinkToken\x12$.notification.CreateLinkTokenRequest\x1a%.notification.CreateLinkTokenResponse\x12R\n" +
-	"\vBindByToken\x12 .notification.BindByTokenRequest\x1a!.notification.BindByTokenResponseBOZMgithub.com/s1lentmol/q-flow-backend/protos/gen/go/notification;notificationv1b\x06proto3"
+	"\vBindByToken\x12 .notification.BindByTokenRequest\x1a!.notification.BindByTokenResponse\x12R\n" +
+	"\vResolveChat\x12 .notification.ResolveChatRequest\x1a!.notification.ResolveChatResponse\x12d\n" +
+	"\x11GetDeliveryStatus\x12&.notification.GetDeliveryStatusRequest\x1a'.notification.GetDeliveryStatusResponse\x12d\n" +
+	"\x11ListNotifications\x12&.notification.ListNotificationsRequest\x1a'.notification.ListNotificationsResponse\x12O\n" +
+	"\n" +
+	"SetChannel\x12\x1f.notification.SetChannelRequest\x1a .notification.SetChannelResponse\x12U\n" +
+	"\fListChannels\x12!.notification.ListChannelsRequest\x1a\".notification.ListChannelsResponse\x12X\n" +
+	"\rDeleteChannel\x12\".notification.DeleteChannelRequest\x1a#.notification.DeleteChannelResponse\x12X\n" +
+	"\rGetWebPushKey\x12\".notification.GetWebPushKeyRequest\x1a#.notification.GetWebPushKeyResponse\x12[\n" +
+	"\x0eGetPreferences\x12#.notification.GetPreferencesRequest\x1a$.notification.GetPreferencesResponse\x12d\n" +
+	"\x11UpdatePreferences\x12&.notification.UpdatePreferencesRequest\x1a'.notification.UpdatePreferencesResponse\x12L\n" +
+	"\tMuteQueue\x12\x1e.notification.MuteQueueRequest\x1a\x1f.notification.MuteQueueResponse\x12R\n" +
+	"\vUnmuteQueue\x12 .notification.UnmuteQueueRequest\x1a!.notification.UnmuteQueueResponseBOZMgithub.com/s1lentmol/q-flow-backend/protos/gen/go/notification;notificationv1b\x06proto3"
 
 var (
 	file_notification_notification_proto_rawDescOnce sync.Once
@@ -459,31 +3041,118 @@ func file_notification_notification_proto_rawDescGZIP() []byte {
 	return file_notification_notification_proto_rawDescData
 }
 
-var file_notification_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_notification_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
 var file_notification_notification_proto_goTypes = []any{
-	(*NotifyPositionSoonRequest)(nil),  // 0: notification.NotifyPositionSoonRequest
-	(*NotifyPositionSoonResponse)(nil), // 1: notification.NotifyPositionSoonResponse
-	(*SetContactRequest)(nil),          // 2: notification.SetContactRequest
-	(*SetContactResponse)(nil),         // 3: notification.SetContactResponse
-	(*CreateLinkTokenRequest)(nil),     // 4: notification.CreateLinkTokenRequest
-	(*CreateLinkTokenResponse)(nil),    // 5: notification.CreateLinkTokenResponse
-	(*BindByTokenRequest)(nil),         // 6: notification.BindByTokenRequest
-	(*BindByTokenResponse)(nil),        // 7: notification.BindByTokenResponse
+	(*NotifyPositionSoonRequest)(nil),        // 0: notification.NotifyPositionSoonRequest
+	(*NotifyPositionSoonResponse)(nil),       // 1: notification.NotifyPositionSoonResponse
+	(*NotifyQueueOpenedRequest)(nil),         // 2: notification.NotifyQueueOpenedRequest
+	(*NotifyQueueOpenedResponse)(nil),        // 3: notification.NotifyQueueOpenedResponse
+	(*NotifyQueueArchivedRequest)(nil),       // 4: notification.NotifyQueueArchivedRequest
+	(*NotifyQueueArchivedResponse)(nil),      // 5: notification.NotifyQueueArchivedResponse
+	(*NotifyParticipantRemovedRequest)(nil),  // 6: notification.NotifyParticipantRemovedRequest
+	(*NotifyParticipantRemovedResponse)(nil), // 7: notification.NotifyParticipantRemovedResponse
+	(*NotifyCalledRequest)(nil),              // 8: notification.NotifyCalledRequest
+	(*NotifyCalledResponse)(nil),             // 9: notification.NotifyCalledResponse
+	(*NotifySwapRequestedRequest)(nil),       // 10: notification.NotifySwapRequestedRequest
+	(*NotifySwapRequestedResponse)(nil),      // 11: notification.NotifySwapRequestedResponse
+	(*NotifySwapAnsweredRequest)(nil),        // 12: notification.NotifySwapAnsweredRequest
+	(*NotifySwapAnsweredResponse)(nil),       // 13: notification.NotifySwapAnsweredResponse
+	(*SetContactRequest)(nil),                // 14: notification.SetContactRequest
+	(*SetContactResponse)(nil),               // 15: notification.SetContactResponse
+	(*GetContactRequest)(nil),                // 16: notification.GetContactRequest
+	(*ContactDTO)(nil),                       // 17: notification.ContactDTO
+	(*GetContactResponse)(nil),               // 18: notification.GetContactResponse
+	(*UnlinkContactRequest)(nil),             // 19: notification.UnlinkContactRequest
+	(*UnlinkContactResponse)(nil),            // 20: notification.UnlinkContactResponse
+	(*CreateLinkTokenRequest)(nil),           // 21: notification.CreateLinkTokenRequest
+	(*CreateLinkTokenResponse)(nil),          // 22: notification.CreateLinkTokenResponse
+	(*BindByTokenRequest)(nil),               // 23: notification.BindByTokenRequest
+	(*BindByTokenResponse)(nil),              // 24: notification.BindByTokenResponse
+	(*ResolveChatRequest)(nil),               // 25: notification.ResolveChatRequest
+	(*ResolveChatResponse)(nil),              // 26: notification.ResolveChatResponse
+	(*NotificationDTO)(nil),                  // 27: notification.NotificationDTO
+	(*GetDeliveryStatusRequest)(nil),         // 28: notification.GetDeliveryStatusRequest
+	(*GetDeliveryStatusResponse)(nil),        // 29: notification.GetDeliveryStatusResponse
+	(*ListNotificationsRequest)(nil),         // 30: notification.ListNotificationsRequest
+	(*ListNotificationsResponse)(nil),        // 31: notification.ListNotificationsResponse
+	(*ChannelDTO)(nil),                       // 32: notification.ChannelDTO
+	(*SetChannelRequest)(nil),                // 33: notification.SetChannelRequest
+	(*SetChannelResponse)(nil),               // 34: notification.SetChannelResponse
+	(*ListChannelsRequest)(nil),              // 35: notification.ListChannelsRequest
+	(*ListChannelsResponse)(nil),             // 36: notification.ListChannelsResponse
+	(*DeleteChannelRequest)(nil),             // 37: notification.DeleteChannelRequest
+	(*DeleteChannelResponse)(nil),            // 38: notification.DeleteChannelResponse
+	(*GetWebPushKeyRequest)(nil),             // 39: notification.GetWebPushKeyRequest
+	(*GetWebPushKeyResponse)(nil),            // 40: notification.GetWebPushKeyResponse
+	(*PreferencesDTO)(nil),                   // 41: notification.PreferencesDTO
+	(*GetPreferencesRequest)(nil),            // 42: notification.GetPreferencesRequest
+	(*GetPreferencesResponse)(nil),           // 43: notification.GetPreferencesResponse
+	(*UpdatePreferencesRequest)(nil),         // 44: notification.UpdatePreferencesRequest
+	(*UpdatePreferencesResponse)(nil),        // 45: notification.UpdatePreferencesResponse
+	(*MuteQueueRequest)(nil),                 // 46: notification.MuteQueueRequest
+	(*MuteQueueResponse)(nil),                // 47: notification.MuteQueueResponse
+	(*UnmuteQueueRequest)(nil),               // 48: notification.UnmuteQueueRequest
+	(*UnmuteQueueResponse)(nil),              // 49: notification.UnmuteQueueResponse
 }
 var file_notification_notification_proto_depIdxs = []int32{
-	0, // 0: notification.Notification.NotifyPositionSoon:input_type -> notification.NotifyPositionSoonRequest
-	2, // 1: notification.Notification.SetContact:input_type -> notification.SetContactRequest
-	4, // 2: notification.Notification.CreateLinkToken:input_type -> notification.CreateLinkTokenRequest
-	6, // 3: notification.Notification.BindByToken:input_type -> notification.BindByTokenRequest
-	1, // 4: notification.Notification.NotifyPositionSoon:output_type -> notification.NotifyPositionSoonResponse
-	3, // 5: notification.Notification.SetContact:output_type -> notification.SetContactResponse
-	5, // 6: notification.Notification.CreateLinkToken:output_type -> notification.CreateLinkTokenResponse
-	7, // 7: notification.Notification.BindByToken:output_type -> notification.BindByTokenResponse
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	17, // 0: notification.GetContactResponse.contact:type_name -> notification.ContactDTO
+	27, // 1: notification.GetDeliveryStatusResponse.notification:type_name -> notification.NotificationDTO
+	27, // 2: notification.ListNotificationsResponse.notifications:type_name -> notification.NotificationDTO
+	32, // 3: notification.SetChannelResponse.channel:type_name -> notification.ChannelDTO
+	32, // 4: notification.ListChannelsResponse.channels:type_name -> notification.ChannelDTO
+	41, // 5: notification.GetPreferencesResponse.preferences:type_name -> notification.PreferencesDTO
+	41, // 6: notification.UpdatePreferencesResponse.preferences:type_name -> notification.PreferencesDTO
+	0,  // 7: notification.Notification.NotifyPositionSoon:input_type -> notification.NotifyPositionSoonRequest
+	2,  // 8: notification.Notification.NotifyQueueOpened:input_type -> notification.NotifyQueueOpenedRequest
+	4,  // 9: notification.Notification.NotifyQueueArchived:input_type -> notification.NotifyQueueArchivedRequest
+	6,  // 10: notification.Notification.NotifyParticipantRemoved:input_type -> notification.NotifyParticipantRemovedRequest
+	8,  // 11: notification.Notification.NotifyCalled:input_type -> notification.NotifyCalledRequest
+	10, // 12: notification.Notification.NotifySwapRequested:input_type -> notification.NotifySwapRequestedRequest
+	12, // 13: notification.Notification.NotifySwapAnswered:input_type -> notification.NotifySwapAnsweredRequest
+	14, // 14: notification.Notification.SetContact:input_type -> notification.SetContactRequest
+	16, // 15: notification.Notification.GetContact:input_type -> notification.GetContactRequest
+	19, // 16: notification.Notification.UnlinkContact:input_type -> notification.UnlinkContactRequest
+	21, // 17: notification.Notification.CreateLinkToken:input_type -> notification.CreateLinkTokenRequest
+	23, // 18: notification.Notification.BindByToken:input_type -> notification.BindByTokenRequest
+	25, // 19: notification.Notification.ResolveChat:input_type -> notification.ResolveChatRequest
+	28, // 20: notification.Notification.GetDeliveryStatus:input_type -> notification.GetDeliveryStatusRequest
+	30, // 21: notification.Notification.ListNotifications:input_type -> notification.ListNotificationsRequest
+	33, // 22: notification.Notification.SetChannel:input_type -> notification.SetChannelRequest
+	35, // 23: notification.Notification.ListChannels:input_type -> notification.ListChannelsRequest
+	37, // 24: notification.Notification.DeleteChannel:input_type -> notification.DeleteChannelRequest
+	39, // 25: notification.Notification.GetWebPushKey:input_type -> notification.GetWebPushKeyRequest
+	42, // 26: notification.Notification.GetPreferences:input_type -> notification.GetPreferencesRequest
+	44, // 27: notification.Notification.UpdatePreferences:input_type -> notification.UpdatePreferencesRequest
+	46, // 28: notification.Notification.MuteQueue:input_type -> notification.MuteQueueRequest
+	48, // 29: notification.Notification.UnmuteQueue:input_type -> notification.UnmuteQueueRequest
+	1,  // 30: notification.Notification.NotifyPositionSoon:output_type -> notification.NotifyPositionSoonResponse
+	3,  // 31: notification.Notification.NotifyQueueOpened:output_type -> notification.NotifyQueueOpenedResponse
+	5,  // 32: notification.Notification.NotifyQueueArchived:output_type -> notification.NotifyQueueArchivedResponse
+	7,  // 33: notification.Notification.NotifyParticipantRemoved:output_type -> notification.NotifyParticipantRemovedResponse
+	9,  // 34: notification.Notification.NotifyCalled:output_type -> notification.NotifyCalledResponse
+	11, // 35: notification.Notification.NotifySwapRequested:output_type -> notification.NotifySwapRequestedResponse
+	13, // 36: notification.Notification.NotifySwapAnswered:output_type -> notification.NotifySwapAnsweredResponse
+	15, // 37: notification.Notification.SetContact:output_type -> notification.SetContactResponse
+	18, // 38: notification.Notification.GetContact:output_type -> notification.GetContactResponse
+	20, // 39: notification.Notification.UnlinkContact:output_type -> notification.UnlinkContactResponse
+	22, // 40: notification.Notification.CreateLinkToken:output_type -> notification.CreateLinkTokenResponse
+	24, // 41: notification.Notification.BindByToken:output_type -> notification.BindByTokenResponse
+	26, // 42: notification.Notification.ResolveChat:output_type -> notification.ResolveChatResponse
+	29, // 43: notification.Notification.GetDeliveryStatus:output_type -> notification.GetDeliveryStatusResponse
+	31, // 44: notification.Notification.ListNotifications:output_type -> notification.ListNotificationsResponse
+	34, // 45: notification.Notification.SetChannel:output_type -> notification.SetChannelResponse
+	36, // 46: notification.Notification.ListChannels:output_type -> notification.ListChannelsResponse
+	38, // 47: notification.Notification.DeleteChannel:output_type -> notification.DeleteChannelResponse
+	40, // 48: notification.Notification.GetWebPushKey:output_type -> notification.GetWebPushKeyResponse
+	43, // 49: notification.Notification.GetPreferences:output_type -> notification.GetPreferencesResponse
+	45, // 50: notification.Notification.UpdatePreferences:output_type -> notification.UpdatePreferencesResponse
+	47, // 51: notification.Notification.MuteQueue:output_type -> notification.MuteQueueResponse
+	49, // 52: notification.Notification.UnmuteQueue:output_type -> notification.UnmuteQueueResponse
+	30, // [30:53] is the sub-list for method output_type
+	7,  // [7:30] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_notification_notification_proto_init() }
@@ -497,7 +3166,7 @@ func file_notification_notification_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notification_notification_proto_rawDesc), len(file_notification_notification_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   50,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
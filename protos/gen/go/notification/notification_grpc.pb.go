@@ -19,22 +19,80 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Notification_NotifyPositionSoon_FullMethodName = "/notification.Notification/NotifyPositionSoon"
-	Notification_SetContact_FullMethodName         = "/notification.Notification/SetContact"
-	Notification_CreateLinkToken_FullMethodName    = "/notification.Notification/CreateLinkToken"
-	Notification_BindByToken_FullMethodName        = "/notification.Notification/BindByToken"
+	Notification_NotifyPositionSoon_FullMethodName       = "/notification.Notification/NotifyPositionSoon"
+	Notification_NotifyQueueOpened_FullMethodName        = "/notification.Notification/NotifyQueueOpened"
+	Notification_NotifyQueueArchived_FullMethodName      = "/notification.Notification/NotifyQueueArchived"
+	Notification_NotifyParticipantRemoved_FullMethodName = "/notification.Notification/NotifyParticipantRemoved"
+	Notification_NotifyCalled_FullMethodName             = "/notification.Notification/NotifyCalled"
+	Notification_NotifySwapRequested_FullMethodName      = "/notification.Notification/NotifySwapRequested"
+	Notification_NotifySwapAnswered_FullMethodName       = "/notification.Notification/NotifySwapAnswered"
+	Notification_SetContact_FullMethodName               = "/notification.Notification/SetContact"
+	Notification_GetContact_FullMethodName               = "/notification.Notification/GetContact"
+	Notification_UnlinkContact_FullMethodName            = "/notification.Notification/UnlinkContact"
+	Notification_CreateLinkToken_FullMethodName          = "/notification.Notification/CreateLinkToken"
+	Notification_BindByToken_FullMethodName              = "/notification.Notification/BindByToken"
+	Notification_ResolveChat_FullMethodName              = "/notification.Notification/ResolveChat"
+	Notification_GetDeliveryStatus_FullMethodName        = "/notification.Notification/GetDeliveryStatus"
+	Notification_ListNotifications_FullMethodName        = "/notification.Notification/ListNotifications"
+	Notification_SetChannel_FullMethodName               = "/notification.Notification/SetChannel"
+	Notification_ListChannels_FullMethodName             = "/notification.Notification/ListChannels"
+	Notification_DeleteChannel_FullMethodName            = "/notification.Notification/DeleteChannel"
+	Notification_GetWebPushKey_FullMethodName            = "/notification.Notification/GetWebPushKey"
+	Notification_GetPreferences_FullMethodName           = "/notification.Notification/GetPreferences"
+	Notification_UpdatePreferences_FullMethodName        = "/notification.Notification/UpdatePreferences"
+	Notification_MuteQueue_FullMethodName                = "/notification.Notification/MuteQueue"
+	Notification_UnmuteQueue_FullMethodName              = "/notification.Notification/UnmuteQueue"
 )
 
 // NotificationClient is the client API for Notification service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type NotificationClient interface {
+	// Notifies only on transitions: entered the head of the queue, became next, got their turn.
 	NotifyPositionSoon(ctx context.Context, in *NotifyPositionSoonRequest, opts ...grpc.CallOption) (*NotifyPositionSoonResponse, error)
+	// Tells group members that a scheduled queue is open for joining.
+	NotifyQueueOpened(ctx context.Context, in *NotifyQueueOpenedRequest, opts ...grpc.CallOption) (*NotifyQueueOpenedResponse, error)
+	// Tells users still in the queue that it was archived.
+	NotifyQueueArchived(ctx context.Context, in *NotifyQueueArchivedRequest, opts ...grpc.CallOption) (*NotifyQueueArchivedResponse, error)
+	// Tells a participant that the owner removed them from the queue.
+	NotifyParticipantRemoved(ctx context.Context, in *NotifyParticipantRemovedRequest, opts ...grpc.CallOption) (*NotifyParticipantRemovedResponse, error)
+	// Tells the participant called to the head to come and check in; Telegram gets a check-in button.
+	NotifyCalled(ctx context.Context, in *NotifyCalledRequest, opts ...grpc.CallOption) (*NotifyCalledResponse, error)
+	// Asks a participant to answer another one's swap request; Telegram gets an accept button.
+	NotifySwapRequested(ctx context.Context, in *NotifySwapRequestedRequest, opts ...grpc.CallOption) (*NotifySwapRequestedResponse, error)
+	// Tells the requester whether the swap was accepted.
+	NotifySwapAnswered(ctx context.Context, in *NotifySwapAnsweredRequest, opts ...grpc.CallOption) (*NotifySwapAnsweredResponse, error)
 	SetContact(ctx context.Context, in *SetContactRequest, opts ...grpc.CallOption) (*SetContactResponse, error)
+	// Telegram link status of the user; NOT_FOUND if nothing is linked.
+	GetContact(ctx context.Context, in *GetContactRequest, opts ...grpc.CallOption) (*GetContactResponse, error)
+	// Unlinks the user's Telegram and sends a confirmation to the old chat; NOT_FOUND if nothing is linked.
+	UnlinkContact(ctx context.Context, in *UnlinkContactRequest, opts ...grpc.CallOption) (*UnlinkContactResponse, error)
 	// Issues a start-link token for the user; frontend uses it in https://t.me/<bot>?start=<token>.
+	// The token expires after a configured TTL (15 minutes by default).
 	CreateLinkToken(ctx context.Context, in *CreateLinkTokenRequest, opts ...grpc.CallOption) (*CreateLinkTokenResponse, error)
 	// Consumes the token received from Telegram /start webhook and binds chat_id to user.
+	// NOT_FOUND if the token is unknown, used or expired.
 	BindByToken(ctx context.Context, in *BindByTokenRequest, opts ...grpc.CallOption) (*BindByTokenResponse, error)
+	// Finds the user bound to a Telegram chat; NOT_FOUND if the chat is not linked.
+	ResolveChat(ctx context.Context, in *ResolveChatRequest, opts ...grpc.CallOption) (*ResolveChatResponse, error)
+	// Returns the delivery state of a notification; user_id, when set, must own it.
+	GetDeliveryStatus(ctx context.Context, in *GetDeliveryStatusRequest, opts ...grpc.CallOption) (*GetDeliveryStatusResponse, error)
+	// Lists notifications newest first; pass next_before_id as before_id for the next page.
+	ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error)
+	// Creates or replaces the user's settings of one channel: telegram, email, webhook or webpush.
+	SetChannel(ctx context.Context, in *SetChannelRequest, opts ...grpc.CallOption) (*SetChannelResponse, error)
+	// Channels the user has set up; without any, notifications go to Telegram.
+	ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error)
+	DeleteChannel(ctx context.Context, in *DeleteChannelRequest, opts ...grpc.CallOption) (*DeleteChannelResponse, error)
+	// VAPID public key for browser push subscriptions.
+	GetWebPushKey(ctx context.Context, in *GetWebPushKeyRequest, opts ...grpc.CallOption) (*GetWebPushKeyResponse, error)
+	// Notification settings of the user; defaults if never saved.
+	GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*GetPreferencesResponse, error)
+	// Replaces all settings; zero values mean the defaults. Muted queues are kept.
+	UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...grpc.CallOption) (*UpdatePreferencesResponse, error)
+	// Stops all notifications about one queue for the user.
+	MuteQueue(ctx context.Context, in *MuteQueueRequest, opts ...grpc.CallOption) (*MuteQueueResponse, error)
+	UnmuteQueue(ctx context.Context, in *UnmuteQueueRequest, opts ...grpc.CallOption) (*UnmuteQueueResponse, error)
 }
 
 type notificationClient struct {
@@ -55,6 +113,66 @@ func (c *notificationClient) NotifyPositionSoon(ctx context.Context, in *NotifyP
 	return out, nil
 }
 
+func (c *notificationClient) NotifyQueueOpened(ctx context.Context, in *NotifyQueueOpenedRequest, opts ...grpc.CallOption) (*NotifyQueueOpenedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotifyQueueOpenedResponse)
+	err := c.cc.Invoke(ctx, Notification_NotifyQueueOpened_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationClient) NotifyQueueArchived(ctx context.Context, in *NotifyQueueArchivedRequest, opts ...grpc.CallOption) (*NotifyQueueArchivedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotifyQueueArchivedResponse)
+	err := c.cc.Invoke(ctx, Notification_NotifyQueueArchived_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationClient) NotifyParticipantRemoved(ctx context.Context, in *NotifyParticipantRemovedRequest, opts ...grpc.CallOption) (*NotifyParticipantRemovedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotifyParticipantRemovedResponse)
+	err := c.cc.Invoke(ctx, Notification_NotifyParticipantRemoved_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationClient) NotifyCalled(ctx context.Context, in *NotifyCalledRequest, opts ...grpc.CallOption) (*NotifyCalledResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotifyCalledResponse)
+	err := c.cc.Invoke(ctx, Notification_NotifyCalled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationClient) NotifySwapRequested(ctx context.Context, in *NotifySwapRequestedRequest, opts ...grpc.CallOption) (*NotifySwapRequestedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotifySwapRequestedResponse)
+	err := c.cc.Invoke(ctx, Notification_NotifySwapRequested_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationClient) NotifySwapAnswered(ctx context.Context, in *NotifySwapAnsweredRequest, opts ...grpc.CallOption) (*NotifySwapAnsweredResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotifySwapAnsweredResponse)
+	err := c.cc.Invoke(ctx, Notification_NotifySwapAnswered_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *notificationClient) SetContact(ctx context.Context, in *SetContactRequest, opts ...grpc.CallOption) (*SetContactResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SetContactResponse)
@@ -65,6 +183,26 @@ func (c *notificationClient) SetContact(ctx context.Context, in *SetContactReque
 	return out, nil
 }
 
+func (c *notificationClient) GetContact(ctx context.Context, in *GetContactRequest, opts ...grpc.CallOption) (*GetContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetContactResponse)
+	err := c.cc.Invoke(ctx, Notification_GetContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationClient) UnlinkContact(ctx context.Context, in *UnlinkContactRequest, opts ...grpc.CallOption) (*UnlinkContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlinkContactResponse)
+	err := c.cc.Invoke(ctx, Notification_UnlinkContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *notificationClient) CreateLinkToken(ctx context.Context, in *CreateLinkTokenRequest, opts ...grpc.CallOption) (*CreateLinkTokenResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateLinkTokenResponse)
@@ -85,16 +223,165 @@ func (c *notificationClient) BindByToken(ctx context.Context, in *BindByTokenReq
 	return out, nil
 }
 
+func (c *notificationClient) ResolveChat(ctx context.Context, in *ResolveChatRequest, opts ...grpc.CallOption) (*ResolveChatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveChatResponse)
+	err := c.cc.Invoke(ctx, Notification_ResolveChat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationClient) GetDeliveryStatus(ctx context.Context, in *GetDeliveryStatusRequest, opts ...grpc.CallOption) (*GetDeliveryStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDeliveryStatusResponse)
+	err := c.cc.Invoke(ctx, Notification_GetDeliveryStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNotificationsResponse)
+	err := c.cc.Invoke(ctx, Notification_ListNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationClient) SetChannel(ctx context.Context, in *SetChannelRequest, opts ...grpc.CallOption) (*SetChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetChannelResponse)
+	err := c.cc.Invoke(ctx, Notification_SetChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationClient) ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChannelsResponse)
+	err := c.cc.Invoke(ctx, Notification_ListChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationClient) DeleteChannel(ctx context.Context, in *DeleteChannelRequest, opts ...grpc.CallOption) (*DeleteChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteChannelResponse)
+	err := c.cc.Invoke(ctx, Notification_DeleteChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationClient) GetWebPushKey(ctx context.Context, in *GetWebPushKeyRequest, opts ...grpc.CallOption) (*GetWebPushKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWebPushKeyResponse)
+	err := c.cc.Invoke(ctx, Notification_GetWebPushKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationClient) GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*GetPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPreferencesResponse)
+	err := c.cc.Invoke(ctx, Notification_GetPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationClient) UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...grpc.CallOption) (*UpdatePreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePreferencesResponse)
+	err := c.cc.Invoke(ctx, Notification_UpdatePreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationClient) MuteQueue(ctx context.Context, in *MuteQueueRequest, opts ...grpc.CallOption) (*MuteQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteQueueResponse)
+	err := c.cc.Invoke(ctx, Notification_MuteQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationClient) UnmuteQueue(ctx context.Context, in *UnmuteQueueRequest, opts ...grpc.CallOption) (*UnmuteQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnmuteQueueResponse)
+	err := c.cc.Invoke(ctx, Notification_UnmuteQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NotificationServer is the server API for Notification service.
 // All implementations must embed UnimplementedNotificationServer
 // for forward compatibility.
 type NotificationServer interface {
+	// Notifies only on transitions: entered the head of the queue, became next, got their turn.
 	NotifyPositionSoon(context.Context, *NotifyPositionSoonRequest) (*NotifyPositionSoonResponse, error)
+	// Tells group members that a scheduled queue is open for joining.
+	NotifyQueueOpened(context.Context, *NotifyQueueOpenedRequest) (*NotifyQueueOpenedResponse, error)
+	// Tells users still in the queue that it was archived.
+	NotifyQueueArchived(context.Context, *NotifyQueueArchivedRequest) (*NotifyQueueArchivedResponse, error)
+	// Tells a participant that the owner removed them from the queue.
+	NotifyParticipantRemoved(context.Context, *NotifyParticipantRemovedRequest) (*NotifyParticipantRemovedResponse, error)
+	// Tells the participant called to the head to come and check in; Telegram gets a check-in button.
+	NotifyCalled(context.Context, *NotifyCalledRequest) (*NotifyCalledResponse, error)
+	// Asks a participant to answer another one's swap request; Telegram gets an accept button.
+	NotifySwapRequested(context.Context, *NotifySwapRequestedRequest) (*NotifySwapRequestedResponse, error)
+	// Tells the requester whether the swap was accepted.
+	NotifySwapAnswered(context.Context, *NotifySwapAnsweredRequest) (*NotifySwapAnsweredResponse, error)
 	SetContact(context.Context, *SetContactRequest) (*SetContactResponse, error)
+	// Telegram link status of the user; NOT_FOUND if nothing is linked.
+	GetContact(context.Context, *GetContactRequest) (*GetContactResponse, error)
+	// Unlinks the user's Telegram and sends a confirmation to the old chat; NOT_FOUND if nothing is linked.
+	UnlinkContact(context.Context, *UnlinkContactRequest) (*UnlinkContactResponse, error)
 	// Issues a start-link token for the user; frontend uses it in https://t.me/<bot>?start=<token>.
+	// The token expires after a configured TTL (15 minutes by default).
 	CreateLinkToken(context.Context, *CreateLinkTokenRequest) (*CreateLinkTokenResponse, error)
 	// Consumes the token received from Telegram /start webhook and binds chat_id to user.
+	// NOT_FOUND if the token is unknown, used or expired.
 	BindByToken(context.Context, *BindByTokenRequest) (*BindByTokenResponse, error)
+	// Finds the user bound to a Telegram chat; NOT_FOUND if the chat is not linked.
+	ResolveChat(context.Context, *ResolveChatRequest) (*ResolveChatResponse, error)
+	// Returns the delivery state of a notification; user_id, when set, must own it.
+	GetDeliveryStatus(context.Context, *GetDeliveryStatusRequest) (*GetDeliveryStatusResponse, error)
+	// Lists notifications newest first; pass next_before_id as before_id for the next page.
+	ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error)
+	// Creates or replaces the user's settings of one channel: telegram, email, webhook or webpush.
+	SetChannel(context.Context, *SetChannelRequest) (*SetChannelResponse, error)
+	// Channels the user has set up; without any, notifications go to Telegram.
+	ListChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error)
+	DeleteChannel(context.Context, *DeleteChannelRequest) (*DeleteChannelResponse, error)
+	// VAPID public key for browser push subscriptions.
+	GetWebPushKey(context.Context, *GetWebPushKeyRequest) (*GetWebPushKeyResponse, error)
+	// Notification settings of the user; defaults if never saved.
+	GetPreferences(context.Context, *GetPreferencesRequest) (*GetPreferencesResponse, error)
+	// Replaces all settings; zero values mean the defaults. Muted queues are kept.
+	UpdatePreferences(context.Context, *UpdatePreferencesRequest) (*UpdatePreferencesResponse, error)
+	// Stops all notifications about one queue for the user.
+	MuteQueue(context.Context, *MuteQueueRequest) (*MuteQueueResponse, error)
+	UnmuteQueue(context.Context, *UnmuteQueueRequest) (*UnmuteQueueResponse, error)
 	mustEmbedUnimplementedNotificationServer()
 }
 
@@ -108,15 +395,72 @@ type UnimplementedNotificationServer struct{}
 func (UnimplementedNotificationServer) NotifyPositionSoon(context.Context, *NotifyPositionSoonRequest) (*NotifyPositionSoonResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NotifyPositionSoon not implemented")
 }
+func (UnimplementedNotificationServer) NotifyQueueOpened(context.Context, *NotifyQueueOpenedRequest) (*NotifyQueueOpenedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifyQueueOpened not implemented")
+}
+func (UnimplementedNotificationServer) NotifyQueueArchived(context.Context, *NotifyQueueArchivedRequest) (*NotifyQueueArchivedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifyQueueArchived not implemented")
+}
+func (UnimplementedNotificationServer) NotifyParticipantRemoved(context.Context, *NotifyParticipantRemovedRequest) (*NotifyParticipantRemovedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifyParticipantRemoved not implemented")
+}
+func (UnimplementedNotificationServer) NotifyCalled(context.Context, *NotifyCalledRequest) (*NotifyCalledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifyCalled not implemented")
+}
+func (UnimplementedNotificationServer) NotifySwapRequested(context.Context, *NotifySwapRequestedRequest) (*NotifySwapRequestedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifySwapRequested not implemented")
+}
+func (UnimplementedNotificationServer) NotifySwapAnswered(context.Context, *NotifySwapAnsweredRequest) (*NotifySwapAnsweredResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifySwapAnswered not implemented")
+}
 func (UnimplementedNotificationServer) SetContact(context.Context, *SetContactRequest) (*SetContactResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetContact not implemented")
 }
+func (UnimplementedNotificationServer) GetContact(context.Context, *GetContactRequest) (*GetContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContact not implemented")
+}
+func (UnimplementedNotificationServer) UnlinkContact(context.Context, *UnlinkContactRequest) (*UnlinkContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkContact not implemented")
+}
 func (UnimplementedNotificationServer) CreateLinkToken(context.Context, *CreateLinkTokenRequest) (*CreateLinkTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateLinkToken not implemented")
 }
 func (UnimplementedNotificationServer) BindByToken(context.Context, *BindByTokenRequest) (*BindByTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BindByToken not implemented")
 }
+func (UnimplementedNotificationServer) ResolveChat(context.Context, *ResolveChatRequest) (*ResolveChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveChat not implemented")
+}
+func (UnimplementedNotificationServer) GetDeliveryStatus(context.Context, *GetDeliveryStatusRequest) (*GetDeliveryStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeliveryStatus not implemented")
+}
+func (UnimplementedNotificationServer) ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (UnimplementedNotificationServer) SetChannel(context.Context, *SetChannelRequest) (*SetChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChannel not implemented")
+}
+func (UnimplementedNotificationServer) ListChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChannels not implemented")
+}
+func (UnimplementedNotificationServer) DeleteChannel(context.Context, *DeleteChannelRequest) (*DeleteChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChannel not implemented")
+}
+func (UnimplementedNotificationServer) GetWebPushKey(context.Context, *GetWebPushKeyRequest) (*GetWebPushKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebPushKey not implemented")
+}
+func (UnimplementedNotificationServer) GetPreferences(context.Context, *GetPreferencesRequest) (*GetPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreferences not implemented")
+}
+func (UnimplementedNotificationServer) UpdatePreferences(context.Context, *UpdatePreferencesRequest) (*UpdatePreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePreferences not implemented")
+}
+func (UnimplementedNotificationServer) MuteQueue(context.Context, *MuteQueueRequest) (*MuteQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteQueue not implemented")
+}
+func (UnimplementedNotificationServer) UnmuteQueue(context.Context, *UnmuteQueueRequest) (*UnmuteQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmuteQueue not implemented")
+}
 func (UnimplementedNotificationServer) mustEmbedUnimplementedNotificationServer() {}
 func (UnimplementedNotificationServer) testEmbeddedByValue()                      {}
 
@@ -156,6 +500,114 @@ func _Notification_NotifyPositionSoon_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Notification_NotifyQueueOpened_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifyQueueOpenedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServer).NotifyQueueOpened(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notification_NotifyQueueOpened_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServer).NotifyQueueOpened(ctx, req.(*NotifyQueueOpenedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notification_NotifyQueueArchived_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifyQueueArchivedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServer).NotifyQueueArchived(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notification_NotifyQueueArchived_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServer).NotifyQueueArchived(ctx, req.(*NotifyQueueArchivedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notification_NotifyParticipantRemoved_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifyParticipantRemovedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServer).NotifyParticipantRemoved(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notification_NotifyParticipantRemoved_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServer).NotifyParticipantRemoved(ctx, req.(*NotifyParticipantRemovedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notification_NotifyCalled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifyCalledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServer).NotifyCalled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notification_NotifyCalled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServer).NotifyCalled(ctx, req.(*NotifyCalledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notification_NotifySwapRequested_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifySwapRequestedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServer).NotifySwapRequested(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notification_NotifySwapRequested_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServer).NotifySwapRequested(ctx, req.(*NotifySwapRequestedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notification_NotifySwapAnswered_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifySwapAnsweredRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServer).NotifySwapAnswered(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notification_NotifySwapAnswered_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServer).NotifySwapAnswered(ctx, req.(*NotifySwapAnsweredRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Notification_SetContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetContactRequest)
 	if err := dec(in); err != nil {
@@ -174,6 +626,42 @@ func _Notification_SetContact_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Notification_GetContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServer).GetContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notification_GetContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServer).GetContact(ctx, req.(*GetContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notification_UnlinkContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServer).UnlinkContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notification_UnlinkContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServer).UnlinkContact(ctx, req.(*UnlinkContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Notification_CreateLinkToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateLinkTokenRequest)
 	if err := dec(in); err != nil {
@@ -210,6 +698,204 @@ func _Notification_BindByToken_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Notification_ResolveChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServer).ResolveChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notification_ResolveChat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServer).ResolveChat(ctx, req.(*ResolveChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notification_GetDeliveryStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeliveryStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServer).GetDeliveryStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notification_GetDeliveryStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServer).GetDeliveryStatus(ctx, req.(*GetDeliveryStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notification_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServer).ListNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notification_ListNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServer).ListNotifications(ctx, req.(*ListNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notification_SetChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServer).SetChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notification_SetChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServer).SetChannel(ctx, req.(*SetChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notification_ListChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServer).ListChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notification_ListChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServer).ListChannels(ctx, req.(*ListChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notification_DeleteChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServer).DeleteChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notification_DeleteChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServer).DeleteChannel(ctx, req.(*DeleteChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notification_GetWebPushKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebPushKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServer).GetWebPushKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notification_GetWebPushKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServer).GetWebPushKey(ctx, req.(*GetWebPushKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notification_GetPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServer).GetPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notification_GetPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServer).GetPreferences(ctx, req.(*GetPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notification_UpdatePreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServer).UpdatePreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notification_UpdatePreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServer).UpdatePreferences(ctx, req.(*UpdatePreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notification_MuteQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServer).MuteQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notification_MuteQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServer).MuteQueue(ctx, req.(*MuteQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notification_UnmuteQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmuteQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServer).UnmuteQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notification_UnmuteQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServer).UnmuteQueue(ctx, req.(*UnmuteQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Notification_ServiceDesc is the grpc.ServiceDesc for Notification service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -221,10 +907,42 @@ var Notification_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NotifyPositionSoon",
 			Handler:    _Notification_NotifyPositionSoon_Handler,
 		},
+		{
+			MethodName: "NotifyQueueOpened",
+			Handler:    _Notification_NotifyQueueOpened_Handler,
+		},
+		{
+			MethodName: "NotifyQueueArchived",
+			Handler:    _Notification_NotifyQueueArchived_Handler,
+		},
+		{
+			MethodName: "NotifyParticipantRemoved",
+			Handler:    _Notification_NotifyParticipantRemoved_Handler,
+		},
+		{
+			MethodName: "NotifyCalled",
+			Handler:    _Notification_NotifyCalled_Handler,
+		},
+		{
+			MethodName: "NotifySwapRequested",
+			Handler:    _Notification_NotifySwapRequested_Handler,
+		},
+		{
+			MethodName: "NotifySwapAnswered",
+			Handler:    _Notification_NotifySwapAnswered_Handler,
+		},
 		{
 			MethodName: "SetContact",
 			Handler:    _Notification_SetContact_Handler,
 		},
+		{
+			MethodName: "GetContact",
+			Handler:    _Notification_GetContact_Handler,
+		},
+		{
+			MethodName: "UnlinkContact",
+			Handler:    _Notification_UnlinkContact_Handler,
+		},
 		{
 			MethodName: "CreateLinkToken",
 			Handler:    _Notification_CreateLinkToken_Handler,
@@ -233,6 +951,50 @@ var Notification_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BindByToken",
 			Handler:    _Notification_BindByToken_Handler,
 		},
+		{
+			MethodName: "ResolveChat",
+			Handler:    _Notification_ResolveChat_Handler,
+		},
+		{
+			MethodName: "GetDeliveryStatus",
+			Handler:    _Notification_GetDeliveryStatus_Handler,
+		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _Notification_ListNotifications_Handler,
+		},
+		{
+			MethodName: "SetChannel",
+			Handler:    _Notification_SetChannel_Handler,
+		},
+		{
+			MethodName: "ListChannels",
+			Handler:    _Notification_ListChannels_Handler,
+		},
+		{
+			MethodName: "DeleteChannel",
+			Handler:    _Notification_DeleteChannel_Handler,
+		},
+		{
+			MethodName: "GetWebPushKey",
+			Handler:    _Notification_GetWebPushKey_Handler,
+		},
+		{
+			MethodName: "GetPreferences",
+			Handler:    _Notification_GetPreferences_Handler,
+		},
+		{
+			MethodName: "UpdatePreferences",
+			Handler:    _Notification_UpdatePreferences_Handler,
+		},
+		{
+			MethodName: "MuteQueue",
+			Handler:    _Notification_MuteQueue_Handler,
+		},
+		{
+			MethodName: "UnmuteQueue",
+			Handler:    _Notification_UnmuteQueue_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "notification/notification.proto",
@@ -29,6 +29,7 @@ const (
 	QueueMode_QUEUE_MODE_MANAGED     QueueMode = 2
 	QueueMode_QUEUE_MODE_RANDOM      QueueMode = 3
 	QueueMode_QUEUE_MODE_SLOTS       QueueMode = 4
+	QueueMode_QUEUE_MODE_PRIORITY    QueueMode = 5
 )
 
 // Enum value maps for QueueMode.
@@ -39,6 +40,7 @@ var (
 		2: "QUEUE_MODE_MANAGED",
 		3: "QUEUE_MODE_RANDOM",
 		4: "QUEUE_MODE_SLOTS",
+		5: "QUEUE_MODE_PRIORITY",
 	}
 	QueueMode_value = map[string]int32{
 		"QUEUE_MODE_UNSPECIFIED": 0,
@@ -46,6 +48,7 @@ var (
 		"QUEUE_MODE_MANAGED":     2,
 		"QUEUE_MODE_RANDOM":      3,
 		"QUEUE_MODE_SLOTS":       4,
+		"QUEUE_MODE_PRIORITY":    5,
 	}
 )
 
@@ -82,6 +85,8 @@ const (
 	QueueStatus_QUEUE_STATUS_UNSPECIFIED QueueStatus = 0
 	QueueStatus_QUEUE_STATUS_ACTIVE      QueueStatus = 1
 	QueueStatus_QUEUE_STATUS_ARCHIVED    QueueStatus = 2
+	QueueStatus_QUEUE_STATUS_SCHEDULED   QueueStatus = 3
+	QueueStatus_QUEUE_STATUS_CLOSED      QueueStatus = 4
 )
 
 // Enum value maps for QueueStatus.
@@ -90,11 +95,15 @@ var (
 		0: "QUEUE_STATUS_UNSPECIFIED",
 		1: "QUEUE_STATUS_ACTIVE",
 		2: "QUEUE_STATUS_ARCHIVED",
+		3: "QUEUE_STATUS_SCHEDULED",
+		4: "QUEUE_STATUS_CLOSED",
 	}
 	QueueStatus_value = map[string]int32{
 		"QUEUE_STATUS_UNSPECIFIED": 0,
 		"QUEUE_STATUS_ACTIVE":      1,
 		"QUEUE_STATUS_ARCHIVED":    2,
+		"QUEUE_STATUS_SCHEDULED":   3,
+		"QUEUE_STATUS_CLOSED":      4,
 	}
 )
 
@@ -125,6 +134,64 @@ func (QueueStatus) EnumDescriptor() ([]byte, []int) {
 	return file_queue_queue_proto_rawDescGZIP(), []int{1}
 }
 
+type SwapStatus int32
+
+const (
+	SwapStatus_SWAP_STATUS_UNSPECIFIED SwapStatus = 0
+	SwapStatus_SWAP_STATUS_PENDING     SwapStatus = 1
+	SwapStatus_SWAP_STATUS_ACCEPTED    SwapStatus = 2
+	SwapStatus_SWAP_STATUS_DECLINED    SwapStatus = 3
+	SwapStatus_SWAP_STATUS_CANCELLED   SwapStatus = 4
+	SwapStatus_SWAP_STATUS_EXPIRED     SwapStatus = 5
+)
+
+// Enum value maps for SwapStatus.
+var (
+	SwapStatus_name = map[int32]string{
+		0: "SWAP_STATUS_UNSPECIFIED",
+		1: "SWAP_STATUS_PENDING",
+		2: "SWAP_STATUS_ACCEPTED",
+		3: "SWAP_STATUS_DECLINED",
+		4: "SWAP_STATUS_CANCELLED",
+		5: "SWAP_STATUS_EXPIRED",
+	}
+	SwapStatus_value = map[string]int32{
+		"SWAP_STATUS_UNSPECIFIED": 0,
+		"SWAP_STATUS_PENDING":     1,
+		"SWAP_STATUS_ACCEPTED":    2,
+		"SWAP_STATUS_DECLINED":    3,
+		"SWAP_STATUS_CANCELLED":   4,
+		"SWAP_STATUS_EXPIRED":     5,
+	}
+)
+
+func (x SwapStatus) Enum() *SwapStatus {
+	p := new(SwapStatus)
+	*p = x
+	return p
+}
+
+func (x SwapStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SwapStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_queue_queue_proto_enumTypes[2].Descriptor()
+}
+
+func (SwapStatus) Type() protoreflect.EnumType {
+	return &file_queue_queue_proto_enumTypes[2]
+}
+
+func (x SwapStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SwapStatus.Descriptor instead.
+func (SwapStatus) EnumDescriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{2}
+}
+
 type QueueDTO struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -136,6 +203,16 @@ type QueueDTO struct {
 	OwnerId       int64                  `protobuf:"varint,7,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
 	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     int64                  `protobuf:"varint,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	OpensAt       int64                  `protobuf:"varint,10,opt,name=opens_at,json=opensAt,proto3" json:"opens_at,omitempty"`                     // unix seconds, 0 if not scheduled
+	ClosesAt      int64                  `protobuf:"varint,11,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`                  // unix seconds, 0 if open-ended
+	NotifyTop     int32                  `protobuf:"varint,12,opt,name=notify_top,json=notifyTop,proto3" json:"notify_top,omitempty"`               // how many participants at the head get "your turn is near"
+	CallTimeout   int32                  `protobuf:"varint,13,opt,name=call_timeout,json=callTimeout,proto3" json:"call_timeout,omitempty"`         // seconds a called participant has to check in, 0 if calls are off
+	CallMissShift int32                  `protobuf:"varint,14,opt,name=call_miss_shift,json=callMissShift,proto3" json:"call_miss_shift,omitempty"` // places a participant who missed the call is moved back, 0 skips them
+	SwapsAllowed  bool                   `protobuf:"varint,15,opt,name=swaps_allowed,json=swapsAllowed,proto3" json:"swaps_allowed,omitempty"`      // participants may swap places by agreement
+	LotteryAt     int64                  `protobuf:"varint,16,opt,name=lottery_at,json=lotteryAt,proto3" json:"lottery_at,omitempty"`               // random mode: unix seconds of the draw, 0 if the owner draws by hand
+	LotterySeed   string                 `protobuf:"bytes,17,opt,name=lottery_seed,json=lotterySeed,proto3" json:"lottery_seed,omitempty"`          // random mode: hex seed of the draw, empty until drawn
+	DrawnAt       int64                  `protobuf:"varint,18,opt,name=drawn_at,json=drawnAt,proto3" json:"drawn_at,omitempty"`                     // random mode: unix seconds of the draw, 0 until drawn
+	LotteryCommit string                 `protobuf:"bytes,19,opt,name=lottery_commit,json=lotteryCommit,proto3" json:"lottery_commit,omitempty"`    // random mode: hex SHA-256 of the seed, published at creation
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -233,6 +310,76 @@ func (x *QueueDTO) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *QueueDTO) GetOpensAt() int64 {
+	if x != nil {
+		return x.OpensAt
+	}
+	return 0
+}
+
+func (x *QueueDTO) GetClosesAt() int64 {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return 0
+}
+
+func (x *QueueDTO) GetNotifyTop() int32 {
+	if x != nil {
+		return x.NotifyTop
+	}
+	return 0
+}
+
+func (x *QueueDTO) GetCallTimeout() int32 {
+	if x != nil {
+		return x.CallTimeout
+	}
+	return 0
+}
+
+func (x *QueueDTO) GetCallMissShift() int32 {
+	if x != nil {
+		return x.CallMissShift
+	}
+	return 0
+}
+
+func (x *QueueDTO) GetSwapsAllowed() bool {
+	if x != nil {
+		return x.SwapsAllowed
+	}
+	return false
+}
+
+func (x *QueueDTO) GetLotteryAt() int64 {
+	if x != nil {
+		return x.LotteryAt
+	}
+	return 0
+}
+
+func (x *QueueDTO) GetLotterySeed() string {
+	if x != nil {
+		return x.LotterySeed
+	}
+	return ""
+}
+
+func (x *QueueDTO) GetDrawnAt() int64 {
+	if x != nil {
+		return x.DrawnAt
+	}
+	return 0
+}
+
+func (x *QueueDTO) GetLotteryCommit() string {
+	if x != nil {
+		return x.LotteryCommit
+	}
+	return ""
+}
+
 type ParticipantDTO struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -242,6 +389,10 @@ type ParticipantDTO struct {
 	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	SlotTime      string                 `protobuf:"bytes,6,opt,name=slot_time,json=slotTime,proto3" json:"slot_time,omitempty"`
 	FullName      string                 `protobuf:"bytes,7,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	CalledAt      int64                  `protobuf:"varint,8,opt,name=called_at,json=calledAt,proto3" json:"called_at,omitempty"`            // unix seconds, 0 if not called
+	CheckedInAt   int64                  `protobuf:"varint,9,opt,name=checked_in_at,json=checkedInAt,proto3" json:"checked_in_at,omitempty"` // unix seconds, 0 if not checked in
+	Deferrals     int32                  `protobuf:"varint,10,opt,name=deferrals,proto3" json:"deferrals,omitempty"`                         // times the participant let others go first
+	Priority      int32                  `protobuf:"varint,11,opt,name=priority,proto3" json:"priority,omitempty"`                           // priority mode: higher goes first, 0 by default
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -325,27 +476,61 @@ func (x *ParticipantDTO) GetFullName() string {
 	return ""
 }
 
-type ListQueuesRequest struct {
+func (x *ParticipantDTO) GetCalledAt() int64 {
+	if x != nil {
+		return x.CalledAt
+	}
+	return 0
+}
+
+func (x *ParticipantDTO) GetCheckedInAt() int64 {
+	if x != nil {
+		return x.CheckedInAt
+	}
+	return 0
+}
+
+func (x *ParticipantDTO) GetDeferrals() int32 {
+	if x != nil {
+		return x.Deferrals
+	}
+	return 0
+}
+
+func (x *ParticipantDTO) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type QueueEventDTO struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupCode     string                 `protobuf:"bytes,1,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	QueueId       int64                  `protobuf:"varint,2,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                       // created, updated, archived, deleted, joined, added, left, removed, advanced, skipped, called, checked_in, call_missed, moved, reordered, deferred, drawn, priority_changed, role_granted, role_revoked
+	ActorId       int64                  `protobuf:"varint,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"` // who performed the action
+	UserId        int64                  `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`    // affected participant, 0 for queue-level events
+	Position      int32                  `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`              // participant position at the time of the event
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListQueuesRequest) Reset() {
-	*x = ListQueuesRequest{}
+func (x *QueueEventDTO) Reset() {
+	*x = QueueEventDTO{}
 	mi := &file_queue_queue_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListQueuesRequest) String() string {
+func (x *QueueEventDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListQueuesRequest) ProtoMessage() {}
+func (*QueueEventDTO) ProtoMessage() {}
 
-func (x *ListQueuesRequest) ProtoReflect() protoreflect.Message {
+func (x *QueueEventDTO) ProtoReflect() protoreflect.Message {
 	mi := &file_queue_queue_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -357,39 +542,90 @@ func (x *ListQueuesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListQueuesRequest.ProtoReflect.Descriptor instead.
-func (*ListQueuesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use QueueEventDTO.ProtoReflect.Descriptor instead.
+func (*QueueEventDTO) Descriptor() ([]byte, []int) {
 	return file_queue_queue_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ListQueuesRequest) GetGroupCode() string {
+func (x *QueueEventDTO) GetId() int64 {
 	if x != nil {
-		return x.GroupCode
+		return x.Id
+	}
+	return 0
+}
+
+func (x *QueueEventDTO) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *QueueEventDTO) GetType() string {
+	if x != nil {
+		return x.Type
 	}
 	return ""
 }
 
-type ListQueuesResponse struct {
+func (x *QueueEventDTO) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *QueueEventDTO) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *QueueEventDTO) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *QueueEventDTO) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type HistoryEntryDTO struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Queues        []*QueueDTO            `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	QueueId       int64                  `protobuf:"varint,2,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FullName      string                 `protobuf:"bytes,4,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Position      int32                  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"` // position when the participant left the queue
+	SlotTime      string                 `protobuf:"bytes,6,opt,name=slot_time,json=slotTime,proto3" json:"slot_time,omitempty"`
+	Outcome       string                 `protobuf:"bytes,7,opt,name=outcome,proto3" json:"outcome,omitempty"` // served, skipped, left, removed
+	ActorId       int64                  `protobuf:"varint,8,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	JoinedAt      int64                  `protobuf:"varint,9,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	FinishedAt    int64                  `protobuf:"varint,10,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListQueuesResponse) Reset() {
-	*x = ListQueuesResponse{}
+func (x *HistoryEntryDTO) Reset() {
+	*x = HistoryEntryDTO{}
 	mi := &file_queue_queue_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListQueuesResponse) String() string {
+func (x *HistoryEntryDTO) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListQueuesResponse) ProtoMessage() {}
+func (*HistoryEntryDTO) ProtoMessage() {}
 
-func (x *ListQueuesResponse) ProtoReflect() protoreflect.Message {
+func (x *HistoryEntryDTO) ProtoReflect() protoreflect.Message {
 	mi := &file_queue_queue_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -401,116 +637,104 @@ func (x *ListQueuesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListQueuesResponse.ProtoReflect.Descriptor instead.
-func (*ListQueuesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HistoryEntryDTO.ProtoReflect.Descriptor instead.
+func (*HistoryEntryDTO) Descriptor() ([]byte, []int) {
 	return file_queue_queue_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ListQueuesResponse) GetQueues() []*QueueDTO {
+func (x *HistoryEntryDTO) GetId() int64 {
 	if x != nil {
-		return x.Queues
+		return x.Id
 	}
-	return nil
-}
-
-type CreateQueueRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Mode          QueueMode              `protobuf:"varint,3,opt,name=mode,proto3,enum=queue.QueueMode" json:"mode,omitempty"`
-	GroupCode     string                 `protobuf:"bytes,4,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
-	OwnerId       int64                  `protobuf:"varint,5,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return 0
 }
 
-func (x *CreateQueueRequest) Reset() {
-	*x = CreateQueueRequest{}
-	mi := &file_queue_queue_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *HistoryEntryDTO) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
 }
 
-func (x *CreateQueueRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *HistoryEntryDTO) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
 }
 
-func (*CreateQueueRequest) ProtoMessage() {}
-
-func (x *CreateQueueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_queue_queue_proto_msgTypes[4]
+func (x *HistoryEntryDTO) GetFullName() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.FullName
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CreateQueueRequest.ProtoReflect.Descriptor instead.
-func (*CreateQueueRequest) Descriptor() ([]byte, []int) {
-	return file_queue_queue_proto_rawDescGZIP(), []int{4}
+func (x *HistoryEntryDTO) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
 }
 
-func (x *CreateQueueRequest) GetTitle() string {
+func (x *HistoryEntryDTO) GetSlotTime() string {
 	if x != nil {
-		return x.Title
+		return x.SlotTime
 	}
 	return ""
 }
 
-func (x *CreateQueueRequest) GetDescription() string {
+func (x *HistoryEntryDTO) GetOutcome() string {
 	if x != nil {
-		return x.Description
+		return x.Outcome
 	}
 	return ""
 }
 
-func (x *CreateQueueRequest) GetMode() QueueMode {
+func (x *HistoryEntryDTO) GetActorId() int64 {
 	if x != nil {
-		return x.Mode
+		return x.ActorId
 	}
-	return QueueMode_QUEUE_MODE_UNSPECIFIED
+	return 0
 }
 
-func (x *CreateQueueRequest) GetGroupCode() string {
+func (x *HistoryEntryDTO) GetJoinedAt() int64 {
 	if x != nil {
-		return x.GroupCode
+		return x.JoinedAt
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateQueueRequest) GetOwnerId() int64 {
+func (x *HistoryEntryDTO) GetFinishedAt() int64 {
 	if x != nil {
-		return x.OwnerId
+		return x.FinishedAt
 	}
 	return 0
 }
 
-type CreateQueueResponse struct {
+type ListQueuesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Queue         *QueueDTO              `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	GroupCode     string                 `protobuf:"bytes,1,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
+	RequesterId   int64                  `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"` // with empty group_code: queues of all the requester's groups
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateQueueResponse) Reset() {
-	*x = CreateQueueResponse{}
-	mi := &file_queue_queue_proto_msgTypes[5]
+func (x *ListQueuesRequest) Reset() {
+	*x = ListQueuesRequest{}
+	mi := &file_queue_queue_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateQueueResponse) String() string {
+func (x *ListQueuesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateQueueResponse) ProtoMessage() {}
+func (*ListQueuesRequest) ProtoMessage() {}
 
-func (x *CreateQueueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_queue_queue_proto_msgTypes[5]
+func (x *ListQueuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -521,29 +745,233 @@ func (x *CreateQueueResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateQueueResponse.ProtoReflect.Descriptor instead.
-func (*CreateQueueResponse) Descriptor() ([]byte, []int) {
-	return file_queue_queue_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ListQueuesRequest.ProtoReflect.Descriptor instead.
+func (*ListQueuesRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *CreateQueueResponse) GetQueue() *QueueDTO {
+func (x *ListQueuesRequest) GetGroupCode() string {
 	if x != nil {
-		return x.Queue
+		return x.GroupCode
 	}
-	return nil
+	return ""
 }
 
-type GetQueueRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	QueueId       int64                  `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+func (x *ListQueuesRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+type ListQueuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queues        []*QueueDTO            `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueuesResponse) Reset() {
+	*x = ListQueuesResponse{}
+	mi := &file_queue_queue_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueuesResponse) ProtoMessage() {}
+
+func (x *ListQueuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueuesResponse.ProtoReflect.Descriptor instead.
+func (*ListQueuesResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListQueuesResponse) GetQueues() []*QueueDTO {
+	if x != nil {
+		return x.Queues
+	}
+	return nil
+}
+
+type CreateQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Mode          QueueMode              `protobuf:"varint,3,opt,name=mode,proto3,enum=queue.QueueMode" json:"mode,omitempty"`
+	GroupCode     string                 `protobuf:"bytes,4,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
+	OwnerId       int64                  `protobuf:"varint,5,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	OpensAt       int64                  `protobuf:"varint,6,opt,name=opens_at,json=opensAt,proto3" json:"opens_at,omitempty"`       // unix seconds, optional
+	ClosesAt      int64                  `protobuf:"varint,7,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`    // unix seconds, optional
+	NotifyTop     int32                  `protobuf:"varint,8,opt,name=notify_top,json=notifyTop,proto3" json:"notify_top,omitempty"` // optional, 1..50, default 3
+	LotteryAt     int64                  `protobuf:"varint,9,opt,name=lottery_at,json=lotteryAt,proto3" json:"lottery_at,omitempty"` // random mode: unix seconds of the draw, optional, defaults to closes_at
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateQueueRequest) Reset() {
+	*x = CreateQueueRequest{}
+	mi := &file_queue_queue_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQueueRequest) ProtoMessage() {}
+
+func (x *CreateQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQueueRequest.ProtoReflect.Descriptor instead.
+func (*CreateQueueRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateQueueRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateQueueRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateQueueRequest) GetMode() QueueMode {
+	if x != nil {
+		return x.Mode
+	}
+	return QueueMode_QUEUE_MODE_UNSPECIFIED
+}
+
+func (x *CreateQueueRequest) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+func (x *CreateQueueRequest) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *CreateQueueRequest) GetOpensAt() int64 {
+	if x != nil {
+		return x.OpensAt
+	}
+	return 0
+}
+
+func (x *CreateQueueRequest) GetClosesAt() int64 {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return 0
+}
+
+func (x *CreateQueueRequest) GetNotifyTop() int32 {
+	if x != nil {
+		return x.NotifyTop
+	}
+	return 0
+}
+
+func (x *CreateQueueRequest) GetLotteryAt() int64 {
+	if x != nil {
+		return x.LotteryAt
+	}
+	return 0
+}
+
+type CreateQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         *QueueDTO              `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateQueueResponse) Reset() {
+	*x = CreateQueueResponse{}
+	mi := &file_queue_queue_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQueueResponse) ProtoMessage() {}
+
+func (x *CreateQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQueueResponse.ProtoReflect.Descriptor instead.
+func (*CreateQueueResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateQueueResponse) GetQueue() *QueueDTO {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+type GetQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueId       int64                  `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
 	GroupCode     string                 `protobuf:"bytes,2,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
+	RequesterId   int64                  `protobuf:"varint,3,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetQueueRequest) Reset() {
 	*x = GetQueueRequest{}
-	mi := &file_queue_queue_proto_msgTypes[6]
+	mi := &file_queue_queue_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -555,7 +983,7 @@ func (x *GetQueueRequest) String() string {
 func (*GetQueueRequest) ProtoMessage() {}
 
 func (x *GetQueueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_queue_queue_proto_msgTypes[6]
+	mi := &file_queue_queue_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -568,7 +996,7 @@ func (x *GetQueueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetQueueRequest.ProtoReflect.Descriptor instead.
 func (*GetQueueRequest) Descriptor() ([]byte, []int) {
-	return file_queue_queue_proto_rawDescGZIP(), []int{6}
+	return file_queue_queue_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetQueueRequest) GetQueueId() int64 {
@@ -585,6 +1013,13 @@ func (x *GetQueueRequest) GetGroupCode() string {
 	return ""
 }
 
+func (x *GetQueueRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
 type GetQueueResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Queue         *QueueDTO              `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
@@ -595,7 +1030,7 @@ type GetQueueResponse struct {
 
 func (x *GetQueueResponse) Reset() {
 	*x = GetQueueResponse{}
-	mi := &file_queue_queue_proto_msgTypes[7]
+	mi := &file_queue_queue_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -607,7 +1042,7 @@ func (x *GetQueueResponse) String() string {
 func (*GetQueueResponse) ProtoMessage() {}
 
 func (x *GetQueueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_queue_queue_proto_msgTypes[7]
+	mi := &file_queue_queue_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -620,7 +1055,7 @@ func (x *GetQueueResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetQueueResponse.ProtoReflect.Descriptor instead.
 func (*GetQueueResponse) Descriptor() ([]byte, []int) {
-	return file_queue_queue_proto_rawDescGZIP(), []int{7}
+	return file_queue_queue_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetQueueResponse) GetQueue() *QueueDTO {
@@ -650,7 +1085,7 @@ type JoinQueueRequest struct {
 
 func (x *JoinQueueRequest) Reset() {
 	*x = JoinQueueRequest{}
-	mi := &file_queue_queue_proto_msgTypes[8]
+	mi := &file_queue_queue_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -662,7 +1097,7 @@ func (x *JoinQueueRequest) String() string {
 func (*JoinQueueRequest) ProtoMessage() {}
 
 func (x *JoinQueueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_queue_queue_proto_msgTypes[8]
+	mi := &file_queue_queue_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -675,7 +1110,7 @@ func (x *JoinQueueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinQueueRequest.ProtoReflect.Descriptor instead.
 func (*JoinQueueRequest) Descriptor() ([]byte, []int) {
-	return file_queue_queue_proto_rawDescGZIP(), []int{8}
+	return file_queue_queue_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *JoinQueueRequest) GetQueueId() int64 {
@@ -722,7 +1157,7 @@ type JoinQueueResponse struct {
 
 func (x *JoinQueueResponse) Reset() {
 	*x = JoinQueueResponse{}
-	mi := &file_queue_queue_proto_msgTypes[9]
+	mi := &file_queue_queue_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -734,7 +1169,7 @@ func (x *JoinQueueResponse) String() string {
 func (*JoinQueueResponse) ProtoMessage() {}
 
 func (x *JoinQueueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_queue_queue_proto_msgTypes[9]
+	mi := &file_queue_queue_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -747,7 +1182,7 @@ func (x *JoinQueueResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinQueueResponse.ProtoReflect.Descriptor instead.
 func (*JoinQueueResponse) Descriptor() ([]byte, []int) {
-	return file_queue_queue_proto_rawDescGZIP(), []int{9}
+	return file_queue_queue_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *JoinQueueResponse) GetPosition() int32 {
@@ -768,7 +1203,7 @@ type LeaveQueueRequest struct {
 
 func (x *LeaveQueueRequest) Reset() {
 	*x = LeaveQueueRequest{}
-	mi := &file_queue_queue_proto_msgTypes[10]
+	mi := &file_queue_queue_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -780,7 +1215,7 @@ func (x *LeaveQueueRequest) String() string {
 func (*LeaveQueueRequest) ProtoMessage() {}
 
 func (x *LeaveQueueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_queue_queue_proto_msgTypes[10]
+	mi := &file_queue_queue_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -793,7 +1228,7 @@ func (x *LeaveQueueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaveQueueRequest.ProtoReflect.Descriptor instead.
 func (*LeaveQueueRequest) Descriptor() ([]byte, []int) {
-	return file_queue_queue_proto_rawDescGZIP(), []int{10}
+	return file_queue_queue_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *LeaveQueueRequest) GetQueueId() int64 {
@@ -825,7 +1260,7 @@ type LeaveQueueResponse struct {
 
 func (x *LeaveQueueResponse) Reset() {
 	*x = LeaveQueueResponse{}
-	mi := &file_queue_queue_proto_msgTypes[11]
+	mi := &file_queue_queue_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -837,7 +1272,7 @@ func (x *LeaveQueueResponse) String() string {
 func (*LeaveQueueResponse) ProtoMessage() {}
 
 func (x *LeaveQueueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_queue_queue_proto_msgTypes[11]
+	mi := &file_queue_queue_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -850,7 +1285,7 @@ func (x *LeaveQueueResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaveQueueResponse.ProtoReflect.Descriptor instead.
 func (*LeaveQueueResponse) Descriptor() ([]byte, []int) {
-	return file_queue_queue_proto_rawDescGZIP(), []int{11}
+	return file_queue_queue_proto_rawDescGZIP(), []int{13}
 }
 
 type AdvanceQueueRequest struct {
@@ -858,13 +1293,14 @@ type AdvanceQueueRequest struct {
 	QueueId       int64                  `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
 	GroupCode     string                 `protobuf:"bytes,2,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
 	ActorId       int64                  `protobuf:"varint,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"` // must be owner
+	Skip          bool                   `protobuf:"varint,4,opt,name=skip,proto3" json:"skip,omitempty"`                      // participant did not show up: recorded as skipped instead of served
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AdvanceQueueRequest) Reset() {
 	*x = AdvanceQueueRequest{}
-	mi := &file_queue_queue_proto_msgTypes[12]
+	mi := &file_queue_queue_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -876,7 +1312,7 @@ func (x *AdvanceQueueRequest) String() string {
 func (*AdvanceQueueRequest) ProtoMessage() {}
 
 func (x *AdvanceQueueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_queue_queue_proto_msgTypes[12]
+	mi := &file_queue_queue_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -889,7 +1325,7 @@ func (x *AdvanceQueueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdvanceQueueRequest.ProtoReflect.Descriptor instead.
 func (*AdvanceQueueRequest) Descriptor() ([]byte, []int) {
-	return file_queue_queue_proto_rawDescGZIP(), []int{12}
+	return file_queue_queue_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *AdvanceQueueRequest) GetQueueId() int64 {
@@ -913,16 +1349,24 @@ func (x *AdvanceQueueRequest) GetActorId() int64 {
 	return 0
 }
 
+func (x *AdvanceQueueRequest) GetSkip() bool {
+	if x != nil {
+		return x.Skip
+	}
+	return false
+}
+
 type AdvanceQueueResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Removed       *ParticipantDTO        `protobuf:"bytes,1,opt,name=removed,proto3" json:"removed,omitempty"`
+	Removed       *ParticipantDTO        `protobuf:"bytes,1,opt,name=removed,proto3" json:"removed,omitempty"` // unset when the head was only called
+	Called        *ParticipantDTO        `protobuf:"bytes,2,opt,name=called,proto3" json:"called,omitempty"`   // participant called to the head, unset if calls are off or nobody is left
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AdvanceQueueResponse) Reset() {
 	*x = AdvanceQueueResponse{}
-	mi := &file_queue_queue_proto_msgTypes[13]
+	mi := &file_queue_queue_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -934,7 +1378,7 @@ func (x *AdvanceQueueResponse) String() string {
 func (*AdvanceQueueResponse) ProtoMessage() {}
 
 func (x *AdvanceQueueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_queue_queue_proto_msgTypes[13]
+	mi := &file_queue_queue_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -947,7 +1391,7 @@ func (x *AdvanceQueueResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdvanceQueueResponse.ProtoReflect.Descriptor instead.
 func (*AdvanceQueueResponse) Descriptor() ([]byte, []int) {
-	return file_queue_queue_proto_rawDescGZIP(), []int{13}
+	return file_queue_queue_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *AdvanceQueueResponse) GetRemoved() *ParticipantDTO {
@@ -957,6 +1401,13 @@ func (x *AdvanceQueueResponse) GetRemoved() *ParticipantDTO {
 	return nil
 }
 
+func (x *AdvanceQueueResponse) GetCalled() *ParticipantDTO {
+	if x != nil {
+		return x.Called
+	}
+	return nil
+}
+
 type RemoveParticipantRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	QueueId       int64                  `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
@@ -969,7 +1420,7 @@ type RemoveParticipantRequest struct {
 
 func (x *RemoveParticipantRequest) Reset() {
 	*x = RemoveParticipantRequest{}
-	mi := &file_queue_queue_proto_msgTypes[14]
+	mi := &file_queue_queue_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -981,7 +1432,7 @@ func (x *RemoveParticipantRequest) String() string {
 func (*RemoveParticipantRequest) ProtoMessage() {}
 
 func (x *RemoveParticipantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_queue_queue_proto_msgTypes[14]
+	mi := &file_queue_queue_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -994,7 +1445,7 @@ func (x *RemoveParticipantRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveParticipantRequest.ProtoReflect.Descriptor instead.
 func (*RemoveParticipantRequest) Descriptor() ([]byte, []int) {
-	return file_queue_queue_proto_rawDescGZIP(), []int{14}
+	return file_queue_queue_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *RemoveParticipantRequest) GetQueueId() int64 {
@@ -1033,7 +1484,7 @@ type RemoveParticipantResponse struct {
 
 func (x *RemoveParticipantResponse) Reset() {
 	*x = RemoveParticipantResponse{}
-	mi := &file_queue_queue_proto_msgTypes[15]
+	mi := &file_queue_queue_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1045,7 +1496,7 @@ func (x *RemoveParticipantResponse) String() string {
 func (*RemoveParticipantResponse) ProtoMessage() {}
 
 func (x *RemoveParticipantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_queue_queue_proto_msgTypes[15]
+	mi := &file_queue_queue_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1058,7 +1509,7 @@ func (x *RemoveParticipantResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveParticipantResponse.ProtoReflect.Descriptor instead.
 func (*RemoveParticipantResponse) Descriptor() ([]byte, []int) {
-	return file_queue_queue_proto_rawDescGZIP(), []int{15}
+	return file_queue_queue_proto_rawDescGZIP(), []int{17}
 }
 
 type ArchiveQueueRequest struct {
@@ -1072,7 +1523,7 @@ type ArchiveQueueRequest struct {
 
 func (x *ArchiveQueueRequest) Reset() {
 	*x = ArchiveQueueRequest{}
-	mi := &file_queue_queue_proto_msgTypes[16]
+	mi := &file_queue_queue_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1084,7 +1535,7 @@ func (x *ArchiveQueueRequest) String() string {
 func (*ArchiveQueueRequest) ProtoMessage() {}
 
 func (x *ArchiveQueueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_queue_queue_proto_msgTypes[16]
+	mi := &file_queue_queue_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1097,7 +1548,7 @@ func (x *ArchiveQueueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveQueueRequest.ProtoReflect.Descriptor instead.
 func (*ArchiveQueueRequest) Descriptor() ([]byte, []int) {
-	return file_queue_queue_proto_rawDescGZIP(), []int{16}
+	return file_queue_queue_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ArchiveQueueRequest) GetQueueId() int64 {
@@ -1129,7 +1580,7 @@ type ArchiveQueueResponse struct {
 
 func (x *ArchiveQueueResponse) Reset() {
 	*x = ArchiveQueueResponse{}
-	mi := &file_queue_queue_proto_msgTypes[17]
+	mi := &file_queue_queue_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1141,7 +1592,7 @@ func (x *ArchiveQueueResponse) String() string {
 func (*ArchiveQueueResponse) ProtoMessage() {}
 
 func (x *ArchiveQueueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_queue_queue_proto_msgTypes[17]
+	mi := &file_queue_queue_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1154,7 +1605,7 @@ func (x *ArchiveQueueResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveQueueResponse.ProtoReflect.Descriptor instead.
 func (*ArchiveQueueResponse) Descriptor() ([]byte, []int) {
-	return file_queue_queue_proto_rawDescGZIP(), []int{17}
+	return file_queue_queue_proto_rawDescGZIP(), []int{19}
 }
 
 type DeleteQueueRequest struct {
@@ -1168,7 +1619,7 @@ type DeleteQueueRequest struct {
 
 func (x *DeleteQueueRequest) Reset() {
 	*x = DeleteQueueRequest{}
-	mi := &file_queue_queue_proto_msgTypes[18]
+	mi := &file_queue_queue_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1180,7 +1631,7 @@ func (x *DeleteQueueRequest) String() string {
 func (*DeleteQueueRequest) ProtoMessage() {}
 
 func (x *DeleteQueueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_queue_queue_proto_msgTypes[18]
+	mi := &file_queue_queue_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1193,7 +1644,7 @@ func (x *DeleteQueueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteQueueRequest.ProtoReflect.Descriptor instead.
 func (*DeleteQueueRequest) Descriptor() ([]byte, []int) {
-	return file_queue_queue_proto_rawDescGZIP(), []int{18}
+	return file_queue_queue_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DeleteQueueRequest) GetQueueId() int64 {
@@ -1225,7 +1676,7 @@ type DeleteQueueResponse struct {
 
 func (x *DeleteQueueResponse) Reset() {
 	*x = DeleteQueueResponse{}
-	mi := &file_queue_queue_proto_msgTypes[19]
+	mi := &file_queue_queue_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1237,7 +1688,7 @@ func (x *DeleteQueueResponse) String() string {
 func (*DeleteQueueResponse) ProtoMessage() {}
 
 func (x *DeleteQueueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_queue_queue_proto_msgTypes[19]
+	mi := &file_queue_queue_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1250,7 +1701,7 @@ func (x *DeleteQueueResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteQueueResponse.ProtoReflect.Descriptor instead.
 func (*DeleteQueueResponse) Descriptor() ([]byte, []int) {
-	return file_queue_queue_proto_rawDescGZIP(), []int{19}
+	return file_queue_queue_proto_rawDescGZIP(), []int{21}
 }
 
 type UpdateQueueRequest struct {
@@ -1260,13 +1711,14 @@ type UpdateQueueRequest struct {
 	ActorId       int64                  `protobuf:"varint,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"` // owner
 	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
 	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	NotifyTop     int32                  `protobuf:"varint,6,opt,name=notify_top,json=notifyTop,proto3" json:"notify_top,omitempty"` // optional, 1..50, 0 keeps current
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateQueueRequest) Reset() {
 	*x = UpdateQueueRequest{}
-	mi := &file_queue_queue_proto_msgTypes[20]
+	mi := &file_queue_queue_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1278,7 +1730,7 @@ func (x *UpdateQueueRequest) String() string {
 func (*UpdateQueueRequest) ProtoMessage() {}
 
 func (x *UpdateQueueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_queue_queue_proto_msgTypes[20]
+	mi := &file_queue_queue_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1291,7 +1743,7 @@ func (x *UpdateQueueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateQueueRequest.ProtoReflect.Descriptor instead.
 func (*UpdateQueueRequest) Descriptor() ([]byte, []int) {
-	return file_queue_queue_proto_rawDescGZIP(), []int{20}
+	return file_queue_queue_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *UpdateQueueRequest) GetQueueId() int64 {
@@ -1329,6 +1781,13 @@ func (x *UpdateQueueRequest) GetDescription() string {
 	return ""
 }
 
+func (x *UpdateQueueRequest) GetNotifyTop() int32 {
+	if x != nil {
+		return x.NotifyTop
+	}
+	return 0
+}
+
 type UpdateQueueResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Queue         *QueueDTO              `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
@@ -1338,7 +1797,7 @@ type UpdateQueueResponse struct {
 
 func (x *UpdateQueueResponse) Reset() {
 	*x = UpdateQueueResponse{}
-	mi := &file_queue_queue_proto_msgTypes[21]
+	mi := &file_queue_queue_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1350,7 +1809,7 @@ func (x *UpdateQueueResponse) String() string {
 func (*UpdateQueueResponse) ProtoMessage() {}
 
 func (x *UpdateQueueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_queue_queue_proto_msgTypes[21]
+	mi := &file_queue_queue_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1363,7 +1822,7 @@ func (x *UpdateQueueResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateQueueResponse.ProtoReflect.Descriptor instead.
 func (*UpdateQueueResponse) Descriptor() ([]byte, []int) {
-	return file_queue_queue_proto_rawDescGZIP(), []int{21}
+	return file_queue_queue_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *UpdateQueueResponse) GetQueue() *QueueDTO {
@@ -1387,7 +1846,7 @@ type AddParticipantRequest struct {
 
 func (x *AddParticipantRequest) Reset() {
 	*x = AddParticipantRequest{}
-	mi := &file_queue_queue_proto_msgTypes[22]
+	mi := &file_queue_queue_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1399,7 +1858,7 @@ func (x *AddParticipantRequest) String() string {
 func (*AddParticipantRequest) ProtoMessage() {}
 
 func (x *AddParticipantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_queue_queue_proto_msgTypes[22]
+	mi := &file_queue_queue_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1412,7 +1871,7 @@ func (x *AddParticipantRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddParticipantRequest.ProtoReflect.Descriptor instead.
 func (*AddParticipantRequest) Descriptor() ([]byte, []int) {
-	return file_queue_queue_proto_rawDescGZIP(), []int{22}
+	return file_queue_queue_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *AddParticipantRequest) GetQueueId() int64 {
@@ -1466,7 +1925,7 @@ type AddParticipantResponse struct {
 
 func (x *AddParticipantResponse) Reset() {
 	*x = AddParticipantResponse{}
-	mi := &file_queue_queue_proto_msgTypes[23]
+	mi := &file_queue_queue_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1478,7 +1937,7 @@ func (x *AddParticipantResponse) String() string {
 func (*AddParticipantResponse) ProtoMessage() {}
 
 func (x *AddParticipantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_queue_queue_proto_msgTypes[23]
+	mi := &file_queue_queue_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1491,7 +1950,7 @@ func (x *AddParticipantResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddParticipantResponse.ProtoReflect.Descriptor instead.
 func (*AddParticipantResponse) Descriptor() ([]byte, []int) {
-	return file_queue_queue_proto_rawDescGZIP(), []int{23}
+	return file_queue_queue_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *AddParticipantResponse) GetPosition() int32 {
@@ -1501,138 +1960,5302 @@ func (x *AddParticipantResponse) GetPosition() int32 {
 	return 0
 }
 
-var File_queue_queue_proto protoreflect.FileDescriptor
+type SetCallPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueId       int64                  `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	GroupCode     string                 `protobuf:"bytes,2,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
+	ActorId       int64                  `protobuf:"varint,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	CallTimeout   int32                  `protobuf:"varint,4,opt,name=call_timeout,json=callTimeout,proto3" json:"call_timeout,omitempty"` // seconds, 30..3600; 0 turns calls off
+	MissShift     int32                  `protobuf:"varint,5,opt,name=miss_shift,json=missShift,proto3" json:"miss_shift,omitempty"`       // 0..50; 0 skips a participant who missed the call
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_queue_queue_proto_rawDesc = "" +
-	"\n" +
-	"\x11queue/queue.proto\x12\x05queue\"\x9c\x02\n" +
-	"\bQueueDTO\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12$\n" +
-	"\x04mode\x18\x04 \x01(\x0e2\x10.queue.QueueModeR\x04mode\x12*\n" +
-	"\x06status\x18\x05 \x01(\x0e2\x12.queue.QueueStatusR\x06status\x12\x1d\n" +
-	"\n" +
-	"group_code\x18\x06 \x01(\tR\tgroupCode\x12\x19\n" +
-	"\bowner_id\x18\a \x01(\x03R\aownerId\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\b \x01(\x03R\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\t \x01(\x03R\tupdatedAt\"\xc9\x01\n" +
-	"\x0eParticipantDTO\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
-	"\bqueue_id\x18\x02 \x01(\x03R\aqueueId\x12\x17\n" +
-	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x1a\n" +
-	"\bposition\x18\x04 \x01(\x05R\bposition\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x1b\n" +
-	"\tslot_time\x18\x06 \x01(\tR\bslotTime\x12\x1b\n" +
-	"\tfull_name\x18\a \x01(\tR\bfullName\"2\n" +
-	"\x11ListQueuesRequest\x12\x1d\n" +
-	"\n" +
-	"group_code\x18\x01 \x01(\tR\tgroupCode\"=\n" +
-	"\x12ListQueuesResponse\x12'\n" +
-	"\x06queues\x18\x01 \x03(\v2\x0f.queue.QueueDTOR\x06queues\"\xac\x01\n" +
-	"\x12CreateQueueRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x12$\n" +
-	"\x04mode\x18\x03 \x01(\x0e2\x10.queue.QueueModeR\x04mode\x12\x1d\n" +
-	"\n" +
-	"group_code\x18\x04 \x01(\tR\tgroupCode\x12\x19\n" +
-	"\bowner_id\x18\x05 \x01(\x03R\aownerId\"<\n" +
-	"\x13CreateQueueResponse\x12%\n" +
-	"\x05queue\x18\x01 \x01(\v2\x0f.queue.QueueDTOR\x05queue\"K\n" +
-	"\x0fGetQueueRequest\x12\x19\n" +
-	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
-	"\n" +
-	"group_code\x18\x02 \x01(\tR\tgroupCode\"t\n" +
-	"\x10GetQueueResponse\x12%\n" +
-	"\x05queue\x18\x01 \x01(\v2\x0f.queue.QueueDTOR\x05queue\x129\n" +
-	"\fparticipants\x18\x02 \x03(\v2\x15.queue.ParticipantDTOR\fparticipants\"\x9f\x01\n" +
-	"\x10JoinQueueRequest\x12\x19\n" +
-	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1d\n" +
-	"\n" +
-	"group_code\x18\x03 \x01(\tR\tgroupCode\x12\x1b\n" +
-	"\tslot_time\x18\x04 \x01(\tR\bslotTime\x12\x1b\n" +
-	"\tuser_name\x18\x05 \x01(\tR\buserName\"/\n" +
-	"\x11JoinQueueResponse\x12\x1a\n" +
-	"\bposition\x18\x01 \x01(\x05R\bposition\"f\n" +
-	"\x11LeaveQueueRequest\x12\x19\n" +
-	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1d\n" +
-	"\n" +
-	"group_code\x18\x03 \x01(\tR\tgroupCode\"\x14\n" +
-	"\x12LeaveQueueResponse\"j\n" +
-	"\x13AdvanceQueueRequest\x12\x19\n" +
-	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
-	"\n" +
-	"group_code\x18\x02 \x01(\tR\tgroupCode\x12\x19\n" +
-	"\bactor_id\x18\x03 \x01(\x03R\aactorId\"G\n" +
-	"\x14AdvanceQueueResponse\x12/\n" +
-	"\aremoved\x18\x01 \x01(\v2\x15.queue.ParticipantDTOR\aremoved\"\x88\x01\n" +
-	"\x18RemoveParticipantRequest\x12\x19\n" +
-	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x19\n" +
-	"\bactor_id\x18\x03 \x01(\x03R\aactorId\x12\x1d\n" +
-	"\n" +
-	"group_code\x18\x04 \x01(\tR\tgroupCode\"\x1b\n" +
-	"\x19RemoveParticipantResponse\"j\n" +
-	"\x13ArchiveQueueRequest\x12\x19\n" +
-	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
-	"\n" +
-	"group_code\x18\x02 \x01(\tR\tgroupCode\x12\x19\n" +
-	"\bactor_id\x18\x03 \x01(\x03R\aactorId\"\x16\n" +
-	"\x14ArchiveQueueResponse\"i\n" +
-	"\x12DeleteQueueRequest\x12\x19\n" +
-	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
-	"\n" +
-	"group_code\x18\x02 \x01(\tR\tgroupCode\x12\x19\n" +
-	"\bactor_id\x18\x03 \x01(\x03R\aactorId\"\x15\n" +
-	"\x13DeleteQueueResponse\"\xa1\x01\n" +
-	"\x12UpdateQueueRequest\x12\x19\n" +
-	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
-	"\n" +
-	"group_code\x18\x02 \x01(\tR\tgroupCode\x12\x19\n" +
-	"\bactor_id\x18\x03 \x01(\x03R\aactorId\x12\x14\n" +
-	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x05 \x01(\tR\vdescription\"<\n" +
-	"\x13UpdateQueueResponse\x12%\n" +
-	"\x05queue\x18\x01 \x01(\v2\x0f.queue.QueueDTOR\x05queue\"\xbf\x01\n" +
-	"\x15AddParticipantRequest\x12\x19\n" +
-	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x19\n" +
-	"\bactor_id\x18\x03 \x01(\x03R\aactorId\x12\x1d\n" +
-	"\n" +
-	"group_code\x18\x04 \x01(\tR\tgroupCode\x12\x1b\n" +
-	"\tslot_time\x18\x05 \x01(\tR\bslotTime\x12\x1b\n" +
-	"\tuser_name\x18\x06 \x01(\tR\buserName\"4\n" +
-	"\x16AddParticipantResponse\x12\x1a\n" +
-	"\bposition\x18\x01 \x01(\x05R\bposition*\x81\x01\n" +
-	"\tQueueMode\x12\x1a\n" +
-	"\x16QUEUE_MODE_UNSPECIFIED\x10\x00\x12\x13\n" +
-	"\x0fQUEUE_MODE_LIVE\x10\x01\x12\x16\n" +
-	"\x12QUEUE_MODE_MANAGED\x10\x02\x12\x15\n" +
-	"\x11QUEUE_MODE_RANDOM\x10\x03\x12\x14\n" +
-	"\x10QUEUE_MODE_SLOTS\x10\x04*_\n" +
-	"\vQueueStatus\x12\x1c\n" +
-	"\x18QUEUE_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
-	"\x13QUEUE_STATUS_ACTIVE\x10\x01\x12\x19\n" +
-	"\x15QUEUE_STATUS_ARCHIVED\x10\x022\x95\x06\n" +
-	"\x05Queue\x12A\n" +
-	"\n" +
-	"ListQueues\x12\x18.queue.ListQueuesRequest\x1a\x19.queue.ListQueuesResponse\x12D\n" +
-	"\vCreateQueue\x12\x19.queue.CreateQueueRequest\x1a\x1a.queue.CreateQueueResponse\x12;\n" +
-	"\bGetQueue\x12\x16.queue.GetQueueRequest\x1a\x17.queue.GetQueueResponse\x12>\n" +
-	"\tJoinQueue\x12\x17.queue.JoinQueueRequest\x1a\x18.queue.JoinQueueResponse\x12A\n" +
-	"\n" +
-	"LeaveQueue\x12\x18.queue.LeaveQueueRequest\x1a\x19.queue.LeaveQueueResponse\x12G\n" +
-	"\fAdvanceQueue\x12\x1a.queue.AdvanceQueueRequest\x1a\x1b.queue.AdvanceQueueResponse\x12V\n" +
-	"\x11RemoveParticipant\x12\x1f.queue.RemoveParticipantRequest\x1a .queue.RemoveParticipantResponse\x12G\n" +
-	"\fArchiveQueue\x12\x1a.queue.ArchiveQueueRequest\x1a\x1b.queue.ArchiveQueueResponse\x12D\n" +
-	"\vDeleteQueue\x12\x19.queue.DeleteQueueRequest\x1a\x1a.queue.DeleteQueueResponse\x12D\n" +
-	"\vUpdateQueue\x12\x19.queue.UpdateQueueRequest\x1a\x1a.queue.UpdateQueueResponse\x12M\n" +
-	"\x0eAddParticipant\x12\x1c.queue.AddParticipantRequest\x1a\x1d.queue.AddParticipantResponseBAZ?github.com/s1lentmol/q-flow-backend/protos/gen/go/queue;queuev1b\x06proto3"
+func (x *SetCallPolicyRequest) Reset() {
+	*x = SetCallPolicyRequest{}
+	mi := &file_queue_queue_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCallPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCallPolicyRequest) ProtoMessage() {}
+
+func (x *SetCallPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCallPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetCallPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetCallPolicyRequest) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *SetCallPolicyRequest) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+func (x *SetCallPolicyRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *SetCallPolicyRequest) GetCallTimeout() int32 {
+	if x != nil {
+		return x.CallTimeout
+	}
+	return 0
+}
+
+func (x *SetCallPolicyRequest) GetMissShift() int32 {
+	if x != nil {
+		return x.MissShift
+	}
+	return 0
+}
+
+type SetCallPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         *QueueDTO              `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCallPolicyResponse) Reset() {
+	*x = SetCallPolicyResponse{}
+	mi := &file_queue_queue_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCallPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCallPolicyResponse) ProtoMessage() {}
+
+func (x *SetCallPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCallPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetCallPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetCallPolicyResponse) GetQueue() *QueueDTO {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+type CheckInRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueId       int64                  `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // the called participant
+	GroupCode     string                 `protobuf:"bytes,3,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInRequest) Reset() {
+	*x = CheckInRequest{}
+	mi := &file_queue_queue_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInRequest) ProtoMessage() {}
+
+func (x *CheckInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInRequest.ProtoReflect.Descriptor instead.
+func (*CheckInRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CheckInRequest) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *CheckInRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CheckInRequest) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+type CheckInResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Participant   *ParticipantDTO        `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInResponse) Reset() {
+	*x = CheckInResponse{}
+	mi := &file_queue_queue_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInResponse) ProtoMessage() {}
+
+func (x *CheckInResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInResponse.ProtoReflect.Descriptor instead.
+func (*CheckInResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CheckInResponse) GetParticipant() *ParticipantDTO {
+	if x != nil {
+		return x.Participant
+	}
+	return nil
+}
+
+type MoveParticipantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueId       int64                  `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	GroupCode     string                 `protobuf:"bytes,2,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
+	ActorId       int64                  `protobuf:"varint,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // participant to move
+	Position      int32                  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`           // new position, 1..number of participants
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveParticipantRequest) Reset() {
+	*x = MoveParticipantRequest{}
+	mi := &file_queue_queue_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveParticipantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveParticipantRequest) ProtoMessage() {}
+
+func (x *MoveParticipantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveParticipantRequest.ProtoReflect.Descriptor instead.
+func (*MoveParticipantRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MoveParticipantRequest) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *MoveParticipantRequest) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+func (x *MoveParticipantRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *MoveParticipantRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MoveParticipantRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type MoveParticipantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Participants  []*ParticipantDTO      `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants,omitempty"` // the new order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveParticipantResponse) Reset() {
+	*x = MoveParticipantResponse{}
+	mi := &file_queue_queue_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveParticipantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveParticipantResponse) ProtoMessage() {}
+
+func (x *MoveParticipantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveParticipantResponse.ProtoReflect.Descriptor instead.
+func (*MoveParticipantResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MoveParticipantResponse) GetParticipants() []*ParticipantDTO {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+type SwapParticipantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueId       int64                  `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	GroupCode     string                 `protobuf:"bytes,2,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
+	ActorId       int64                  `protobuf:"varint,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	FirstUserId   int64                  `protobuf:"varint,4,opt,name=first_user_id,json=firstUserId,proto3" json:"first_user_id,omitempty"`
+	SecondUserId  int64                  `protobuf:"varint,5,opt,name=second_user_id,json=secondUserId,proto3" json:"second_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwapParticipantsRequest) Reset() {
+	*x = SwapParticipantsRequest{}
+	mi := &file_queue_queue_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwapParticipantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapParticipantsRequest) ProtoMessage() {}
+
+func (x *SwapParticipantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapParticipantsRequest.ProtoReflect.Descriptor instead.
+func (*SwapParticipantsRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SwapParticipantsRequest) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *SwapParticipantsRequest) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+func (x *SwapParticipantsRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *SwapParticipantsRequest) GetFirstUserId() int64 {
+	if x != nil {
+		return x.FirstUserId
+	}
+	return 0
+}
+
+func (x *SwapParticipantsRequest) GetSecondUserId() int64 {
+	if x != nil {
+		return x.SecondUserId
+	}
+	return 0
+}
+
+type SwapParticipantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Participants  []*ParticipantDTO      `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants,omitempty"` // the new order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwapParticipantsResponse) Reset() {
+	*x = SwapParticipantsResponse{}
+	mi := &file_queue_queue_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwapParticipantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapParticipantsResponse) ProtoMessage() {}
+
+func (x *SwapParticipantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapParticipantsResponse.ProtoReflect.Descriptor instead.
+func (*SwapParticipantsResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SwapParticipantsResponse) GetParticipants() []*ParticipantDTO {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+type ReorderQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueId       int64                  `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	GroupCode     string                 `protobuf:"bytes,2,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
+	ActorId       int64                  `protobuf:"varint,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	UserIds       []int64                `protobuf:"varint,4,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"` // every participant exactly once, head first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderQueueRequest) Reset() {
+	*x = ReorderQueueRequest{}
+	mi := &file_queue_queue_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderQueueRequest) ProtoMessage() {}
+
+func (x *ReorderQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderQueueRequest.ProtoReflect.Descriptor instead.
+func (*ReorderQueueRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ReorderQueueRequest) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *ReorderQueueRequest) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+func (x *ReorderQueueRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *ReorderQueueRequest) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type ReorderQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Participants  []*ParticipantDTO      `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants,omitempty"` // the new order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderQueueResponse) Reset() {
+	*x = ReorderQueueResponse{}
+	mi := &file_queue_queue_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderQueueResponse) ProtoMessage() {}
+
+func (x *ReorderQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderQueueResponse.ProtoReflect.Descriptor instead.
+func (*ReorderQueueResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReorderQueueResponse) GetParticipants() []*ParticipantDTO {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+type SetLotteryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueId       int64                  `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	GroupCode     string                 `protobuf:"bytes,2,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
+	ActorId       int64                  `protobuf:"varint,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	LotteryAt     int64                  `protobuf:"varint,4,opt,name=lottery_at,json=lotteryAt,proto3" json:"lottery_at,omitempty"` // unix seconds in the future; 0 leaves the draw to the owner
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLotteryRequest) Reset() {
+	*x = SetLotteryRequest{}
+	mi := &file_queue_queue_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLotteryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLotteryRequest) ProtoMessage() {}
+
+func (x *SetLotteryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLotteryRequest.ProtoReflect.Descriptor instead.
+func (*SetLotteryRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetLotteryRequest) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *SetLotteryRequest) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+func (x *SetLotteryRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *SetLotteryRequest) GetLotteryAt() int64 {
+	if x != nil {
+		return x.LotteryAt
+	}
+	return 0
+}
+
+type SetLotteryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         *QueueDTO              `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLotteryResponse) Reset() {
+	*x = SetLotteryResponse{}
+	mi := &file_queue_queue_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLotteryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLotteryResponse) ProtoMessage() {}
+
+func (x *SetLotteryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLotteryResponse.ProtoReflect.Descriptor instead.
+func (*SetLotteryResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SetLotteryResponse) GetQueue() *QueueDTO {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+type DrawLotteryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueId       int64                  `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	GroupCode     string                 `protobuf:"bytes,2,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
+	ActorId       int64                  `protobuf:"varint,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrawLotteryRequest) Reset() {
+	*x = DrawLotteryRequest{}
+	mi := &file_queue_queue_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrawLotteryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawLotteryRequest) ProtoMessage() {}
+
+func (x *DrawLotteryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawLotteryRequest.ProtoReflect.Descriptor instead.
+func (*DrawLotteryRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DrawLotteryRequest) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *DrawLotteryRequest) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+func (x *DrawLotteryRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+type DrawLotteryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         *QueueDTO              `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`               // with the published seed
+	Participants  []*ParticipantDTO      `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"` // the drawn order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrawLotteryResponse) Reset() {
+	*x = DrawLotteryResponse{}
+	mi := &file_queue_queue_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrawLotteryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawLotteryResponse) ProtoMessage() {}
+
+func (x *DrawLotteryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawLotteryResponse.ProtoReflect.Descriptor instead.
+func (*DrawLotteryResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DrawLotteryResponse) GetQueue() *QueueDTO {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+func (x *DrawLotteryResponse) GetParticipants() []*ParticipantDTO {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+type DeferTurnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueId       int64                  `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	GroupCode     string                 `protobuf:"bytes,2,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Places        int32                  `protobuf:"varint,4,opt,name=places,proto3" json:"places,omitempty"` // 1..10; past the end moves to the end
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeferTurnRequest) Reset() {
+	*x = DeferTurnRequest{}
+	mi := &file_queue_queue_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeferTurnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeferTurnRequest) ProtoMessage() {}
+
+func (x *DeferTurnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeferTurnRequest.ProtoReflect.Descriptor instead.
+func (*DeferTurnRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeferTurnRequest) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *DeferTurnRequest) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+func (x *DeferTurnRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeferTurnRequest) GetPlaces() int32 {
+	if x != nil {
+		return x.Places
+	}
+	return 0
+}
+
+type DeferTurnResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Participant   *ParticipantDTO        `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"` // at the new place
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeferTurnResponse) Reset() {
+	*x = DeferTurnResponse{}
+	mi := &file_queue_queue_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeferTurnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeferTurnResponse) ProtoMessage() {}
+
+func (x *DeferTurnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeferTurnResponse.ProtoReflect.Descriptor instead.
+func (*DeferTurnResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeferTurnResponse) GetParticipant() *ParticipantDTO {
+	if x != nil {
+		return x.Participant
+	}
+	return nil
+}
+
+type SetParticipantPriorityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueId       int64                  `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	GroupCode     string                 `protobuf:"bytes,2,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
+	ActorId       int64                  `protobuf:"varint,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Priority      int32                  `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"` // -10..10
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetParticipantPriorityRequest) Reset() {
+	*x = SetParticipantPriorityRequest{}
+	mi := &file_queue_queue_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetParticipantPriorityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetParticipantPriorityRequest) ProtoMessage() {}
+
+func (x *SetParticipantPriorityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetParticipantPriorityRequest.ProtoReflect.Descriptor instead.
+func (*SetParticipantPriorityRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SetParticipantPriorityRequest) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *SetParticipantPriorityRequest) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+func (x *SetParticipantPriorityRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *SetParticipantPriorityRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetParticipantPriorityRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type SetParticipantPriorityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Participants  []*ParticipantDTO      `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants,omitempty"` // the new order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetParticipantPriorityResponse) Reset() {
+	*x = SetParticipantPriorityResponse{}
+	mi := &file_queue_queue_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetParticipantPriorityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetParticipantPriorityResponse) ProtoMessage() {}
+
+func (x *SetParticipantPriorityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetParticipantPriorityResponse.ProtoReflect.Descriptor instead.
+func (*SetParticipantPriorityResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SetParticipantPriorityResponse) GetParticipants() []*ParticipantDTO {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+type SwapRequestDTO struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	QueueId       int64                  `protobuf:"varint,2,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	FromUserId    int64                  `protobuf:"varint,3,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"`
+	ToUserId      int64                  `protobuf:"varint,4,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	Status        SwapStatus             `protobuf:"varint,5,opt,name=status,proto3,enum=queue.SwapStatus" json:"status,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	AnsweredAt    int64                  `protobuf:"varint,8,opt,name=answered_at,json=answeredAt,proto3" json:"answered_at,omitempty"` // unix seconds, 0 while pending
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwapRequestDTO) Reset() {
+	*x = SwapRequestDTO{}
+	mi := &file_queue_queue_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwapRequestDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapRequestDTO) ProtoMessage() {}
+
+func (x *SwapRequestDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapRequestDTO.ProtoReflect.Descriptor instead.
+func (*SwapRequestDTO) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SwapRequestDTO) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SwapRequestDTO) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *SwapRequestDTO) GetFromUserId() int64 {
+	if x != nil {
+		return x.FromUserId
+	}
+	return 0
+}
+
+func (x *SwapRequestDTO) GetToUserId() int64 {
+	if x != nil {
+		return x.ToUserId
+	}
+	return 0
+}
+
+func (x *SwapRequestDTO) GetStatus() SwapStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SwapStatus_SWAP_STATUS_UNSPECIFIED
+}
+
+func (x *SwapRequestDTO) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *SwapRequestDTO) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *SwapRequestDTO) GetAnsweredAt() int64 {
+	if x != nil {
+		return x.AnsweredAt
+	}
+	return 0
+}
+
+type SetSwapPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueId       int64                  `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	GroupCode     string                 `protobuf:"bytes,2,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
+	ActorId       int64                  `protobuf:"varint,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Allowed       bool                   `protobuf:"varint,4,opt,name=allowed,proto3" json:"allowed,omitempty"` // forbidding cancels the pending requests
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSwapPolicyRequest) Reset() {
+	*x = SetSwapPolicyRequest{}
+	mi := &file_queue_queue_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSwapPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSwapPolicyRequest) ProtoMessage() {}
+
+func (x *SetSwapPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSwapPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetSwapPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetSwapPolicyRequest) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *SetSwapPolicyRequest) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+func (x *SetSwapPolicyRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *SetSwapPolicyRequest) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+type SetSwapPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         *QueueDTO              `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSwapPolicyResponse) Reset() {
+	*x = SetSwapPolicyResponse{}
+	mi := &file_queue_queue_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSwapPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSwapPolicyResponse) ProtoMessage() {}
+
+func (x *SetSwapPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSwapPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetSwapPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SetSwapPolicyResponse) GetQueue() *QueueDTO {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+type RequestSwapRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueId       int64                  `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	GroupCode     string                 `protobuf:"bytes,2,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // requester
+	TargetUserId  int64                  `protobuf:"varint,4,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestSwapRequest) Reset() {
+	*x = RequestSwapRequest{}
+	mi := &file_queue_queue_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestSwapRequest) ProtoMessage() {}
+
+func (x *RequestSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestSwapRequest.ProtoReflect.Descriptor instead.
+func (*RequestSwapRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RequestSwapRequest) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *RequestSwapRequest) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+func (x *RequestSwapRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RequestSwapRequest) GetTargetUserId() int64 {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return 0
+}
+
+type RequestSwapResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *SwapRequestDTO        `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestSwapResponse) Reset() {
+	*x = RequestSwapResponse{}
+	mi := &file_queue_queue_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestSwapResponse) ProtoMessage() {}
+
+func (x *RequestSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestSwapResponse.ProtoReflect.Descriptor instead.
+func (*RequestSwapResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RequestSwapResponse) GetRequest() *SwapRequestDTO {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type AnswerSwapRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     int64                  `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	GroupCode     string                 `protobuf:"bytes,2,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // receiver of the request
+	Accept        bool                   `protobuf:"varint,4,opt,name=accept,proto3" json:"accept,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnswerSwapRequest) Reset() {
+	*x = AnswerSwapRequest{}
+	mi := &file_queue_queue_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnswerSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerSwapRequest) ProtoMessage() {}
+
+func (x *AnswerSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerSwapRequest.ProtoReflect.Descriptor instead.
+func (*AnswerSwapRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AnswerSwapRequest) GetRequestId() int64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *AnswerSwapRequest) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+func (x *AnswerSwapRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AnswerSwapRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type AnswerSwapResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *SwapRequestDTO        `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Participants  []*ParticipantDTO      `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"` // the new order, empty if declined
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnswerSwapResponse) Reset() {
+	*x = AnswerSwapResponse{}
+	mi := &file_queue_queue_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnswerSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerSwapResponse) ProtoMessage() {}
+
+func (x *AnswerSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerSwapResponse.ProtoReflect.Descriptor instead.
+func (*AnswerSwapResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AnswerSwapResponse) GetRequest() *SwapRequestDTO {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *AnswerSwapResponse) GetParticipants() []*ParticipantDTO {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+type CancelSwapRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     int64                  `protobuf:"varint,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // requester
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSwapRequest) Reset() {
+	*x = CancelSwapRequest{}
+	mi := &file_queue_queue_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSwapRequest) ProtoMessage() {}
+
+func (x *CancelSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSwapRequest.ProtoReflect.Descriptor instead.
+func (*CancelSwapRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CancelSwapRequest) GetRequestId() int64 {
+	if x != nil {
+		return x.RequestId
+	}
+	return 0
+}
+
+func (x *CancelSwapRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type CancelSwapResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Request       *SwapRequestDTO        `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSwapResponse) Reset() {
+	*x = CancelSwapResponse{}
+	mi := &file_queue_queue_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSwapResponse) ProtoMessage() {}
+
+func (x *CancelSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSwapResponse.ProtoReflect.Descriptor instead.
+func (*CancelSwapResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CancelSwapResponse) GetRequest() *SwapRequestDTO {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type ListSwapRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueId       int64                  `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	GroupCode     string                 `protobuf:"bytes,2,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSwapRequestsRequest) Reset() {
+	*x = ListSwapRequestsRequest{}
+	mi := &file_queue_queue_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSwapRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSwapRequestsRequest) ProtoMessage() {}
+
+func (x *ListSwapRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSwapRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListSwapRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListSwapRequestsRequest) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *ListSwapRequestsRequest) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+func (x *ListSwapRequestsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListSwapRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*SwapRequestDTO      `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSwapRequestsResponse) Reset() {
+	*x = ListSwapRequestsResponse{}
+	mi := &file_queue_queue_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSwapRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSwapRequestsResponse) ProtoMessage() {}
+
+func (x *ListSwapRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSwapRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListSwapRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListSwapRequestsResponse) GetRequests() []*SwapRequestDTO {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type WatchQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueId       int64                  `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	GroupCode     string                 `protobuf:"bytes,2,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
+	RequesterId   int64                  `protobuf:"varint,3,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchQueueRequest) Reset() {
+	*x = WatchQueueRequest{}
+	mi := &file_queue_queue_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchQueueRequest) ProtoMessage() {}
+
+func (x *WatchQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchQueueRequest.ProtoReflect.Descriptor instead.
+func (*WatchQueueRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *WatchQueueRequest) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *WatchQueueRequest) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+func (x *WatchQueueRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+type QueueUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         *QueueDTO              `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	Participants  []*ParticipantDTO      `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+	Deleted       bool                   `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"` // queue was deleted, no further updates follow
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueUpdate) Reset() {
+	*x = QueueUpdate{}
+	mi := &file_queue_queue_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueUpdate) ProtoMessage() {}
+
+func (x *QueueUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueUpdate.ProtoReflect.Descriptor instead.
+func (*QueueUpdate) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *QueueUpdate) GetQueue() *QueueDTO {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+func (x *QueueUpdate) GetParticipants() []*ParticipantDTO {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *QueueUpdate) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type ListQueueEventsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	QueueId   int64                  `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	GroupCode string                 `protobuf:"bytes,2,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
+	// Optional filters.
+	ActorId int64  `protobuf:"varint,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Type    string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Since   int64  `protobuf:"varint,5,opt,name=since,proto3" json:"since,omitempty"` // unix seconds, inclusive
+	Until   int64  `protobuf:"varint,6,opt,name=until,proto3" json:"until,omitempty"` // unix seconds, exclusive
+	// Pagination: pass next_before_id of the previous page.
+	BeforeId      int64 `protobuf:"varint,7,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"`
+	Limit         int32 `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"` // default 50, max 200
+	RequesterId   int64 `protobuf:"varint,9,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueueEventsRequest) Reset() {
+	*x = ListQueueEventsRequest{}
+	mi := &file_queue_queue_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueueEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueueEventsRequest) ProtoMessage() {}
+
+func (x *ListQueueEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueueEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListQueueEventsRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListQueueEventsRequest) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *ListQueueEventsRequest) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+func (x *ListQueueEventsRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *ListQueueEventsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListQueueEventsRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ListQueueEventsRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *ListQueueEventsRequest) GetBeforeId() int64 {
+	if x != nil {
+		return x.BeforeId
+	}
+	return 0
+}
+
+func (x *ListQueueEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListQueueEventsRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+type ListQueueEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*QueueEventDTO       `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextBeforeId  int64                  `protobuf:"varint,2,opt,name=next_before_id,json=nextBeforeId,proto3" json:"next_before_id,omitempty"` // 0 when there are no more events
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueueEventsResponse) Reset() {
+	*x = ListQueueEventsResponse{}
+	mi := &file_queue_queue_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueueEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueueEventsResponse) ProtoMessage() {}
+
+func (x *ListQueueEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueueEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListQueueEventsResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListQueueEventsResponse) GetEvents() []*QueueEventDTO {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListQueueEventsResponse) GetNextBeforeId() int64 {
+	if x != nil {
+		return x.NextBeforeId
+	}
+	return 0
+}
+
+type GetQueueHistoryRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	QueueId   int64                  `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	GroupCode string                 `protobuf:"bytes,2,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
+	// Optional filters.
+	UserId  int64  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Outcome string `protobuf:"bytes,4,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	// Pagination: pass next_before_id of the previous page.
+	BeforeId      int64 `protobuf:"varint,5,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"`
+	Limit         int32 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"` // default 50, max 200
+	RequesterId   int64 `protobuf:"varint,7,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQueueHistoryRequest) Reset() {
+	*x = GetQueueHistoryRequest{}
+	mi := &file_queue_queue_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQueueHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueueHistoryRequest) ProtoMessage() {}
+
+func (x *GetQueueHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueueHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetQueueHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetQueueHistoryRequest) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *GetQueueHistoryRequest) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+func (x *GetQueueHistoryRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetQueueHistoryRequest) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *GetQueueHistoryRequest) GetBeforeId() int64 {
+	if x != nil {
+		return x.BeforeId
+	}
+	return 0
+}
+
+func (x *GetQueueHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetQueueHistoryRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+type GetQueueHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*HistoryEntryDTO     `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextBeforeId  int64                  `protobuf:"varint,2,opt,name=next_before_id,json=nextBeforeId,proto3" json:"next_before_id,omitempty"` // 0 when there are no more entries
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQueueHistoryResponse) Reset() {
+	*x = GetQueueHistoryResponse{}
+	mi := &file_queue_queue_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQueueHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueueHistoryResponse) ProtoMessage() {}
+
+func (x *GetQueueHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueueHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetQueueHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetQueueHistoryResponse) GetEntries() []*HistoryEntryDTO {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetQueueHistoryResponse) GetNextBeforeId() int64 {
+	if x != nil {
+		return x.NextBeforeId
+	}
+	return 0
+}
+
+type QueueMemberDTO struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueId       int64                  `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // owner, moderator, viewer
+	GrantedBy     int64                  `protobuf:"varint,4,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueMemberDTO) Reset() {
+	*x = QueueMemberDTO{}
+	mi := &file_queue_queue_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueMemberDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueMemberDTO) ProtoMessage() {}
+
+func (x *QueueMemberDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueMemberDTO.ProtoReflect.Descriptor instead.
+func (*QueueMemberDTO) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *QueueMemberDTO) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *QueueMemberDTO) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *QueueMemberDTO) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *QueueMemberDTO) GetGrantedBy() int64 {
+	if x != nil {
+		return x.GrantedBy
+	}
+	return 0
+}
+
+func (x *QueueMemberDTO) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueId       int64                  `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	GroupCode     string                 `protobuf:"bytes,2,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
+	RequesterId   int64                  `protobuf:"varint,3,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMembersRequest) Reset() {
+	*x = ListMembersRequest{}
+	mi := &file_queue_queue_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMembersRequest) ProtoMessage() {}
+
+func (x *ListMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListMembersRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListMembersRequest) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *ListMembersRequest) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+func (x *ListMembersRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+type ListMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*QueueMemberDTO      `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMembersResponse) Reset() {
+	*x = ListMembersResponse{}
+	mi := &file_queue_queue_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMembersResponse) ProtoMessage() {}
+
+func (x *ListMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListMembersResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListMembersResponse) GetMembers() []*QueueMemberDTO {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type GrantRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueId       int64                  `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	GroupCode     string                 `protobuf:"bytes,2,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	ActorId       int64                  `protobuf:"varint,5,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantRoleRequest) Reset() {
+	*x = GrantRoleRequest{}
+	mi := &file_queue_queue_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantRoleRequest) ProtoMessage() {}
+
+func (x *GrantRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantRoleRequest.ProtoReflect.Descriptor instead.
+func (*GrantRoleRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GrantRoleRequest) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *GrantRoleRequest) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+func (x *GrantRoleRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GrantRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *GrantRoleRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+type GrantRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *QueueMemberDTO        `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantRoleResponse) Reset() {
+	*x = GrantRoleResponse{}
+	mi := &file_queue_queue_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantRoleResponse) ProtoMessage() {}
+
+func (x *GrantRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantRoleResponse.ProtoReflect.Descriptor instead.
+func (*GrantRoleResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GrantRoleResponse) GetMember() *QueueMemberDTO {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type RevokeRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueId       int64                  `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	GroupCode     string                 `protobuf:"bytes,2,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
+	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ActorId       int64                  `protobuf:"varint,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeRoleRequest) Reset() {
+	*x = RevokeRoleRequest{}
+	mi := &file_queue_queue_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRoleRequest) ProtoMessage() {}
+
+func (x *RevokeRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRoleRequest.ProtoReflect.Descriptor instead.
+func (*RevokeRoleRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RevokeRoleRequest) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *RevokeRoleRequest) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+func (x *RevokeRoleRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RevokeRoleRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+type RevokeRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeRoleResponse) Reset() {
+	*x = RevokeRoleResponse{}
+	mi := &file_queue_queue_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRoleResponse) ProtoMessage() {}
+
+func (x *RevokeRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRoleResponse.ProtoReflect.Descriptor instead.
+func (*RevokeRoleResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{67}
+}
+
+type GroupDTO struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code            string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	OwnerId         int64                  `protobuf:"varint,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	InviteCode      string                 `protobuf:"bytes,5,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"` // only for group admins
+	RequireApproval bool                   `protobuf:"varint,6,opt,name=require_approval,json=requireApproval,proto3" json:"require_approval,omitempty"`
+	CreatedAt       int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Role            string                 `protobuf:"bytes,8,opt,name=role,proto3" json:"role,omitempty"`     // requester's role: admin, member
+	Status          string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"` // requester's membership: pending, active
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GroupDTO) Reset() {
+	*x = GroupDTO{}
+	mi := &file_queue_queue_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupDTO) ProtoMessage() {}
+
+func (x *GroupDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupDTO.ProtoReflect.Descriptor instead.
+func (*GroupDTO) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GroupDTO) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GroupDTO) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *GroupDTO) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GroupDTO) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *GroupDTO) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+func (x *GroupDTO) GetRequireApproval() bool {
+	if x != nil {
+		return x.RequireApproval
+	}
+	return false
+}
+
+func (x *GroupDTO) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *GroupDTO) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *GroupDTO) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GroupMemberDTO struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FullName      string                 `protobuf:"bytes,3,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`     // admin, member
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // pending, active
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMemberDTO) Reset() {
+	*x = GroupMemberDTO{}
+	mi := &file_queue_queue_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMemberDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMemberDTO) ProtoMessage() {}
+
+func (x *GroupMemberDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMemberDTO.ProtoReflect.Descriptor instead.
+func (*GroupMemberDTO) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GroupMemberDTO) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *GroupMemberDTO) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GroupMemberDTO) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *GroupMemberDTO) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *GroupMemberDTO) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GroupMemberDTO) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type CreateGroupRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Code            string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RequireApproval bool                   `protobuf:"varint,3,opt,name=require_approval,json=requireApproval,proto3" json:"require_approval,omitempty"`
+	OwnerId         int64                  `protobuf:"varint,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	OwnerName       string                 `protobuf:"bytes,5,opt,name=owner_name,json=ownerName,proto3" json:"owner_name,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateGroupRequest) Reset() {
+	*x = CreateGroupRequest{}
+	mi := &file_queue_queue_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRequest) ProtoMessage() {}
+
+func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CreateGroupRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetRequireApproval() bool {
+	if x != nil {
+		return x.RequireApproval
+	}
+	return false
+}
+
+func (x *CreateGroupRequest) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *CreateGroupRequest) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
+	}
+	return ""
+}
+
+type CreateGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *GroupDTO              `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupResponse) Reset() {
+	*x = CreateGroupResponse{}
+	mi := &file_queue_queue_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupResponse) ProtoMessage() {}
+
+func (x *CreateGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupResponse.ProtoReflect.Descriptor instead.
+func (*CreateGroupResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateGroupResponse) GetGroup() *GroupDTO {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type ListGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupsRequest) Reset() {
+	*x = ListGroupsRequest{}
+	mi := &file_queue_queue_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsRequest) ProtoMessage() {}
+
+func (x *ListGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListGroupsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*GroupDTO            `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupsResponse) Reset() {
+	*x = ListGroupsResponse{}
+	mi := &file_queue_queue_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsResponse) ProtoMessage() {}
+
+func (x *ListGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListGroupsResponse) GetGroups() []*GroupDTO {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type GetGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	RequesterId   int64                  `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupRequest) Reset() {
+	*x = GetGroupRequest{}
+	mi := &file_queue_queue_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupRequest) ProtoMessage() {}
+
+func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetGroupRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *GetGroupRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+type GetGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *GroupDTO              `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupResponse) Reset() {
+	*x = GetGroupResponse{}
+	mi := &file_queue_queue_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupResponse) ProtoMessage() {}
+
+func (x *GetGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetGroupResponse) GetGroup() *GroupDTO {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type JoinGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InviteCode    string                 `protobuf:"bytes,1,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName      string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinGroupRequest) Reset() {
+	*x = JoinGroupRequest{}
+	mi := &file_queue_queue_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGroupRequest) ProtoMessage() {}
+
+func (x *JoinGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGroupRequest.ProtoReflect.Descriptor instead.
+func (*JoinGroupRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *JoinGroupRequest) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+func (x *JoinGroupRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *JoinGroupRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+type JoinGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *GroupMemberDTO        `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinGroupResponse) Reset() {
+	*x = JoinGroupResponse{}
+	mi := &file_queue_queue_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGroupResponse) ProtoMessage() {}
+
+func (x *JoinGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGroupResponse.ProtoReflect.Descriptor instead.
+func (*JoinGroupResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *JoinGroupResponse) GetMember() *GroupMemberDTO {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type ListGroupMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	RequesterId   int64                  `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // optional filter: pending, active
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupMembersRequest) Reset() {
+	*x = ListGroupMembersRequest{}
+	mi := &file_queue_queue_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupMembersRequest) ProtoMessage() {}
+
+func (x *ListGroupMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupMembersRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListGroupMembersRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *ListGroupMembersRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *ListGroupMembersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListGroupMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*GroupMemberDTO      `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupMembersResponse) Reset() {
+	*x = ListGroupMembersResponse{}
+	mi := &file_queue_queue_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupMembersResponse) ProtoMessage() {}
+
+func (x *ListGroupMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupMembersResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListGroupMembersResponse) GetMembers() []*GroupMemberDTO {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type ReviewGroupMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ActorId       int64                  `protobuf:"varint,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Approve       bool                   `protobuf:"varint,4,opt,name=approve,proto3" json:"approve,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewGroupMemberRequest) Reset() {
+	*x = ReviewGroupMemberRequest{}
+	mi := &file_queue_queue_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewGroupMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewGroupMemberRequest) ProtoMessage() {}
+
+func (x *ReviewGroupMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewGroupMemberRequest.ProtoReflect.Descriptor instead.
+func (*ReviewGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ReviewGroupMemberRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *ReviewGroupMemberRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReviewGroupMemberRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *ReviewGroupMemberRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+type ReviewGroupMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewGroupMemberResponse) Reset() {
+	*x = ReviewGroupMemberResponse{}
+	mi := &file_queue_queue_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewGroupMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewGroupMemberResponse) ProtoMessage() {}
+
+func (x *ReviewGroupMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewGroupMemberResponse.ProtoReflect.Descriptor instead.
+func (*ReviewGroupMemberResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{81}
+}
+
+type RemoveGroupMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ActorId       int64                  `protobuf:"varint,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGroupMemberRequest) Reset() {
+	*x = RemoveGroupMemberRequest{}
+	mi := &file_queue_queue_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGroupMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGroupMemberRequest) ProtoMessage() {}
+
+func (x *RemoveGroupMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGroupMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *RemoveGroupMemberRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *RemoveGroupMemberRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RemoveGroupMemberRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+type RemoveGroupMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGroupMemberResponse) Reset() {
+	*x = RemoveGroupMemberResponse{}
+	mi := &file_queue_queue_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGroupMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGroupMemberResponse) ProtoMessage() {}
+
+func (x *RemoveGroupMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGroupMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveGroupMemberResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{83}
+}
+
+type RegenerateInviteCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ActorId       int64                  `protobuf:"varint,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateInviteCodeRequest) Reset() {
+	*x = RegenerateInviteCodeRequest{}
+	mi := &file_queue_queue_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateInviteCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateInviteCodeRequest) ProtoMessage() {}
+
+func (x *RegenerateInviteCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateInviteCodeRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateInviteCodeRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *RegenerateInviteCodeRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *RegenerateInviteCodeRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+type RegenerateInviteCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InviteCode    string                 `protobuf:"bytes,1,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateInviteCodeResponse) Reset() {
+	*x = RegenerateInviteCodeResponse{}
+	mi := &file_queue_queue_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateInviteCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateInviteCodeResponse) ProtoMessage() {}
+
+func (x *RegenerateInviteCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateInviteCodeResponse.ProtoReflect.Descriptor instead.
+func (*RegenerateInviteCodeResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *RegenerateInviteCodeResponse) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+// Times are RFC3339, like slot_time in JoinQueueRequest.
+type SlotBreakDTO struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartsAt      string                 `protobuf:"bytes,1,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        string                 `protobuf:"bytes,2,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotBreakDTO) Reset() {
+	*x = SlotBreakDTO{}
+	mi := &file_queue_queue_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotBreakDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotBreakDTO) ProtoMessage() {}
+
+func (x *SlotBreakDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotBreakDTO.ProtoReflect.Descriptor instead.
+func (*SlotBreakDTO) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SlotBreakDTO) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *SlotBreakDTO) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+type SlotScheduleDTO struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueId       int64                  `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	StartsAt      string                 `protobuf:"bytes,2,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        string                 `protobuf:"bytes,3,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	SlotMinutes   int32                  `protobuf:"varint,4,opt,name=slot_minutes,json=slotMinutes,proto3" json:"slot_minutes,omitempty"`
+	Capacity      int32                  `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Breaks        []*SlotBreakDTO        `protobuf:"bytes,6,rep,name=breaks,proto3" json:"breaks,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotScheduleDTO) Reset() {
+	*x = SlotScheduleDTO{}
+	mi := &file_queue_queue_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotScheduleDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotScheduleDTO) ProtoMessage() {}
+
+func (x *SlotScheduleDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotScheduleDTO.ProtoReflect.Descriptor instead.
+func (*SlotScheduleDTO) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SlotScheduleDTO) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *SlotScheduleDTO) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *SlotScheduleDTO) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *SlotScheduleDTO) GetSlotMinutes() int32 {
+	if x != nil {
+		return x.SlotMinutes
+	}
+	return 0
+}
+
+func (x *SlotScheduleDTO) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *SlotScheduleDTO) GetBreaks() []*SlotBreakDTO {
+	if x != nil {
+		return x.Breaks
+	}
+	return nil
+}
+
+func (x *SlotScheduleDTO) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type SlotDTO struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartsAt      string                 `protobuf:"bytes,1,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        string                 `protobuf:"bytes,2,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Capacity      int32                  `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Taken         int32                  `protobuf:"varint,4,opt,name=taken,proto3" json:"taken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotDTO) Reset() {
+	*x = SlotDTO{}
+	mi := &file_queue_queue_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotDTO) ProtoMessage() {}
+
+func (x *SlotDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotDTO.ProtoReflect.Descriptor instead.
+func (*SlotDTO) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SlotDTO) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *SlotDTO) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *SlotDTO) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *SlotDTO) GetTaken() int32 {
+	if x != nil {
+		return x.Taken
+	}
+	return 0
+}
+
+type SetSlotScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueId       int64                  `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	GroupCode     string                 `protobuf:"bytes,2,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
+	ActorId       int64                  `protobuf:"varint,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	StartsAt      string                 `protobuf:"bytes,4,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        string                 `protobuf:"bytes,5,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	SlotMinutes   int32                  `protobuf:"varint,6,opt,name=slot_minutes,json=slotMinutes,proto3" json:"slot_minutes,omitempty"`
+	Capacity      int32                  `protobuf:"varint,7,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Breaks        []*SlotBreakDTO        `protobuf:"bytes,8,rep,name=breaks,proto3" json:"breaks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSlotScheduleRequest) Reset() {
+	*x = SetSlotScheduleRequest{}
+	mi := &file_queue_queue_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSlotScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSlotScheduleRequest) ProtoMessage() {}
+
+func (x *SetSlotScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSlotScheduleRequest.ProtoReflect.Descriptor instead.
+func (*SetSlotScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SetSlotScheduleRequest) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *SetSlotScheduleRequest) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+func (x *SetSlotScheduleRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *SetSlotScheduleRequest) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *SetSlotScheduleRequest) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *SetSlotScheduleRequest) GetSlotMinutes() int32 {
+	if x != nil {
+		return x.SlotMinutes
+	}
+	return 0
+}
+
+func (x *SetSlotScheduleRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *SetSlotScheduleRequest) GetBreaks() []*SlotBreakDTO {
+	if x != nil {
+		return x.Breaks
+	}
+	return nil
+}
+
+type SetSlotScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *SlotScheduleDTO       `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSlotScheduleResponse) Reset() {
+	*x = SetSlotScheduleResponse{}
+	mi := &file_queue_queue_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSlotScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSlotScheduleResponse) ProtoMessage() {}
+
+func (x *SetSlotScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSlotScheduleResponse.ProtoReflect.Descriptor instead.
+func (*SetSlotScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *SetSlotScheduleResponse) GetSchedule() *SlotScheduleDTO {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type ListFreeSlotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QueueId       int64                  `protobuf:"varint,1,opt,name=queue_id,json=queueId,proto3" json:"queue_id,omitempty"`
+	GroupCode     string                 `protobuf:"bytes,2,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
+	RequesterId   int64                  `protobuf:"varint,3,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFreeSlotsRequest) Reset() {
+	*x = ListFreeSlotsRequest{}
+	mi := &file_queue_queue_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFreeSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFreeSlotsRequest) ProtoMessage() {}
+
+func (x *ListFreeSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFreeSlotsRequest.ProtoReflect.Descriptor instead.
+func (*ListFreeSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ListFreeSlotsRequest) GetQueueId() int64 {
+	if x != nil {
+		return x.QueueId
+	}
+	return 0
+}
+
+func (x *ListFreeSlotsRequest) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+func (x *ListFreeSlotsRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+type ListFreeSlotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *SlotScheduleDTO       `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Slots         []*SlotDTO             `protobuf:"bytes,2,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFreeSlotsResponse) Reset() {
+	*x = ListFreeSlotsResponse{}
+	mi := &file_queue_queue_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFreeSlotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFreeSlotsResponse) ProtoMessage() {}
+
+func (x *ListFreeSlotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFreeSlotsResponse.ProtoReflect.Descriptor instead.
+func (*ListFreeSlotsResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ListFreeSlotsResponse) GetSchedule() *SlotScheduleDTO {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *ListFreeSlotsResponse) GetSlots() []*SlotDTO {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+// QueueTemplateSpec holds the editable part of a template. Each instance opens
+// on one of weekdays (0 = Sunday) at start_time ("HH:MM") in timezone (IANA,
+// UTC by default), is published publish_before_minutes ahead and stays open
+// for duration_minutes (0 = until closed by hand). Slots templates also need
+// slot_minutes and slot_capacity.
+type QueueTemplateSpec struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Title                string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Mode                 QueueMode              `protobuf:"varint,3,opt,name=mode,proto3,enum=queue.QueueMode" json:"mode,omitempty"`
+	GroupCode            string                 `protobuf:"bytes,4,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"`
+	Weekdays             []int32                `protobuf:"varint,5,rep,packed,name=weekdays,proto3" json:"weekdays,omitempty"`
+	StartTime            string                 `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Timezone             string                 `protobuf:"bytes,7,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	PublishBeforeMinutes int32                  `protobuf:"varint,8,opt,name=publish_before_minutes,json=publishBeforeMinutes,proto3" json:"publish_before_minutes,omitempty"`
+	DurationMinutes      int32                  `protobuf:"varint,9,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	SlotMinutes          int32                  `protobuf:"varint,10,opt,name=slot_minutes,json=slotMinutes,proto3" json:"slot_minutes,omitempty"`
+	SlotCapacity         int32                  `protobuf:"varint,11,opt,name=slot_capacity,json=slotCapacity,proto3" json:"slot_capacity,omitempty"`
+	AutoArchive          bool                   `protobuf:"varint,12,opt,name=auto_archive,json=autoArchive,proto3" json:"auto_archive,omitempty"`
+	Paused               bool                   `protobuf:"varint,13,opt,name=paused,proto3" json:"paused,omitempty"`
+	LotteryAfterMinutes  int32                  `protobuf:"varint,14,opt,name=lottery_after_minutes,json=lotteryAfterMinutes,proto3" json:"lottery_after_minutes,omitempty"` // random mode: draw this long after opening, 0 draws at closing
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *QueueTemplateSpec) Reset() {
+	*x = QueueTemplateSpec{}
+	mi := &file_queue_queue_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueTemplateSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueTemplateSpec) ProtoMessage() {}
+
+func (x *QueueTemplateSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueTemplateSpec.ProtoReflect.Descriptor instead.
+func (*QueueTemplateSpec) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *QueueTemplateSpec) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *QueueTemplateSpec) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *QueueTemplateSpec) GetMode() QueueMode {
+	if x != nil {
+		return x.Mode
+	}
+	return QueueMode_QUEUE_MODE_UNSPECIFIED
+}
+
+func (x *QueueTemplateSpec) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+func (x *QueueTemplateSpec) GetWeekdays() []int32 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *QueueTemplateSpec) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *QueueTemplateSpec) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *QueueTemplateSpec) GetPublishBeforeMinutes() int32 {
+	if x != nil {
+		return x.PublishBeforeMinutes
+	}
+	return 0
+}
+
+func (x *QueueTemplateSpec) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *QueueTemplateSpec) GetSlotMinutes() int32 {
+	if x != nil {
+		return x.SlotMinutes
+	}
+	return 0
+}
+
+func (x *QueueTemplateSpec) GetSlotCapacity() int32 {
+	if x != nil {
+		return x.SlotCapacity
+	}
+	return 0
+}
+
+func (x *QueueTemplateSpec) GetAutoArchive() bool {
+	if x != nil {
+		return x.AutoArchive
+	}
+	return false
+}
+
+func (x *QueueTemplateSpec) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *QueueTemplateSpec) GetLotteryAfterMinutes() int32 {
+	if x != nil {
+		return x.LotteryAfterMinutes
+	}
+	return 0
+}
+
+type QueueTemplateDTO struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId       int64                  `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Spec          *QueueTemplateSpec     `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec,omitempty"`
+	LastQueueId   int64                  `protobuf:"varint,4,opt,name=last_queue_id,json=lastQueueId,proto3" json:"last_queue_id,omitempty"`
+	NextRunAt     int64                  `protobuf:"varint,5,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueTemplateDTO) Reset() {
+	*x = QueueTemplateDTO{}
+	mi := &file_queue_queue_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueTemplateDTO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueTemplateDTO) ProtoMessage() {}
+
+func (x *QueueTemplateDTO) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueTemplateDTO.ProtoReflect.Descriptor instead.
+func (*QueueTemplateDTO) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *QueueTemplateDTO) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *QueueTemplateDTO) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *QueueTemplateDTO) GetSpec() *QueueTemplateSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *QueueTemplateDTO) GetLastQueueId() int64 {
+	if x != nil {
+		return x.LastQueueId
+	}
+	return 0
+}
+
+func (x *QueueTemplateDTO) GetNextRunAt() int64 {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return 0
+}
+
+func (x *QueueTemplateDTO) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *QueueTemplateDTO) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type CreateTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       int64                  `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Spec          *QueueTemplateSpec     `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTemplateRequest) Reset() {
+	*x = CreateTemplateRequest{}
+	mi := &file_queue_queue_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateRequest) ProtoMessage() {}
+
+func (x *CreateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *CreateTemplateRequest) GetOwnerId() int64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *CreateTemplateRequest) GetSpec() *QueueTemplateSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+type CreateTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *QueueTemplateDTO      `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTemplateResponse) Reset() {
+	*x = CreateTemplateResponse{}
+	mi := &file_queue_queue_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTemplateResponse) ProtoMessage() {}
+
+func (x *CreateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *CreateTemplateResponse) GetTemplate() *QueueTemplateDTO {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type GetTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    int64                  `protobuf:"varint,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	RequesterId   int64                  `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplateRequest) Reset() {
+	*x = GetTemplateRequest{}
+	mi := &file_queue_queue_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplateRequest) ProtoMessage() {}
+
+func (x *GetTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetTemplateRequest) GetTemplateId() int64 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *GetTemplateRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+type GetTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *QueueTemplateDTO      `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTemplateResponse) Reset() {
+	*x = GetTemplateResponse{}
+	mi := &file_queue_queue_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTemplateResponse) ProtoMessage() {}
+
+func (x *GetTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetTemplateResponse) GetTemplate() *QueueTemplateDTO {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type ListTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupCode     string                 `protobuf:"bytes,1,opt,name=group_code,json=groupCode,proto3" json:"group_code,omitempty"` // empty = templates owned by the requester
+	RequesterId   int64                  `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesRequest) Reset() {
+	*x = ListTemplatesRequest{}
+	mi := &file_queue_queue_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesRequest) ProtoMessage() {}
+
+func (x *ListTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ListTemplatesRequest) GetGroupCode() string {
+	if x != nil {
+		return x.GroupCode
+	}
+	return ""
+}
+
+func (x *ListTemplatesRequest) GetRequesterId() int64 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+type ListTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*QueueTemplateDTO    `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesResponse) Reset() {
+	*x = ListTemplatesResponse{}
+	mi := &file_queue_queue_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesResponse) ProtoMessage() {}
+
+func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ListTemplatesResponse) GetTemplates() []*QueueTemplateDTO {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type UpdateTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    int64                  `protobuf:"varint,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	ActorId       int64                  `protobuf:"varint,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Spec          *QueueTemplateSpec     `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTemplateRequest) Reset() {
+	*x = UpdateTemplateRequest{}
+	mi := &file_queue_queue_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTemplateRequest) ProtoMessage() {}
+
+func (x *UpdateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *UpdateTemplateRequest) GetTemplateId() int64 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *UpdateTemplateRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *UpdateTemplateRequest) GetSpec() *QueueTemplateSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+type UpdateTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *QueueTemplateDTO      `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTemplateResponse) Reset() {
+	*x = UpdateTemplateResponse{}
+	mi := &file_queue_queue_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTemplateResponse) ProtoMessage() {}
+
+func (x *UpdateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *UpdateTemplateResponse) GetTemplate() *QueueTemplateDTO {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type DeleteTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    int64                  `protobuf:"varint,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	ActorId       int64                  `protobuf:"varint,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateRequest) Reset() {
+	*x = DeleteTemplateRequest{}
+	mi := &file_queue_queue_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *DeleteTemplateRequest) GetTemplateId() int64 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *DeleteTemplateRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+type DeleteTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTemplateResponse) Reset() {
+	*x = DeleteTemplateResponse{}
+	mi := &file_queue_queue_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_queue_queue_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_queue_queue_proto_rawDescGZIP(), []int{104}
+}
+
+var File_queue_queue_proto protoreflect.FileDescriptor
+
+const file_queue_queue_proto_rawDesc = "" +
+	"\n" +
+	"\x11queue/queue.proto\x12\x05queue\"\xe7\x04\n" +
+	"\bQueueDTO\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12$\n" +
+	"\x04mode\x18\x04 \x01(\x0e2\x10.queue.QueueModeR\x04mode\x12*\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x12.queue.QueueStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x06 \x01(\tR\tgroupCode\x12\x19\n" +
+	"\bowner_id\x18\a \x01(\x03R\aownerId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\x03R\tupdatedAt\x12\x19\n" +
+	"\bopens_at\x18\n" +
+	" \x01(\x03R\aopensAt\x12\x1b\n" +
+	"\tcloses_at\x18\v \x01(\x03R\bclosesAt\x12\x1d\n" +
+	"\n" +
+	"notify_top\x18\f \x01(\x05R\tnotifyTop\x12!\n" +
+	"\fcall_timeout\x18\r \x01(\x05R\vcallTimeout\x12&\n" +
+	"\x0fcall_miss_shift\x18\x0e \x01(\x05R\rcallMissShift\x12#\n" +
+	"\rswaps_allowed\x18\x0f \x01(\bR\fswapsAllowed\x12\x1d\n" +
+	"\n" +
+	"lottery_at\x18\x10 \x01(\x03R\tlotteryAt\x12!\n" +
+	"\flottery_seed\x18\x11 \x01(\tR\vlotterySeed\x12\x19\n" +
+	"\bdrawn_at\x18\x12 \x01(\x03R\adrawnAt\x12%\n" +
+	"\x0elottery_commit\x18\x13 \x01(\tR\rlotteryCommit\"\xc4\x02\n" +
+	"\x0eParticipantDTO\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bqueue_id\x18\x02 \x01(\x03R\aqueueId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x1b\n" +
+	"\tslot_time\x18\x06 \x01(\tR\bslotTime\x12\x1b\n" +
+	"\tfull_name\x18\a \x01(\tR\bfullName\x12\x1b\n" +
+	"\tcalled_at\x18\b \x01(\x03R\bcalledAt\x12\"\n" +
+	"\rchecked_in_at\x18\t \x01(\x03R\vcheckedInAt\x12\x1c\n" +
+	"\tdeferrals\x18\n" +
+	" \x01(\x05R\tdeferrals\x12\x1a\n" +
+	"\bpriority\x18\v \x01(\x05R\bpriority\"\xbd\x01\n" +
+	"\rQueueEventDTO\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bqueue_id\x18\x02 \x01(\x03R\aqueueId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\x03R\aactorId\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bposition\x18\x06 \x01(\x05R\bposition\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\"\x9e\x02\n" +
+	"\x0fHistoryEntryDTO\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bqueue_id\x18\x02 \x01(\x03R\aqueueId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tfull_name\x18\x04 \x01(\tR\bfullName\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\x05R\bposition\x12\x1b\n" +
+	"\tslot_time\x18\x06 \x01(\tR\bslotTime\x12\x18\n" +
+	"\aoutcome\x18\a \x01(\tR\aoutcome\x12\x19\n" +
+	"\bactor_id\x18\b \x01(\x03R\aactorId\x12\x1b\n" +
+	"\tjoined_at\x18\t \x01(\x03R\bjoinedAt\x12\x1f\n" +
+	"\vfinished_at\x18\n" +
+	" \x01(\x03R\n" +
+	"finishedAt\"U\n" +
+	"\x11ListQueuesRequest\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x01 \x01(\tR\tgroupCode\x12!\n" +
+	"\frequester_id\x18\x02 \x01(\x03R\vrequesterId\"=\n" +
+	"\x12ListQueuesResponse\x12'\n" +
+	"\x06queues\x18\x01 \x03(\v2\x0f.queue.QueueDTOR\x06queues\"\xa2\x02\n" +
+	"\x12CreateQueueRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12$\n" +
+	"\x04mode\x18\x03 \x01(\x0e2\x10.queue.QueueModeR\x04mode\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x04 \x01(\tR\tgroupCode\x12\x19\n" +
+	"\bowner_id\x18\x05 \x01(\x03R\aownerId\x12\x19\n" +
+	"\bopens_at\x18\x06 \x01(\x03R\aopensAt\x12\x1b\n" +
+	"\tcloses_at\x18\a \x01(\x03R\bclosesAt\x12\x1d\n" +
+	"\n" +
+	"notify_top\x18\b \x01(\x05R\tnotifyTop\x12\x1d\n" +
+	"\n" +
+	"lottery_at\x18\t \x01(\x03R\tlotteryAt\"<\n" +
+	"\x13CreateQueueResponse\x12%\n" +
+	"\x05queue\x18\x01 \x01(\v2\x0f.queue.QueueDTOR\x05queue\"n\n" +
+	"\x0fGetQueueRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x02 \x01(\tR\tgroupCode\x12!\n" +
+	"\frequester_id\x18\x03 \x01(\x03R\vrequesterId\"t\n" +
+	"\x10GetQueueResponse\x12%\n" +
+	"\x05queue\x18\x01 \x01(\v2\x0f.queue.QueueDTOR\x05queue\x129\n" +
+	"\fparticipants\x18\x02 \x03(\v2\x15.queue.ParticipantDTOR\fparticipants\"\x9f\x01\n" +
+	"\x10JoinQueueRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x03 \x01(\tR\tgroupCode\x12\x1b\n" +
+	"\tslot_time\x18\x04 \x01(\tR\bslotTime\x12\x1b\n" +
+	"\tuser_name\x18\x05 \x01(\tR\buserName\"/\n" +
+	"\x11JoinQueueResponse\x12\x1a\n" +
+	"\bposition\x18\x01 \x01(\x05R\bposition\"f\n" +
+	"\x11LeaveQueueRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x03 \x01(\tR\tgroupCode\"\x14\n" +
+	"\x12LeaveQueueResponse\"~\n" +
+	"\x13AdvanceQueueRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x02 \x01(\tR\tgroupCode\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\x03R\aactorId\x12\x12\n" +
+	"\x04skip\x18\x04 \x01(\bR\x04skip\"v\n" +
+	"\x14AdvanceQueueResponse\x12/\n" +
+	"\aremoved\x18\x01 \x01(\v2\x15.queue.ParticipantDTOR\aremoved\x12-\n" +
+	"\x06called\x18\x02 \x01(\v2\x15.queue.ParticipantDTOR\x06called\"\x88\x01\n" +
+	"\x18RemoveParticipantRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\x03R\aactorId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x04 \x01(\tR\tgroupCode\"\x1b\n" +
+	"\x19RemoveParticipantResponse\"j\n" +
+	"\x13ArchiveQueueRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x02 \x01(\tR\tgroupCode\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\x03R\aactorId\"\x16\n" +
+	"\x14ArchiveQueueResponse\"i\n" +
+	"\x12DeleteQueueRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x02 \x01(\tR\tgroupCode\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\x03R\aactorId\"\x15\n" +
+	"\x13DeleteQueueResponse\"\xc0\x01\n" +
+	"\x12UpdateQueueRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x02 \x01(\tR\tgroupCode\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\x03R\aactorId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"notify_top\x18\x06 \x01(\x05R\tnotifyTop\"<\n" +
+	"\x13UpdateQueueResponse\x12%\n" +
+	"\x05queue\x18\x01 \x01(\v2\x0f.queue.QueueDTOR\x05queue\"\xbf\x01\n" +
+	"\x15AddParticipantRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\x03R\aactorId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x04 \x01(\tR\tgroupCode\x12\x1b\n" +
+	"\tslot_time\x18\x05 \x01(\tR\bslotTime\x12\x1b\n" +
+	"\tuser_name\x18\x06 \x01(\tR\buserName\"4\n" +
+	"\x16AddParticipantResponse\x12\x1a\n" +
+	"\bposition\x18\x01 \x01(\x05R\bposition\"\xad\x01\n" +
+	"\x14SetCallPolicyRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x02 \x01(\tR\tgroupCode\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\x03R\aactorId\x12!\n" +
+	"\fcall_timeout\x18\x04 \x01(\x05R\vcallTimeout\x12\x1d\n" +
+	"\n" +
+	"miss_shift\x18\x05 \x01(\x05R\tmissShift\">\n" +
+	"\x15SetCallPolicyResponse\x12%\n" +
+	"\x05queue\x18\x01 \x01(\v2\x0f.queue.QueueDTOR\x05queue\"c\n" +
+	"\x0eCheckInRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x03 \x01(\tR\tgroupCode\"J\n" +
+	"\x0fCheckInResponse\x127\n" +
+	"\vparticipant\x18\x01 \x01(\v2\x15.queue.ParticipantDTOR\vparticipant\"\xa2\x01\n" +
+	"\x16MoveParticipantRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x02 \x01(\tR\tgroupCode\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\x03R\aactorId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\x05R\bposition\"T\n" +
+	"\x17MoveParticipantResponse\x129\n" +
+	"\fparticipants\x18\x01 \x03(\v2\x15.queue.ParticipantDTOR\fparticipants\"\xb8\x01\n" +
+	"\x17SwapParticipantsRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x02 \x01(\tR\tgroupCode\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\x03R\aactorId\x12\"\n" +
+	"\rfirst_user_id\x18\x04 \x01(\x03R\vfirstUserId\x12$\n" +
+	"\x0esecond_user_id\x18\x05 \x01(\x03R\fsecondUserId\"U\n" +
+	"\x18SwapParticipantsResponse\x129\n" +
+	"\fparticipants\x18\x01 \x03(\v2\x15.queue.ParticipantDTOR\fparticipants\"\x85\x01\n" +
+	"\x13ReorderQueueRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x02 \x01(\tR\tgroupCode\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\x03R\aactorId\x12\x19\n" +
+	"\buser_ids\x18\x04 \x03(\x03R\auserIds\"Q\n" +
+	"\x14ReorderQueueResponse\x129\n" +
+	"\fparticipants\x18\x01 \x03(\v2\x15.queue.ParticipantDTOR\fparticipants\"\x87\x01\n" +
+	"\x11SetLotteryRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x02 \x01(\tR\tgroupCode\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\x03R\aactorId\x12\x1d\n" +
+	"\n" +
+	"lottery_at\x18\x04 \x01(\x03R\tlotteryAt\";\n" +
+	"\x12SetLotteryResponse\x12%\n" +
+	"\x05queue\x18\x01 \x01(\v2\x0f.queue.QueueDTOR\x05queue\"i\n" +
+	"\x12DrawLotteryRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x02 \x01(\tR\tgroupCode\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\x03R\aactorId\"w\n" +
+	"\x13DrawLotteryResponse\x12%\n" +
+	"\x05queue\x18\x01 \x01(\v2\x0f.queue.QueueDTOR\x05queue\x129\n" +
+	"\fparticipants\x18\x02 \x03(\v2\x15.queue.ParticipantDTOR\fparticipants\"}\n" +
+	"\x10DeferTurnRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x02 \x01(\tR\tgroupCode\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06places\x18\x04 \x01(\x05R\x06places\"L\n" +
+	"\x11DeferTurnResponse\x127\n" +
+	"\vparticipant\x18\x01 \x01(\v2\x15.queue.ParticipantDTOR\vparticipant\"\xa9\x01\n" +
+	"\x1dSetParticipantPriorityRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x02 \x01(\tR\tgroupCode\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\x03R\aactorId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bpriority\x18\x05 \x01(\x05R\bpriority\"[\n" +
+	"\x1eSetParticipantPriorityResponse\x129\n" +
+	"\fparticipants\x18\x01 \x03(\v2\x15.queue.ParticipantDTOR\fparticipants\"\x85\x02\n" +
+	"\x0eSwapRequestDTO\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bqueue_id\x18\x02 \x01(\x03R\aqueueId\x12 \n" +
+	"\ffrom_user_id\x18\x03 \x01(\x03R\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x04 \x01(\x03R\btoUserId\x12)\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x11.queue.SwapStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\x03R\texpiresAt\x12\x1f\n" +
+	"\vanswered_at\x18\b \x01(\x03R\n" +
+	"answeredAt\"\x85\x01\n" +
+	"\x14SetSwapPolicyRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x02 \x01(\tR\tgroupCode\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\x03R\aactorId\x12\x18\n" +
+	"\aallowed\x18\x04 \x01(\bR\aallowed\">\n" +
+	"\x15SetSwapPolicyResponse\x12%\n" +
+	"\x05queue\x18\x01 \x01(\v2\x0f.queue.QueueDTOR\x05queue\"\x8d\x01\n" +
+	"\x12RequestSwapRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x02 \x01(\tR\tgroupCode\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12$\n" +
+	"\x0etarget_user_id\x18\x04 \x01(\x03R\ftargetUserId\"F\n" +
+	"\x13RequestSwapResponse\x12/\n" +
+	"\arequest\x18\x01 \x01(\v2\x15.queue.SwapRequestDTOR\arequest\"\x82\x01\n" +
+	"\x11AnswerSwapRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\x03R\trequestId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x02 \x01(\tR\tgroupCode\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06accept\x18\x04 \x01(\bR\x06accept\"\x80\x01\n" +
+	"\x12AnswerSwapResponse\x12/\n" +
+	"\arequest\x18\x01 \x01(\v2\x15.queue.SwapRequestDTOR\arequest\x129\n" +
+	"\fparticipants\x18\x02 \x03(\v2\x15.queue.ParticipantDTOR\fparticipants\"K\n" +
+	"\x11CancelSwapRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\x03R\trequestId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"E\n" +
+	"\x12CancelSwapResponse\x12/\n" +
+	"\arequest\x18\x01 \x01(\v2\x15.queue.SwapRequestDTOR\arequest\"l\n" +
+	"\x17ListSwapRequestsRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x02 \x01(\tR\tgroupCode\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\"M\n" +
+	"\x18ListSwapRequestsResponse\x121\n" +
+	"\brequests\x18\x01 \x03(\v2\x15.queue.SwapRequestDTOR\brequests\"p\n" +
+	"\x11WatchQueueRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x02 \x01(\tR\tgroupCode\x12!\n" +
+	"\frequester_id\x18\x03 \x01(\x03R\vrequesterId\"\x89\x01\n" +
+	"\vQueueUpdate\x12%\n" +
+	"\x05queue\x18\x01 \x01(\v2\x0f.queue.QueueDTOR\x05queue\x129\n" +
+	"\fparticipants\x18\x02 \x03(\v2\x15.queue.ParticipantDTOR\fparticipants\x12\x18\n" +
+	"\adeleted\x18\x03 \x01(\bR\adeleted\"\x83\x02\n" +
+	"\x16ListQueueEventsRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x02 \x01(\tR\tgroupCode\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\x03R\aactorId\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x14\n" +
+	"\x05since\x18\x05 \x01(\x03R\x05since\x12\x14\n" +
+	"\x05until\x18\x06 \x01(\x03R\x05until\x12\x1b\n" +
+	"\tbefore_id\x18\a \x01(\x03R\bbeforeId\x12\x14\n" +
+	"\x05limit\x18\b \x01(\x05R\x05limit\x12!\n" +
+	"\frequester_id\x18\t \x01(\x03R\vrequesterId\"m\n" +
+	"\x17ListQueueEventsResponse\x12,\n" +
+	"\x06events\x18\x01 \x03(\v2\x14.queue.QueueEventDTOR\x06events\x12$\n" +
+	"\x0enext_before_id\x18\x02 \x01(\x03R\fnextBeforeId\"\xdb\x01\n" +
+	"\x16GetQueueHistoryRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x02 \x01(\tR\tgroupCode\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x18\n" +
+	"\aoutcome\x18\x04 \x01(\tR\aoutcome\x12\x1b\n" +
+	"\tbefore_id\x18\x05 \x01(\x03R\bbeforeId\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\x12!\n" +
+	"\frequester_id\x18\a \x01(\x03R\vrequesterId\"q\n" +
+	"\x17GetQueueHistoryResponse\x120\n" +
+	"\aentries\x18\x01 \x03(\v2\x16.queue.HistoryEntryDTOR\aentries\x12$\n" +
+	"\x0enext_before_id\x18\x02 \x01(\x03R\fnextBeforeId\"\x96\x01\n" +
+	"\x0eQueueMemberDTO\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"granted_by\x18\x04 \x01(\x03R\tgrantedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\"q\n" +
+	"\x12ListMembersRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x02 \x01(\tR\tgroupCode\x12!\n" +
+	"\frequester_id\x18\x03 \x01(\x03R\vrequesterId\"F\n" +
+	"\x13ListMembersResponse\x12/\n" +
+	"\amembers\x18\x01 \x03(\v2\x15.queue.QueueMemberDTOR\amembers\"\x94\x01\n" +
+	"\x10GrantRoleRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x02 \x01(\tR\tgroupCode\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x19\n" +
+	"\bactor_id\x18\x05 \x01(\x03R\aactorId\"B\n" +
+	"\x11GrantRoleResponse\x12-\n" +
+	"\x06member\x18\x01 \x01(\v2\x15.queue.QueueMemberDTOR\x06member\"\x81\x01\n" +
+	"\x11RevokeRoleRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x02 \x01(\tR\tgroupCode\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\x03R\aactorId\"\x14\n" +
+	"\x12RevokeRoleResponse\"\xf4\x01\n" +
+	"\bGroupDTO\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x19\n" +
+	"\bowner_id\x18\x04 \x01(\x03R\aownerId\x12\x1f\n" +
+	"\vinvite_code\x18\x05 \x01(\tR\n" +
+	"inviteCode\x12)\n" +
+	"\x10require_approval\x18\x06 \x01(\bR\x0frequireApproval\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\x12\x12\n" +
+	"\x04role\x18\b \x01(\tR\x04role\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\"\xac\x01\n" +
+	"\x0eGroupMemberDTO\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tfull_name\x18\x03 \x01(\tR\bfullName\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\"\xa1\x01\n" +
+	"\x12CreateGroupRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12)\n" +
+	"\x10require_approval\x18\x03 \x01(\bR\x0frequireApproval\x12\x19\n" +
+	"\bowner_id\x18\x04 \x01(\x03R\aownerId\x12\x1d\n" +
+	"\n" +
+	"owner_name\x18\x05 \x01(\tR\townerName\"<\n" +
+	"\x13CreateGroupResponse\x12%\n" +
+	"\x05group\x18\x01 \x01(\v2\x0f.queue.GroupDTOR\x05group\",\n" +
+	"\x11ListGroupsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"=\n" +
+	"\x12ListGroupsResponse\x12'\n" +
+	"\x06groups\x18\x01 \x03(\v2\x0f.queue.GroupDTOR\x06groups\"O\n" +
+	"\x0fGetGroupRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12!\n" +
+	"\frequester_id\x18\x02 \x01(\x03R\vrequesterId\"9\n" +
+	"\x10GetGroupResponse\x12%\n" +
+	"\x05group\x18\x01 \x01(\v2\x0f.queue.GroupDTOR\x05group\"i\n" +
+	"\x10JoinGroupRequest\x12\x1f\n" +
+	"\vinvite_code\x18\x01 \x01(\tR\n" +
+	"inviteCode\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x03 \x01(\tR\buserName\"B\n" +
+	"\x11JoinGroupResponse\x12-\n" +
+	"\x06member\x18\x01 \x01(\v2\x15.queue.GroupMemberDTOR\x06member\"o\n" +
+	"\x17ListGroupMembersRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12!\n" +
+	"\frequester_id\x18\x02 \x01(\x03R\vrequesterId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"K\n" +
+	"\x18ListGroupMembersResponse\x12/\n" +
+	"\amembers\x18\x01 \x03(\v2\x15.queue.GroupMemberDTOR\amembers\"\x83\x01\n" +
+	"\x18ReviewGroupMemberRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\x03R\aactorId\x12\x18\n" +
+	"\aapprove\x18\x04 \x01(\bR\aapprove\"\x1b\n" +
+	"\x19ReviewGroupMemberResponse\"i\n" +
+	"\x18RemoveGroupMemberRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\x03R\aactorId\"\x1b\n" +
+	"\x19RemoveGroupMemberResponse\"S\n" +
+	"\x1bRegenerateInviteCodeRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\x03R\aactorId\"?\n" +
+	"\x1cRegenerateInviteCodeResponse\x12\x1f\n" +
+	"\vinvite_code\x18\x01 \x01(\tR\n" +
+	"inviteCode\"D\n" +
+	"\fSlotBreakDTO\x12\x1b\n" +
+	"\tstarts_at\x18\x01 \x01(\tR\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\x02 \x01(\tR\x06endsAt\"\xed\x01\n" +
+	"\x0fSlotScheduleDTO\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1b\n" +
+	"\tstarts_at\x18\x02 \x01(\tR\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\x03 \x01(\tR\x06endsAt\x12!\n" +
+	"\fslot_minutes\x18\x04 \x01(\x05R\vslotMinutes\x12\x1a\n" +
+	"\bcapacity\x18\x05 \x01(\x05R\bcapacity\x12+\n" +
+	"\x06breaks\x18\x06 \x03(\v2\x13.queue.SlotBreakDTOR\x06breaks\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\x03R\tupdatedAt\"q\n" +
+	"\aSlotDTO\x12\x1b\n" +
+	"\tstarts_at\x18\x01 \x01(\tR\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\x02 \x01(\tR\x06endsAt\x12\x1a\n" +
+	"\bcapacity\x18\x03 \x01(\x05R\bcapacity\x12\x14\n" +
+	"\x05taken\x18\x04 \x01(\x05R\x05taken\"\x8f\x02\n" +
+	"\x16SetSlotScheduleRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x02 \x01(\tR\tgroupCode\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\x03R\aactorId\x12\x1b\n" +
+	"\tstarts_at\x18\x04 \x01(\tR\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\x05 \x01(\tR\x06endsAt\x12!\n" +
+	"\fslot_minutes\x18\x06 \x01(\x05R\vslotMinutes\x12\x1a\n" +
+	"\bcapacity\x18\a \x01(\x05R\bcapacity\x12+\n" +
+	"\x06breaks\x18\b \x03(\v2\x13.queue.SlotBreakDTOR\x06breaks\"M\n" +
+	"\x17SetSlotScheduleResponse\x122\n" +
+	"\bschedule\x18\x01 \x01(\v2\x16.queue.SlotScheduleDTOR\bschedule\"s\n" +
+	"\x14ListFreeSlotsRequest\x12\x19\n" +
+	"\bqueue_id\x18\x01 \x01(\x03R\aqueueId\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x02 \x01(\tR\tgroupCode\x12!\n" +
+	"\frequester_id\x18\x03 \x01(\x03R\vrequesterId\"q\n" +
+	"\x15ListFreeSlotsResponse\x122\n" +
+	"\bschedule\x18\x01 \x01(\v2\x16.queue.SlotScheduleDTOR\bschedule\x12$\n" +
+	"\x05slots\x18\x02 \x03(\v2\x0e.queue.SlotDTOR\x05slots\"\xff\x03\n" +
+	"\x11QueueTemplateSpec\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12$\n" +
+	"\x04mode\x18\x03 \x01(\x0e2\x10.queue.QueueModeR\x04mode\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x04 \x01(\tR\tgroupCode\x12\x1a\n" +
+	"\bweekdays\x18\x05 \x03(\x05R\bweekdays\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x06 \x01(\tR\tstartTime\x12\x1a\n" +
+	"\btimezone\x18\a \x01(\tR\btimezone\x124\n" +
+	"\x16publish_before_minutes\x18\b \x01(\x05R\x14publishBeforeMinutes\x12)\n" +
+	"\x10duration_minutes\x18\t \x01(\x05R\x0fdurationMinutes\x12!\n" +
+	"\fslot_minutes\x18\n" +
+	" \x01(\x05R\vslotMinutes\x12#\n" +
+	"\rslot_capacity\x18\v \x01(\x05R\fslotCapacity\x12!\n" +
+	"\fauto_archive\x18\f \x01(\bR\vautoArchive\x12\x16\n" +
+	"\x06paused\x18\r \x01(\bR\x06paused\x122\n" +
+	"\x15lottery_after_minutes\x18\x0e \x01(\x05R\x13lotteryAfterMinutes\"\xed\x01\n" +
+	"\x10QueueTemplateDTO\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\x03R\aownerId\x12,\n" +
+	"\x04spec\x18\x03 \x01(\v2\x18.queue.QueueTemplateSpecR\x04spec\x12\"\n" +
+	"\rlast_queue_id\x18\x04 \x01(\x03R\vlastQueueId\x12\x1e\n" +
+	"\vnext_run_at\x18\x05 \x01(\x03R\tnextRunAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\x03R\tupdatedAt\"`\n" +
+	"\x15CreateTemplateRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\x03R\aownerId\x12,\n" +
+	"\x04spec\x18\x02 \x01(\v2\x18.queue.QueueTemplateSpecR\x04spec\"M\n" +
+	"\x16CreateTemplateResponse\x123\n" +
+	"\btemplate\x18\x01 \x01(\v2\x17.queue.QueueTemplateDTOR\btemplate\"X\n" +
+	"\x12GetTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\x03R\n" +
+	"templateId\x12!\n" +
+	"\frequester_id\x18\x02 \x01(\x03R\vrequesterId\"J\n" +
+	"\x13GetTemplateResponse\x123\n" +
+	"\btemplate\x18\x01 \x01(\v2\x17.queue.QueueTemplateDTOR\btemplate\"X\n" +
+	"\x14ListTemplatesRequest\x12\x1d\n" +
+	"\n" +
+	"group_code\x18\x01 \x01(\tR\tgroupCode\x12!\n" +
+	"\frequester_id\x18\x02 \x01(\x03R\vrequesterId\"N\n" +
+	"\x15ListTemplatesResponse\x125\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x17.queue.QueueTemplateDTOR\ttemplates\"\x81\x01\n" +
+	"\x15UpdateTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\x03R\n" +
+	"templateId\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\x03R\aactorId\x12,\n" +
+	"\x04spec\x18\x03 \x01(\v2\x18.queue.QueueTemplateSpecR\x04spec\"M\n" +
+	"\x16UpdateTemplateResponse\x123\n" +
+	"\btemplate\x18\x01 \x01(\v2\x17.queue.QueueTemplateDTOR\btemplate\"S\n" +
+	"\x15DeleteTemplateRequest\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\x03R\n" +
+	"templateId\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\x03R\aactorId\"\x18\n" +
+	"\x16DeleteTemplateResponse*\x9a\x01\n" +
+	"\tQueueMode\x12\x1a\n" +
+	"\x16QUEUE_MODE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fQUEUE_MODE_LIVE\x10\x01\x12\x16\n" +
+	"\x12QUEUE_MODE_MANAGED\x10\x02\x12\x15\n" +
+	"\x11QUEUE_MODE_RANDOM\x10\x03\x12\x14\n" +
+	"\x10QUEUE_MODE_SLOTS\x10\x04\x12\x17\n" +
+	"\x13QUEUE_MODE_PRIORITY\x10\x05*\x94\x01\n" +
+	"\vQueueStatus\x12\x1c\n" +
+	"\x18QUEUE_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13QUEUE_STATUS_ACTIVE\x10\x01\x12\x19\n" +
+	"\x15QUEUE_STATUS_ARCHIVED\x10\x02\x12\x1a\n" +
+	"\x16QUEUE_STATUS_SCHEDULED\x10\x03\x12\x17\n" +
+	"\x13QUEUE_STATUS_CLOSED\x10\x04*\xaa\x01\n" +
+	"\n" +
+	"SwapStatus\x12\x1b\n" +
+	"\x17SWAP_STATUS_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13SWAP_STATUS_PENDING\x10\x01\x12\x18\n" +
+	"\x14SWAP_STATUS_ACCEPTED\x10\x02\x12\x18\n" +
+	"\x14SWAP_STATUS_DECLINED\x10\x03\x12\x19\n" +
+	"\x15SWAP_STATUS_CANCELLED\x10\x04\x12\x17\n" +
+	"\x13SWAP_STATUS_EXPIRED\x10\x052\xdc\x1a\n" +
+	"\x05Queue\x12A\n" +
+	"\n" +
+	"ListQueues\x12\x18.queue.ListQueuesRequest\x1a\x19.queue.ListQueuesResponse\x12D\n" +
+	"\vCreateQueue\x12\x19.queue.CreateQueueRequest\x1a\x1a.queue.CreateQueueResponse\x12;\n" +
+	"\bGetQueue\x12\x16.queue.GetQueueRequest\x1a\x17.queue.GetQueueResponse\x12>\n" +
+	"\tJoinQueue\x12\x17.queue.JoinQueueRequest\x1a\x18.queue.JoinQueueResponse\x12A\n" +
+	"\n" +
+	"LeaveQueue\x12\x18.queue.LeaveQueueRequest\x1a\x19.queue.LeaveQueueResponse\x12G\n" +
+	"\fAdvanceQueue\x12\x1a.queue.AdvanceQueueRequest\x1a\x1b.queue.AdvanceQueueResponse\x12V\n" +
+	"\x11RemoveParticipant\x12\x1f.queue.RemoveParticipantRequest\x1a .queue.RemoveParticipantResponse\x12G\n" +
+	"\fArchiveQueue\x12\x1a.queue.ArchiveQueueRequest\x1a\x1b.queue.ArchiveQueueResponse\x12D\n" +
+	"\vDeleteQueue\x12\x19.queue.DeleteQueueRequest\x1a\x1a.queue.DeleteQueueResponse\x12D\n" +
+	"\vUpdateQueue\x12\x19.queue.UpdateQueueRequest\x1a\x1a.queue.UpdateQueueResponse\x12M\n" +
+	"\x0eAddParticipant\x12\x1c.queue.AddParticipantRequest\x1a\x1d.queue.AddParticipantResponse\x12J\n" +
+	"\rSetCallPolicy\x12\x1b.queue.SetCallPolicyRequest\x1a\x1c.queue.SetCallPolicyResponse\x128\n" +
+	"\aCheckIn\x12\x15.queue.CheckInRequest\x1a\x16.queue.CheckInResponse\x12P\n" +
+	"\x0fMoveParticipant\x12\x1d.queue.MoveParticipantRequest\x1a\x1e.queue.MoveParticipantResponse\x12S\n" +
+	"\x10SwapParticipants\x12\x1e.queue.SwapParticipantsRequest\x1a\x1f.queue.SwapParticipantsResponse\x12G\n" +
+	"\fReorderQueue\x12\x1a.queue.ReorderQueueRequest\x1a\x1b.queue.ReorderQueueResponse\x12A\n" +
+	"\n" +
+	"SetLottery\x12\x18.queue.SetLotteryRequest\x1a\x19.queue.SetLotteryResponse\x12D\n" +
+	"\vDrawLottery\x12\x19.queue.DrawLotteryRequest\x1a\x1a.queue.DrawLotteryResponse\x12>\n" +
+	"\tDeferTurn\x12\x17.queue.DeferTurnRequest\x1a\x18.queue.DeferTurnResponse\x12e\n" +
+	"\x16SetParticipantPriority\x12$.queue.SetParticipantPriorityRequest\x1a%.queue.SetParticipantPriorityResponse\x12J\n" +
+	"\rSetSwapPolicy\x12\x1b.queue.SetSwapPolicyRequest\x1a\x1c.queue.SetSwapPolicyResponse\x12D\n" +
+	"\vRequestSwap\x12\x19.queue.RequestSwapRequest\x1a\x1a.queue.RequestSwapResponse\x12A\n" +
+	"\n" +
+	"AnswerSwap\x12\x18.queue.AnswerSwapRequest\x1a\x19.queue.AnswerSwapResponse\x12A\n" +
+	"\n" +
+	"CancelSwap\x12\x18.queue.CancelSwapRequest\x1a\x19.queue.CancelSwapResponse\x12S\n" +
+	"\x10ListSwapRequests\x12\x1e.queue.ListSwapRequestsRequest\x1a\x1f.queue.ListSwapRequestsResponse\x12<\n" +
+	"\n" +
+	"WatchQueue\x12\x18.queue.WatchQueueRequest\x1a\x12.queue.QueueUpdate0\x01\x12P\n" +
+	"\x0fListQueueEvents\x12\x1d.queue.ListQueueEventsRequest\x1a\x1e.queue.ListQueueEventsResponse\x12P\n" +
+	"\x0fGetQueueHistory\x12\x1d.queue.GetQueueHistoryRequest\x1a\x1e.queue.GetQueueHistoryResponse\x12D\n" +
+	"\vListMembers\x12\x19.queue.ListMembersRequest\x1a\x1a.queue.ListMembersResponse\x12>\n" +
+	"\tGrantRole\x12\x17.queue.GrantRoleRequest\x1a\x18.queue.GrantRoleResponse\x12A\n" +
+	"\n" +
+	"RevokeRole\x12\x18.queue.RevokeRoleRequest\x1a\x19.queue.RevokeRoleResponse\x12P\n" +
+	"\x0fSetSlotSchedule\x12\x1d.queue.SetSlotScheduleRequest\x1a\x1e.queue.SetSlotScheduleResponse\x12J\n" +
+	"\rListFreeSlots\x12\x1b.queue.ListFreeSlotsRequest\x1a\x1c.queue.ListFreeSlotsResponse\x12D\n" +
+	"\vCreateGroup\x12\x19.queue.CreateGroupRequest\x1a\x1a.queue.CreateGroupResponse\x12A\n" +
+	"\n" +
+	"ListGroups\x12\x18.queue.ListGroupsRequest\x1a\x19.queue.ListGroupsResponse\x12;\n" +
+	"\bGetGroup\x12\x16.queue.GetGroupRequest\x1a\x17.queue.GetGroupResponse\x12>\n" +
+	"\tJoinGroup\x12\x17.queue.JoinGroupRequest\x1a\x18.queue.JoinGroupResponse\x12S\n" +
+	"\x10ListGroupMembers\x12\x1e.queue.ListGroupMembersRequest\x1a\x1f.queue.ListGroupMembersResponse\x12V\n" +
+	"\x11ReviewGroupMember\x12\x1f.queue.ReviewGroupMemberRequest\x1a .queue.ReviewGroupMemberResponse\x12V\n" +
+	"\x11RemoveGroupMember\x12\x1f.queue.RemoveGroupMemberRequest\x1a .queue.RemoveGroupMemberResponse\x12_\n" +
+	"\x14RegenerateInviteCode\x12\".queue.RegenerateInviteCodeRequest\x1a#.queue.RegenerateInviteCodeResponse\x12M\n" +
+	"\x0eCreateTemplate\x12\x1c.queue.CreateTemplateRequest\x1a\x1d.queue.CreateTemplateResponse\x12D\n" +
+	"\vGetTemplate\x12\x19.queue.GetTemplateRequest\x1a\x1a.queue.GetTemplateResponse\x12J\n" +
+	"\rListTemplates\x12\x1b.queue.ListTemplatesRequest\x1a\x1c.queue.ListTemplatesResponse\x12M\n" +
+	"\x0eUpdateTemplate\x12\x1c.queue.UpdateTemplateRequest\x1a\x1d.queue.UpdateTemplateResponse\x12M\n" +
+	"\x0eDeleteTemplate\x12\x1c.queue.DeleteTemplateRequest\x1a\x1d.queue.DeleteTemplateResponseBAZ?github.com/s1lentmol/q-flow-backend/protos/gen/go/queue;queuev1b\x06proto3"
 
 var (
 	file_queue_queue_proto_rawDescOnce sync.Once
@@ -1646,73 +7269,267 @@ func file_queue_queue_proto_rawDescGZIP() []byte {
 	return file_queue_queue_proto_rawDescData
 }
 
-var file_queue_queue_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_queue_queue_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_queue_queue_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_queue_queue_proto_msgTypes = make([]protoimpl.MessageInfo, 105)
 var file_queue_queue_proto_goTypes = []any{
-	(QueueMode)(0),                    // 0: queue.QueueMode
-	(QueueStatus)(0),                  // 1: queue.QueueStatus
-	(*QueueDTO)(nil),                  // 2: queue.QueueDTO
-	(*ParticipantDTO)(nil),            // 3: queue.ParticipantDTO
-	(*ListQueuesRequest)(nil),         // 4: queue.ListQueuesRequest
-	(*ListQueuesResponse)(nil),        // 5: queue.ListQueuesResponse
-	(*CreateQueueRequest)(nil),        // 6: queue.CreateQueueRequest
-	(*CreateQueueResponse)(nil),       // 7: queue.CreateQueueResponse
-	(*GetQueueRequest)(nil),           // 8: queue.GetQueueRequest
-	(*GetQueueResponse)(nil),          // 9: queue.GetQueueResponse
-	(*JoinQueueRequest)(nil),          // 10: queue.JoinQueueRequest
-	(*JoinQueueResponse)(nil),         // 11: queue.JoinQueueResponse
-	(*LeaveQueueRequest)(nil),         // 12: queue.LeaveQueueRequest
-	(*LeaveQueueResponse)(nil),        // 13: queue.LeaveQueueResponse
-	(*AdvanceQueueRequest)(nil),       // 14: queue.AdvanceQueueRequest
-	(*AdvanceQueueResponse)(nil),      // 15: queue.AdvanceQueueResponse
-	(*RemoveParticipantRequest)(nil),  // 16: queue.RemoveParticipantRequest
-	(*RemoveParticipantResponse)(nil), // 17: queue.RemoveParticipantResponse
-	(*ArchiveQueueRequest)(nil),       // 18: queue.ArchiveQueueRequest
-	(*ArchiveQueueResponse)(nil),      // 19: queue.ArchiveQueueResponse
-	(*DeleteQueueRequest)(nil),        // 20: queue.DeleteQueueRequest
-	(*DeleteQueueResponse)(nil),       // 21: queue.DeleteQueueResponse
-	(*UpdateQueueRequest)(nil),        // 22: queue.UpdateQueueRequest
-	(*UpdateQueueResponse)(nil),       // 23: queue.UpdateQueueResponse
-	(*AddParticipantRequest)(nil),     // 24: queue.AddParticipantRequest
-	(*AddParticipantResponse)(nil),    // 25: queue.AddParticipantResponse
+	(QueueMode)(0),                         // 0: queue.QueueMode
+	(QueueStatus)(0),                       // 1: queue.QueueStatus
+	(SwapStatus)(0),                        // 2: queue.SwapStatus
+	(*QueueDTO)(nil),                       // 3: queue.QueueDTO
+	(*ParticipantDTO)(nil),                 // 4: queue.ParticipantDTO
+	(*QueueEventDTO)(nil),                  // 5: queue.QueueEventDTO
+	(*HistoryEntryDTO)(nil),                // 6: queue.HistoryEntryDTO
+	(*ListQueuesRequest)(nil),              // 7: queue.ListQueuesRequest
+	(*ListQueuesResponse)(nil),             // 8: queue.ListQueuesResponse
+	(*CreateQueueRequest)(nil),             // 9: queue.CreateQueueRequest
+	(*CreateQueueResponse)(nil),            // 10: queue.CreateQueueResponse
+	(*GetQueueRequest)(nil),                // 11: queue.GetQueueRequest
+	(*GetQueueResponse)(nil),               // 12: queue.GetQueueResponse
+	(*JoinQueueRequest)(nil),               // 13: queue.JoinQueueRequest
+	(*JoinQueueResponse)(nil),              // 14: queue.JoinQueueResponse
+	(*LeaveQueueRequest)(nil),              // 15: queue.LeaveQueueRequest
+	(*LeaveQueueResponse)(nil),             // 16: queue.LeaveQueueResponse
+	(*AdvanceQueueRequest)(nil),            // 17: queue.AdvanceQueueRequest
+	(*AdvanceQueueResponse)(nil),           // 18: queue.AdvanceQueueResponse
+	(*RemoveParticipantRequest)(nil),       // 19: queue.RemoveParticipantRequest
+	(*RemoveParticipantResponse)(nil),      // 20: queue.RemoveParticipantResponse
+	(*ArchiveQueueRequest)(nil),            // 21: queue.ArchiveQueueRequest
+	(*ArchiveQueueResponse)(nil),           // 22: queue.ArchiveQueueResponse
+	(*DeleteQueueRequest)(nil),             // 23: queue.DeleteQueueRequest
+	(*DeleteQueueResponse)(nil),            // 24: queue.DeleteQueueResponse
+	(*UpdateQueueRequest)(nil),             // 25: queue.UpdateQueueRequest
+	(*UpdateQueueResponse)(nil),            // 26: queue.UpdateQueueResponse
+	(*AddParticipantRequest)(nil),          // 27: queue.AddParticipantRequest
+	(*AddParticipantResponse)(nil),         // 28: queue.AddParticipantResponse
+	(*SetCallPolicyRequest)(nil),           // 29: queue.SetCallPolicyRequest
+	(*SetCallPolicyResponse)(nil),          // 30: queue.SetCallPolicyResponse
+	(*CheckInRequest)(nil),                 // 31: queue.CheckInRequest
+	(*CheckInResponse)(nil),                // 32: queue.CheckInResponse
+	(*MoveParticipantRequest)(nil),         // 33: queue.MoveParticipantRequest
+	(*MoveParticipantResponse)(nil),        // 34: queue.MoveParticipantResponse
+	(*SwapParticipantsRequest)(nil),        // 35: queue.SwapParticipantsRequest
+	(*SwapParticipantsResponse)(nil),       // 36: queue.SwapParticipantsResponse
+	(*ReorderQueueRequest)(nil),            // 37: queue.ReorderQueueRequest
+	(*ReorderQueueResponse)(nil),           // 38: queue.ReorderQueueResponse
+	(*SetLotteryRequest)(nil),              // 39: queue.SetLotteryRequest
+	(*SetLotteryResponse)(nil),             // 40: queue.SetLotteryResponse
+	(*DrawLotteryRequest)(nil),             // 41: queue.DrawLotteryRequest
+	(*DrawLotteryResponse)(nil),            // 42: queue.DrawLotteryResponse
+	(*DeferTurnRequest)(nil),               // 43: queue.DeferTurnRequest
+	(*DeferTurnResponse)(nil),              // 44: queue.DeferTurnResponse
+	(*SetParticipantPriorityRequest)(nil),  // 45: queue.SetParticipantPriorityRequest
+	(*SetParticipantPriorityResponse)(nil), // 46: queue.SetParticipantPriorityResponse
+	(*SwapRequestDTO)(nil),                 // 47: queue.SwapRequestDTO
+	(*SetSwapPolicyRequest)(nil),           // 48: queue.SetSwapPolicyRequest
+	(*SetSwapPolicyResponse)(nil),          // 49: queue.SetSwapPolicyResponse
+	(*RequestSwapRequest)(nil),             // 50: queue.RequestSwapRequest
+	(*RequestSwapResponse)(nil),            // 51: queue.RequestSwapResponse
+	(*AnswerSwapRequest)(nil),              // 52: queue.AnswerSwapRequest
+	(*AnswerSwapResponse)(nil),             // 53: queue.AnswerSwapResponse
+	(*CancelSwapRequest)(nil),              // 54: queue.CancelSwapRequest
+	(*CancelSwapResponse)(nil),             // 55: queue.CancelSwapResponse
+	(*ListSwapRequestsRequest)(nil),        // 56: queue.ListSwapRequestsRequest
+	(*ListSwapRequestsResponse)(nil),       // 57: queue.ListSwapRequestsResponse
+	(*WatchQueueRequest)(nil),              // 58: queue.WatchQueueRequest
+	(*QueueUpdate)(nil),                    // 59: queue.QueueUpdate
+	(*ListQueueEventsRequest)(nil),         // 60: queue.ListQueueEventsRequest
+	(*ListQueueEventsResponse)(nil),        // 61: queue.ListQueueEventsResponse
+	(*GetQueueHistoryRequest)(nil),         // 62: queue.GetQueueHistoryRequest
+	(*GetQueueHistoryResponse)(nil),        // 63: queue.GetQueueHistoryResponse
+	(*QueueMemberDTO)(nil),                 // 64: queue.QueueMemberDTO
+	(*ListMembersRequest)(nil),             // 65: queue.ListMembersRequest
+	(*ListMembersResponse)(nil),            // 66: queue.ListMembersResponse
+	(*GrantRoleRequest)(nil),               // 67: queue.GrantRoleRequest
+	(*GrantRoleResponse)(nil),              // 68: queue.GrantRoleResponse
+	(*RevokeRoleRequest)(nil),              // 69: queue.RevokeRoleRequest
+	(*RevokeRoleResponse)(nil),             // 70: queue.RevokeRoleResponse
+	(*GroupDTO)(nil),                       // 71: queue.GroupDTO
+	(*GroupMemberDTO)(nil),                 // 72: queue.GroupMemberDTO
+	(*CreateGroupRequest)(nil),             // 73: queue.CreateGroupRequest
+	(*CreateGroupResponse)(nil),            // 74: queue.CreateGroupResponse
+	(*ListGroupsRequest)(nil),              // 75: queue.ListGroupsRequest
+	(*ListGroupsResponse)(nil),             // 76: queue.ListGroupsResponse
+	(*GetGroupRequest)(nil),                // 77: queue.GetGroupRequest
+	(*GetGroupResponse)(nil),               // 78: queue.GetGroupResponse
+	(*JoinGroupRequest)(nil),               // 79: queue.JoinGroupRequest
+	(*JoinGroupResponse)(nil),              // 80: queue.JoinGroupResponse
+	(*ListGroupMembersRequest)(nil),        // 81: queue.ListGroupMembersRequest
+	(*ListGroupMembersResponse)(nil),       // 82: queue.ListGroupMembersResponse
+	(*ReviewGroupMemberRequest)(nil),       // 83: queue.ReviewGroupMemberRequest
+	(*ReviewGroupMemberResponse)(nil),      // 84: queue.ReviewGroupMemberResponse
+	(*RemoveGroupMemberRequest)(nil),       // 85: queue.RemoveGroupMemberRequest
+	(*RemoveGroupMemberResponse)(nil),      // 86: queue.RemoveGroupMemberResponse
+	(*RegenerateInviteCodeRequest)(nil),    // 87: queue.RegenerateInviteCodeRequest
+	(*RegenerateInviteCodeResponse)(nil),   // 88: queue.RegenerateInviteCodeResponse
+	(*SlotBreakDTO)(nil),                   // 89: queue.SlotBreakDTO
+	(*SlotScheduleDTO)(nil),                // 90: queue.SlotScheduleDTO
+	(*SlotDTO)(nil),                        // 91: queue.SlotDTO
+	(*SetSlotScheduleRequest)(nil),         // 92: queue.SetSlotScheduleRequest
+	(*SetSlotScheduleResponse)(nil),        // 93: queue.SetSlotScheduleResponse
+	(*ListFreeSlotsRequest)(nil),           // 94: queue.ListFreeSlotsRequest
+	(*ListFreeSlotsResponse)(nil),          // 95: queue.ListFreeSlotsResponse
+	(*QueueTemplateSpec)(nil),              // 96: queue.QueueTemplateSpec
+	(*QueueTemplateDTO)(nil),               // 97: queue.QueueTemplateDTO
+	(*CreateTemplateRequest)(nil),          // 98: queue.CreateTemplateRequest
+	(*CreateTemplateResponse)(nil),         // 99: queue.CreateTemplateResponse
+	(*GetTemplateRequest)(nil),             // 100: queue.GetTemplateRequest
+	(*GetTemplateResponse)(nil),            // 101: queue.GetTemplateResponse
+	(*ListTemplatesRequest)(nil),           // 102: queue.ListTemplatesRequest
+	(*ListTemplatesResponse)(nil),          // 103: queue.ListTemplatesResponse
+	(*UpdateTemplateRequest)(nil),          // 104: queue.UpdateTemplateRequest
+	(*UpdateTemplateResponse)(nil),         // 105: queue.UpdateTemplateResponse
+	(*DeleteTemplateRequest)(nil),          // 106: queue.DeleteTemplateRequest
+	(*DeleteTemplateResponse)(nil),         // 107: queue.DeleteTemplateResponse
 }
 var file_queue_queue_proto_depIdxs = []int32{
-	0,  // 0: queue.QueueDTO.mode:type_name -> queue.QueueMode
-	1,  // 1: queue.QueueDTO.status:type_name -> queue.QueueStatus
-	2,  // 2: queue.ListQueuesResponse.queues:type_name -> queue.QueueDTO
-	0,  // 3: queue.CreateQueueRequest.mode:type_name -> queue.QueueMode
-	2,  // 4: queue.CreateQueueResponse.queue:type_name -> queue.QueueDTO
-	2,  // 5: queue.GetQueueResponse.queue:type_name -> queue.QueueDTO
-	3,  // 6: queue.GetQueueResponse.participants:type_name -> queue.ParticipantDTO
-	3,  // 7: queue.AdvanceQueueResponse.removed:type_name -> queue.ParticipantDTO
-	2,  // 8: queue.UpdateQueueResponse.queue:type_name -> queue.QueueDTO
-	4,  // 9: queue.Queue.ListQueues:input_type -> queue.ListQueuesRequest
-	6,  // 10: queue.Queue.CreateQueue:input_type -> queue.CreateQueueRequest
-	8,  // 11: queue.Queue.GetQueue:input_type -> queue.GetQueueRequest
-	10, // 12: queue.Queue.JoinQueue:input_type -> queue.JoinQueueRequest
-	12, // 13: queue.Queue.LeaveQueue:input_type -> queue.LeaveQueueRequest
-	14, // 14: queue.Queue.AdvanceQueue:input_type -> queue.AdvanceQueueRequest
-	16, // 15: queue.Queue.RemoveParticipant:input_type -> queue.RemoveParticipantRequest
-	18, // 16: queue.Queue.ArchiveQueue:input_type -> queue.ArchiveQueueRequest
-	20, // 17: queue.Queue.DeleteQueue:input_type -> queue.DeleteQueueRequest
-	22, // 18: queue.Queue.UpdateQueue:input_type -> queue.UpdateQueueRequest
-	24, // 19: queue.Queue.AddParticipant:input_type -> queue.AddParticipantRequest
-	5,  // 20: queue.Queue.ListQueues:output_type -> queue.ListQueuesResponse
-	7,  // 21: queue.Queue.CreateQueue:output_type -> queue.CreateQueueResponse
-	9,  // 22: queue.Queue.GetQueue:output_type -> queue.GetQueueResponse
-	11, // 23: queue.Queue.JoinQueue:output_type -> queue.JoinQueueResponse
-	13, // 24: queue.Queue.LeaveQueue:output_type -> queue.LeaveQueueResponse
-	15, // 25: queue.Queue.AdvanceQueue:output_type -> queue.AdvanceQueueResponse
-	17, // 26: queue.Queue.RemoveParticipant:output_type -> queue.RemoveParticipantResponse
-	19, // 27: queue.Queue.ArchiveQueue:output_type -> queue.ArchiveQueueResponse
-	21, // 28: queue.Queue.DeleteQueue:output_type -> queue.DeleteQueueResponse
-	23, // 29: queue.Queue.UpdateQueue:output_type -> queue.UpdateQueueResponse
-	25, // 30: queue.Queue.AddParticipant:output_type -> queue.AddParticipantResponse
-	20, // [20:31] is the sub-list for method output_type
-	9,  // [9:20] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	0,   // 0: queue.QueueDTO.mode:type_name -> queue.QueueMode
+	1,   // 1: queue.QueueDTO.status:type_name -> queue.QueueStatus
+	3,   // 2: queue.ListQueuesResponse.queues:type_name -> queue.QueueDTO
+	0,   // 3: queue.CreateQueueRequest.mode:type_name -> queue.QueueMode
+	3,   // 4: queue.CreateQueueResponse.queue:type_name -> queue.QueueDTO
+	3,   // 5: queue.GetQueueResponse.queue:type_name -> queue.QueueDTO
+	4,   // 6: queue.GetQueueResponse.participants:type_name -> queue.ParticipantDTO
+	4,   // 7: queue.AdvanceQueueResponse.removed:type_name -> queue.ParticipantDTO
+	4,   // 8: queue.AdvanceQueueResponse.called:type_name -> queue.ParticipantDTO
+	3,   // 9: queue.UpdateQueueResponse.queue:type_name -> queue.QueueDTO
+	3,   // 10: queue.SetCallPolicyResponse.queue:type_name -> queue.QueueDTO
+	4,   // 11: queue.CheckInResponse.participant:type_name -> queue.ParticipantDTO
+	4,   // 12: queue.MoveParticipantResponse.participants:type_name -> queue.ParticipantDTO
+	4,   // 13: queue.SwapParticipantsResponse.participants:type_name -> queue.ParticipantDTO
+	4,   // 14: queue.ReorderQueueResponse.participants:type_name -> queue.ParticipantDTO
+	3,   // 15: queue.SetLotteryResponse.queue:type_name -> queue.QueueDTO
+	3,   // 16: queue.DrawLotteryResponse.queue:type_name -> queue.QueueDTO
+	4,   // 17: queue.DrawLotteryResponse.participants:type_name -> queue.ParticipantDTO
+	4,   // 18: queue.DeferTurnResponse.participant:type_name -> queue.ParticipantDTO
+	4,   // 19: queue.SetParticipantPriorityResponse.participants:type_name -> queue.ParticipantDTO
+	2,   // 20: queue.SwapRequestDTO.status:type_name -> queue.SwapStatus
+	3,   // 21: queue.SetSwapPolicyResponse.queue:type_name -> queue.QueueDTO
+	47,  // 22: queue.RequestSwapResponse.request:type_name -> queue.SwapRequestDTO
+	47,  // 23: queue.AnswerSwapResponse.request:type_name -> queue.SwapRequestDTO
+	4,   // 24: queue.AnswerSwapResponse.participants:type_name -> queue.ParticipantDTO
+	47,  // 25: queue.CancelSwapResponse.request:type_name -> queue.SwapRequestDTO
+	47,  // 26: queue.ListSwapRequestsResponse.requests:type_name -> queue.SwapRequestDTO
+	3,   // 27: queue.QueueUpdate.queue:type_name -> queue.QueueDTO
+	4,   // 28: queue.QueueUpdate.participants:type_name -> queue.ParticipantDTO
+	5,   // 29: queue.ListQueueEventsResponse.events:type_name -> queue.QueueEventDTO
+	6,   // 30: queue.GetQueueHistoryResponse.entries:type_name -> queue.HistoryEntryDTO
+	64,  // 31: queue.ListMembersResponse.members:type_name -> queue.QueueMemberDTO
+	64,  // 32: queue.GrantRoleResponse.member:type_name -> queue.QueueMemberDTO
+	71,  // 33: queue.CreateGroupResponse.group:type_name -> queue.GroupDTO
+	71,  // 34: queue.ListGroupsResponse.groups:type_name -> queue.GroupDTO
+	71,  // 35: queue.GetGroupResponse.group:type_name -> queue.GroupDTO
+	72,  // 36: queue.JoinGroupResponse.member:type_name -> queue.GroupMemberDTO
+	72,  // 37: queue.ListGroupMembersResponse.members:type_name -> queue.GroupMemberDTO
+	89,  // 38: queue.SlotScheduleDTO.breaks:type_name -> queue.SlotBreakDTO
+	89,  // 39: queue.SetSlotScheduleRequest.breaks:type_name -> queue.SlotBreakDTO
+	90,  // 40: queue.SetSlotScheduleResponse.schedule:type_name -> queue.SlotScheduleDTO
+	90,  // 41: queue.ListFreeSlotsResponse.schedule:type_name -> queue.SlotScheduleDTO
+	91,  // 42: queue.ListFreeSlotsResponse.slots:type_name -> queue.SlotDTO
+	0,   // 43: queue.QueueTemplateSpec.mode:type_name -> queue.QueueMode
+	96,  // 44: queue.QueueTemplateDTO.spec:type_name -> queue.QueueTemplateSpec
+	96,  // 45: queue.CreateTemplateRequest.spec:type_name -> queue.QueueTemplateSpec
+	97,  // 46: queue.CreateTemplateResponse.template:type_name -> queue.QueueTemplateDTO
+	97,  // 47: queue.GetTemplateResponse.template:type_name -> queue.QueueTemplateDTO
+	97,  // 48: queue.ListTemplatesResponse.templates:type_name -> queue.QueueTemplateDTO
+	96,  // 49: queue.UpdateTemplateRequest.spec:type_name -> queue.QueueTemplateSpec
+	97,  // 50: queue.UpdateTemplateResponse.template:type_name -> queue.QueueTemplateDTO
+	7,   // 51: queue.Queue.ListQueues:input_type -> queue.ListQueuesRequest
+	9,   // 52: queue.Queue.CreateQueue:input_type -> queue.CreateQueueRequest
+	11,  // 53: queue.Queue.GetQueue:input_type -> queue.GetQueueRequest
+	13,  // 54: queue.Queue.JoinQueue:input_type -> queue.JoinQueueRequest
+	15,  // 55: queue.Queue.LeaveQueue:input_type -> queue.LeaveQueueRequest
+	17,  // 56: queue.Queue.AdvanceQueue:input_type -> queue.AdvanceQueueRequest
+	19,  // 57: queue.Queue.RemoveParticipant:input_type -> queue.RemoveParticipantRequest
+	21,  // 58: queue.Queue.ArchiveQueue:input_type -> queue.ArchiveQueueRequest
+	23,  // 59: queue.Queue.DeleteQueue:input_type -> queue.DeleteQueueRequest
+	25,  // 60: queue.Queue.UpdateQueue:input_type -> queue.UpdateQueueRequest
+	27,  // 61: queue.Queue.AddParticipant:input_type -> queue.AddParticipantRequest
+	29,  // 62: queue.Queue.SetCallPolicy:input_type -> queue.SetCallPolicyRequest
+	31,  // 63: queue.Queue.CheckIn:input_type -> queue.CheckInRequest
+	33,  // 64: queue.Queue.MoveParticipant:input_type -> queue.MoveParticipantRequest
+	35,  // 65: queue.Queue.SwapParticipants:input_type -> queue.SwapParticipantsRequest
+	37,  // 66: queue.Queue.ReorderQueue:input_type -> queue.ReorderQueueRequest
+	39,  // 67: queue.Queue.SetLottery:input_type -> queue.SetLotteryRequest
+	41,  // 68: queue.Queue.DrawLottery:input_type -> queue.DrawLotteryRequest
+	43,  // 69: queue.Queue.DeferTurn:input_type -> queue.DeferTurnRequest
+	45,  // 70: queue.Queue.SetParticipantPriority:input_type -> queue.SetParticipantPriorityRequest
+	48,  // 71: queue.Queue.SetSwapPolicy:input_type -> queue.SetSwapPolicyRequest
+	50,  // 72: queue.Queue.RequestSwap:input_type -> queue.RequestSwapRequest
+	52,  // 73: queue.Queue.AnswerSwap:input_type -> queue.AnswerSwapRequest
+	54,  // 74: queue.Queue.CancelSwap:input_type -> queue.CancelSwapRequest
+	56,  // 75: queue.Queue.ListSwapRequests:input_type -> queue.ListSwapRequestsRequest
+	58,  // 76: queue.Queue.WatchQueue:input_type -> queue.WatchQueueRequest
+	60,  // 77: queue.Queue.ListQueueEvents:input_type -> queue.ListQueueEventsRequest
+	62,  // 78: queue.Queue.GetQueueHistory:input_type -> queue.GetQueueHistoryRequest
+	65,  // 79: queue.Queue.ListMembers:input_type -> queue.ListMembersRequest
+	67,  // 80: queue.Queue.GrantRole:input_type -> queue.GrantRoleRequest
+	69,  // 81: queue.Queue.RevokeRole:input_type -> queue.RevokeRoleRequest
+	92,  // 82: queue.Queue.SetSlotSchedule:input_type -> queue.SetSlotScheduleRequest
+	94,  // 83: queue.Queue.ListFreeSlots:input_type -> queue.ListFreeSlotsRequest
+	73,  // 84: queue.Queue.CreateGroup:input_type -> queue.CreateGroupRequest
+	75,  // 85: queue.Queue.ListGroups:input_type -> queue.ListGroupsRequest
+	77,  // 86: queue.Queue.GetGroup:input_type -> queue.GetGroupRequest
+	79,  // 87: queue.Queue.JoinGroup:input_type -> queue.JoinGroupRequest
+	81,  // 88: queue.Queue.ListGroupMembers:input_type -> queue.ListGroupMembersRequest
+	83,  // 89: queue.Queue.ReviewGroupMember:input_type -> queue.ReviewGroupMemberRequest
+	85,  // 90: queue.Queue.RemoveGroupMember:input_type -> queue.RemoveGroupMemberRequest
+	87,  // 91: queue.Queue.RegenerateInviteCode:input_type -> queue.RegenerateInviteCodeRequest
+	98,  // 92: queue.Queue.CreateTemplate:input_type -> queue.CreateTemplateRequest
+	100, // 93: queue.Queue.GetTemplate:input_type -> queue.GetTemplateRequest
+	102, // 94: queue.Queue.ListTemplates:input_type -> queue.ListTemplatesRequest
+	104, // 95: queue.Queue.UpdateTemplate:input_type -> queue.UpdateTemplateRequest
+	106, // 96: queue.Queue.DeleteTemplate:input_type -> queue.DeleteTemplateRequest
+	8,   // 97: queue.Queue.ListQueues:output_type -> queue.ListQueuesResponse
+	10,  // 98: queue.Queue.CreateQueue:output_type -> queue.CreateQueueResponse
+	12,  // 99: queue.Queue.GetQueue:output_type -> queue.GetQueueResponse
+	14,  // 100: queue.Queue.JoinQueue:output_type -> queue.JoinQueueResponse
+	16,  // 101: queue.Queue.LeaveQueue:output_type -> queue.LeaveQueueResponse
+	18,  // 102: queue.Queue.AdvanceQueue:output_type -> queue.AdvanceQueueResponse
+	20,  // 103: queue.Queue.RemoveParticipant:output_type -> queue.RemoveParticipantResponse
+	22,  // 104: queue.Queue.ArchiveQueue:output_type -> queue.ArchiveQueueResponse
+	24,  // 105: queue.Queue.DeleteQueue:output_type -> queue.DeleteQueueResponse
+	26,  // 106: queue.Queue.UpdateQueue:output_type -> queue.UpdateQueueResponse
+	28,  // 107: queue.Queue.AddParticipant:output_type -> queue.AddParticipantResponse
+	30,  // 108: queue.Queue.SetCallPolicy:output_type -> queue.SetCallPolicyResponse
+	32,  // 109: queue.Queue.CheckIn:output_type -> queue.CheckInResponse
+	34,  // 110: queue.Queue.MoveParticipant:output_type -> queue.MoveParticipantResponse
+	36,  // 111: queue.Queue.SwapParticipants:output_type -> queue.SwapParticipantsResponse
+	38,  // 112: queue.Queue.ReorderQueue:output_type -> queue.ReorderQueueResponse
+	40,  // 113: queue.Queue.SetLottery:output_type -> queue.SetLotteryResponse
+	42,  // 114: queue.Queue.DrawLottery:output_type -> queue.DrawLotteryResponse
+	44,  // 115: queue.Queue.DeferTurn:output_type -> queue.DeferTurnResponse
+	46,  // 116: queue.Queue.SetParticipantPriority:output_type -> queue.SetParticipantPriorityResponse
+	49,  // 117: queue.Queue.SetSwapPolicy:output_type -> queue.SetSwapPolicyResponse
+	51,  // 118: queue.Queue.RequestSwap:output_type -> queue.RequestSwapResponse
+	53,  // 119: queue.Queue.AnswerSwap:output_type -> queue.AnswerSwapResponse
+	55,  // 120: queue.Queue.CancelSwap:output_type -> queue.CancelSwapResponse
+	57,  // 121: queue.Queue.ListSwapRequests:output_type -> queue.ListSwapRequestsResponse
+	59,  // 122: queue.Queue.WatchQueue:output_type -> queue.QueueUpdate
+	61,  // 123: queue.Queue.ListQueueEvents:output_type -> queue.ListQueueEventsResponse
+	63,  // 124: queue.Queue.GetQueueHistory:output_type -> queue.GetQueueHistoryResponse
+	66,  // 125: queue.Queue.ListMembers:output_type -> queue.ListMembersResponse
+	68,  // 126: queue.Queue.GrantRole:output_type -> queue.GrantRoleResponse
+	70,  // 127: queue.Queue.RevokeRole:output_type -> queue.RevokeRoleResponse
+	93,  // 128: queue.Queue.SetSlotSchedule:output_type -> queue.SetSlotScheduleResponse
+	95,  // 129: queue.Queue.ListFreeSlots:output_type -> queue.ListFreeSlotsResponse
+	74,  // 130: queue.Queue.CreateGroup:output_type -> queue.CreateGroupResponse
+	76,  // 131: queue.Queue.ListGroups:output_type -> queue.ListGroupsResponse
+	78,  // 132: queue.Queue.GetGroup:output_type -> queue.GetGroupResponse
+	80,  // 133: queue.Queue.JoinGroup:output_type -> queue.JoinGroupResponse
+	82,  // 134: queue.Queue.ListGroupMembers:output_type -> queue.ListGroupMembersResponse
+	84,  // 135: queue.Queue.ReviewGroupMember:output_type -> queue.ReviewGroupMemberResponse
+	86,  // 136: queue.Queue.RemoveGroupMember:output_type -> queue.RemoveGroupMemberResponse
+	88,  // 137: queue.Queue.RegenerateInviteCode:output_type -> queue.RegenerateInviteCodeResponse
+	99,  // 138: queue.Queue.CreateTemplate:output_type -> queue.CreateTemplateResponse
+	101, // 139: queue.Queue.GetTemplate:output_type -> queue.GetTemplateResponse
+	103, // 140: queue.Queue.ListTemplates:output_type -> queue.ListTemplatesResponse
+	105, // 141: queue.Queue.UpdateTemplate:output_type -> queue.UpdateTemplateResponse
+	107, // 142: queue.Queue.DeleteTemplate:output_type -> queue.DeleteTemplateResponse
+	97,  // [97:143] is the sub-list for method output_type
+	51,  // [51:97] is the sub-list for method input_type
+	51,  // [51:51] is the sub-list for extension type_name
+	51,  // [51:51] is the sub-list for extension extendee
+	0,   // [0:51] is the sub-list for field type_name
 }
 
 func init() { file_queue_queue_proto_init() }
@@ -1725,8 +7542,8 @@ func file_queue_queue_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_queue_queue_proto_rawDesc), len(file_queue_queue_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   24,
+			NumEnums:      3,
+			NumMessages:   105,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
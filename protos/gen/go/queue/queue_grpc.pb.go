@@ -19,17 +19,52 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Queue_ListQueues_FullMethodName        = "/queue.Queue/ListQueues"
-	Queue_CreateQueue_FullMethodName       = "/queue.Queue/CreateQueue"
-	Queue_GetQueue_FullMethodName          = "/queue.Queue/GetQueue"
-	Queue_JoinQueue_FullMethodName         = "/queue.Queue/JoinQueue"
-	Queue_LeaveQueue_FullMethodName        = "/queue.Queue/LeaveQueue"
-	Queue_AdvanceQueue_FullMethodName      = "/queue.Queue/AdvanceQueue"
-	Queue_RemoveParticipant_FullMethodName = "/queue.Queue/RemoveParticipant"
-	Queue_ArchiveQueue_FullMethodName      = "/queue.Queue/ArchiveQueue"
-	Queue_DeleteQueue_FullMethodName       = "/queue.Queue/DeleteQueue"
-	Queue_UpdateQueue_FullMethodName       = "/queue.Queue/UpdateQueue"
-	Queue_AddParticipant_FullMethodName    = "/queue.Queue/AddParticipant"
+	Queue_ListQueues_FullMethodName             = "/queue.Queue/ListQueues"
+	Queue_CreateQueue_FullMethodName            = "/queue.Queue/CreateQueue"
+	Queue_GetQueue_FullMethodName               = "/queue.Queue/GetQueue"
+	Queue_JoinQueue_FullMethodName              = "/queue.Queue/JoinQueue"
+	Queue_LeaveQueue_FullMethodName             = "/queue.Queue/LeaveQueue"
+	Queue_AdvanceQueue_FullMethodName           = "/queue.Queue/AdvanceQueue"
+	Queue_RemoveParticipant_FullMethodName      = "/queue.Queue/RemoveParticipant"
+	Queue_ArchiveQueue_FullMethodName           = "/queue.Queue/ArchiveQueue"
+	Queue_DeleteQueue_FullMethodName            = "/queue.Queue/DeleteQueue"
+	Queue_UpdateQueue_FullMethodName            = "/queue.Queue/UpdateQueue"
+	Queue_AddParticipant_FullMethodName         = "/queue.Queue/AddParticipant"
+	Queue_SetCallPolicy_FullMethodName          = "/queue.Queue/SetCallPolicy"
+	Queue_CheckIn_FullMethodName                = "/queue.Queue/CheckIn"
+	Queue_MoveParticipant_FullMethodName        = "/queue.Queue/MoveParticipant"
+	Queue_SwapParticipants_FullMethodName       = "/queue.Queue/SwapParticipants"
+	Queue_ReorderQueue_FullMethodName           = "/queue.Queue/ReorderQueue"
+	Queue_SetLottery_FullMethodName             = "/queue.Queue/SetLottery"
+	Queue_DrawLottery_FullMethodName            = "/queue.Queue/DrawLottery"
+	Queue_DeferTurn_FullMethodName              = "/queue.Queue/DeferTurn"
+	Queue_SetParticipantPriority_FullMethodName = "/queue.Queue/SetParticipantPriority"
+	Queue_SetSwapPolicy_FullMethodName          = "/queue.Queue/SetSwapPolicy"
+	Queue_RequestSwap_FullMethodName            = "/queue.Queue/RequestSwap"
+	Queue_AnswerSwap_FullMethodName             = "/queue.Queue/AnswerSwap"
+	Queue_CancelSwap_FullMethodName             = "/queue.Queue/CancelSwap"
+	Queue_ListSwapRequests_FullMethodName       = "/queue.Queue/ListSwapRequests"
+	Queue_WatchQueue_FullMethodName             = "/queue.Queue/WatchQueue"
+	Queue_ListQueueEvents_FullMethodName        = "/queue.Queue/ListQueueEvents"
+	Queue_GetQueueHistory_FullMethodName        = "/queue.Queue/GetQueueHistory"
+	Queue_ListMembers_FullMethodName            = "/queue.Queue/ListMembers"
+	Queue_GrantRole_FullMethodName              = "/queue.Queue/GrantRole"
+	Queue_RevokeRole_FullMethodName             = "/queue.Queue/RevokeRole"
+	Queue_SetSlotSchedule_FullMethodName        = "/queue.Queue/SetSlotSchedule"
+	Queue_ListFreeSlots_FullMethodName          = "/queue.Queue/ListFreeSlots"
+	Queue_CreateGroup_FullMethodName            = "/queue.Queue/CreateGroup"
+	Queue_ListGroups_FullMethodName             = "/queue.Queue/ListGroups"
+	Queue_GetGroup_FullMethodName               = "/queue.Queue/GetGroup"
+	Queue_JoinGroup_FullMethodName              = "/queue.Queue/JoinGroup"
+	Queue_ListGroupMembers_FullMethodName       = "/queue.Queue/ListGroupMembers"
+	Queue_ReviewGroupMember_FullMethodName      = "/queue.Queue/ReviewGroupMember"
+	Queue_RemoveGroupMember_FullMethodName      = "/queue.Queue/RemoveGroupMember"
+	Queue_RegenerateInviteCode_FullMethodName   = "/queue.Queue/RegenerateInviteCode"
+	Queue_CreateTemplate_FullMethodName         = "/queue.Queue/CreateTemplate"
+	Queue_GetTemplate_FullMethodName            = "/queue.Queue/GetTemplate"
+	Queue_ListTemplates_FullMethodName          = "/queue.Queue/ListTemplates"
+	Queue_UpdateTemplate_FullMethodName         = "/queue.Queue/UpdateTemplate"
+	Queue_DeleteTemplate_FullMethodName         = "/queue.Queue/DeleteTemplate"
 )
 
 // QueueClient is the client API for Queue service.
@@ -47,6 +82,61 @@ type QueueClient interface {
 	DeleteQueue(ctx context.Context, in *DeleteQueueRequest, opts ...grpc.CallOption) (*DeleteQueueResponse, error)
 	UpdateQueue(ctx context.Context, in *UpdateQueueRequest, opts ...grpc.CallOption) (*UpdateQueueResponse, error)
 	AddParticipant(ctx context.Context, in *AddParticipantRequest, opts ...grpc.CallOption) (*AddParticipantResponse, error)
+	// Calls: with a call timeout set, advancing calls the head and waits for them to check in.
+	SetCallPolicy(ctx context.Context, in *SetCallPolicyRequest, opts ...grpc.CallOption) (*SetCallPolicyResponse, error)
+	CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResponse, error)
+	// Manual order for moderators; positions are renumbered 1..n, not available in slots, random and priority modes.
+	MoveParticipant(ctx context.Context, in *MoveParticipantRequest, opts ...grpc.CallOption) (*MoveParticipantResponse, error)
+	SwapParticipants(ctx context.Context, in *SwapParticipantsRequest, opts ...grpc.CallOption) (*SwapParticipantsResponse, error)
+	ReorderQueue(ctx context.Context, in *ReorderQueueRequest, opts ...grpc.CallOption) (*ReorderQueueResponse, error)
+	// Random mode: registrations keep their order until the draw, which ranks participants by
+	// SHA-256("<lottery_seed>:<user_id>") once and freezes the order. Advancing waits for the draw.
+	SetLottery(ctx context.Context, in *SetLotteryRequest, opts ...grpc.CallOption) (*SetLotteryResponse, error)
+	DrawLottery(ctx context.Context, in *DrawLotteryRequest, opts ...grpc.CallOption) (*DrawLotteryResponse, error)
+	// Lets the participants behind go first: the caller moves down 1..10 places, at most twice per queue entry.
+	DeferTurn(ctx context.Context, in *DeferTurnRequest, opts ...grpc.CallOption) (*DeferTurnResponse, error)
+	// Priority mode: higher priorities go first, the order of joining decides within a level.
+	// Newcomers get priority 0; the owner sets -10..10 and the participant is moved accordingly.
+	SetParticipantPriority(ctx context.Context, in *SetParticipantPriorityRequest, opts ...grpc.CallOption) (*SetParticipantPriorityResponse, error)
+	// Swaps by agreement: a participant asks another one, who accepts or declines before the request expires.
+	// The owner may forbid swaps per queue; not available in slots, random and priority modes.
+	SetSwapPolicy(ctx context.Context, in *SetSwapPolicyRequest, opts ...grpc.CallOption) (*SetSwapPolicyResponse, error)
+	RequestSwap(ctx context.Context, in *RequestSwapRequest, opts ...grpc.CallOption) (*RequestSwapResponse, error)
+	AnswerSwap(ctx context.Context, in *AnswerSwapRequest, opts ...grpc.CallOption) (*AnswerSwapResponse, error)
+	CancelSwap(ctx context.Context, in *CancelSwapRequest, opts ...grpc.CallOption) (*CancelSwapResponse, error)
+	// Pending requests the user sent or received in the queue.
+	ListSwapRequests(ctx context.Context, in *ListSwapRequestsRequest, opts ...grpc.CallOption) (*ListSwapRequestsResponse, error)
+	// Streams queue snapshots: the current state first, then one after every change.
+	WatchQueue(ctx context.Context, in *WatchQueueRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QueueUpdate], error)
+	// Audit log of the queue, newest first.
+	ListQueueEvents(ctx context.Context, in *ListQueueEventsRequest, opts ...grpc.CallOption) (*ListQueueEventsResponse, error)
+	// Participants who left the queue (served, skipped, left, removed), newest first.
+	GetQueueHistory(ctx context.Context, in *GetQueueHistoryRequest, opts ...grpc.CallOption) (*GetQueueHistoryResponse, error)
+	// Queue roles: owners manage members, moderators run the queue, viewers only watch.
+	ListMembers(ctx context.Context, in *ListMembersRequest, opts ...grpc.CallOption) (*ListMembersResponse, error)
+	GrantRole(ctx context.Context, in *GrantRoleRequest, opts ...grpc.CallOption) (*GrantRoleResponse, error)
+	RevokeRole(ctx context.Context, in *RevokeRoleRequest, opts ...grpc.CallOption) (*RevokeRoleResponse, error)
+	// Slot schedule of a queue in slots mode; participants may only book its slots.
+	SetSlotSchedule(ctx context.Context, in *SetSlotScheduleRequest, opts ...grpc.CallOption) (*SetSlotScheduleResponse, error)
+	// Upcoming slots that still have room, with the schedule they come from.
+	ListFreeSlots(ctx context.Context, in *ListFreeSlotsRequest, opts ...grpc.CallOption) (*ListFreeSlotsResponse, error)
+	// Groups own queues (by code). Queues are visible to active group members only.
+	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error)
+	ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error)
+	GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*GetGroupResponse, error)
+	JoinGroup(ctx context.Context, in *JoinGroupRequest, opts ...grpc.CallOption) (*JoinGroupResponse, error)
+	ListGroupMembers(ctx context.Context, in *ListGroupMembersRequest, opts ...grpc.CallOption) (*ListGroupMembersResponse, error)
+	// Approves or rejects a pending join request (group admins only).
+	ReviewGroupMember(ctx context.Context, in *ReviewGroupMemberRequest, opts ...grpc.CallOption) (*ReviewGroupMemberResponse, error)
+	// Leave the group (user_id == actor_id) or remove a member (group admins only).
+	RemoveGroupMember(ctx context.Context, in *RemoveGroupMemberRequest, opts ...grpc.CallOption) (*RemoveGroupMemberResponse, error)
+	RegenerateInviteCode(ctx context.Context, in *RegenerateInviteCodeRequest, opts ...grpc.CallOption) (*RegenerateInviteCodeResponse, error)
+	// Templates recreate a queue on a weekly rule; the scheduler publishes each instance.
+	CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*CreateTemplateResponse, error)
+	GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*GetTemplateResponse, error)
+	ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error)
+	UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...grpc.CallOption) (*UpdateTemplateResponse, error)
+	DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*DeleteTemplateResponse, error)
 }
 
 type queueClient struct {
@@ -167,6 +257,365 @@ func (c *queueClient) AddParticipant(ctx context.Context, in *AddParticipantRequ
 	return out, nil
 }
 
+func (c *queueClient) SetCallPolicy(ctx context.Context, in *SetCallPolicyRequest, opts ...grpc.CallOption) (*SetCallPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCallPolicyResponse)
+	err := c.cc.Invoke(ctx, Queue_SetCallPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckInResponse)
+	err := c.cc.Invoke(ctx, Queue_CheckIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) MoveParticipant(ctx context.Context, in *MoveParticipantRequest, opts ...grpc.CallOption) (*MoveParticipantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveParticipantResponse)
+	err := c.cc.Invoke(ctx, Queue_MoveParticipant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) SwapParticipants(ctx context.Context, in *SwapParticipantsRequest, opts ...grpc.CallOption) (*SwapParticipantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SwapParticipantsResponse)
+	err := c.cc.Invoke(ctx, Queue_SwapParticipants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) ReorderQueue(ctx context.Context, in *ReorderQueueRequest, opts ...grpc.CallOption) (*ReorderQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderQueueResponse)
+	err := c.cc.Invoke(ctx, Queue_ReorderQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) SetLottery(ctx context.Context, in *SetLotteryRequest, opts ...grpc.CallOption) (*SetLotteryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetLotteryResponse)
+	err := c.cc.Invoke(ctx, Queue_SetLottery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) DrawLottery(ctx context.Context, in *DrawLotteryRequest, opts ...grpc.CallOption) (*DrawLotteryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DrawLotteryResponse)
+	err := c.cc.Invoke(ctx, Queue_DrawLottery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) DeferTurn(ctx context.Context, in *DeferTurnRequest, opts ...grpc.CallOption) (*DeferTurnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeferTurnResponse)
+	err := c.cc.Invoke(ctx, Queue_DeferTurn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) SetParticipantPriority(ctx context.Context, in *SetParticipantPriorityRequest, opts ...grpc.CallOption) (*SetParticipantPriorityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetParticipantPriorityResponse)
+	err := c.cc.Invoke(ctx, Queue_SetParticipantPriority_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) SetSwapPolicy(ctx context.Context, in *SetSwapPolicyRequest, opts ...grpc.CallOption) (*SetSwapPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSwapPolicyResponse)
+	err := c.cc.Invoke(ctx, Queue_SetSwapPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) RequestSwap(ctx context.Context, in *RequestSwapRequest, opts ...grpc.CallOption) (*RequestSwapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestSwapResponse)
+	err := c.cc.Invoke(ctx, Queue_RequestSwap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) AnswerSwap(ctx context.Context, in *AnswerSwapRequest, opts ...grpc.CallOption) (*AnswerSwapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnswerSwapResponse)
+	err := c.cc.Invoke(ctx, Queue_AnswerSwap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) CancelSwap(ctx context.Context, in *CancelSwapRequest, opts ...grpc.CallOption) (*CancelSwapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelSwapResponse)
+	err := c.cc.Invoke(ctx, Queue_CancelSwap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) ListSwapRequests(ctx context.Context, in *ListSwapRequestsRequest, opts ...grpc.CallOption) (*ListSwapRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSwapRequestsResponse)
+	err := c.cc.Invoke(ctx, Queue_ListSwapRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) WatchQueue(ctx context.Context, in *WatchQueueRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QueueUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Queue_ServiceDesc.Streams[0], Queue_WatchQueue_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchQueueRequest, QueueUpdate]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Queue_WatchQueueClient = grpc.ServerStreamingClient[QueueUpdate]
+
+func (c *queueClient) ListQueueEvents(ctx context.Context, in *ListQueueEventsRequest, opts ...grpc.CallOption) (*ListQueueEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQueueEventsResponse)
+	err := c.cc.Invoke(ctx, Queue_ListQueueEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) GetQueueHistory(ctx context.Context, in *GetQueueHistoryRequest, opts ...grpc.CallOption) (*GetQueueHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQueueHistoryResponse)
+	err := c.cc.Invoke(ctx, Queue_GetQueueHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) ListMembers(ctx context.Context, in *ListMembersRequest, opts ...grpc.CallOption) (*ListMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMembersResponse)
+	err := c.cc.Invoke(ctx, Queue_ListMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) GrantRole(ctx context.Context, in *GrantRoleRequest, opts ...grpc.CallOption) (*GrantRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GrantRoleResponse)
+	err := c.cc.Invoke(ctx, Queue_GrantRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) RevokeRole(ctx context.Context, in *RevokeRoleRequest, opts ...grpc.CallOption) (*RevokeRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeRoleResponse)
+	err := c.cc.Invoke(ctx, Queue_RevokeRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) SetSlotSchedule(ctx context.Context, in *SetSlotScheduleRequest, opts ...grpc.CallOption) (*SetSlotScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetSlotScheduleResponse)
+	err := c.cc.Invoke(ctx, Queue_SetSlotSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) ListFreeSlots(ctx context.Context, in *ListFreeSlotsRequest, opts ...grpc.CallOption) (*ListFreeSlotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFreeSlotsResponse)
+	err := c.cc.Invoke(ctx, Queue_ListFreeSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGroupResponse)
+	err := c.cc.Invoke(ctx, Queue_CreateGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGroupsResponse)
+	err := c.cc.Invoke(ctx, Queue_ListGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*GetGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupResponse)
+	err := c.cc.Invoke(ctx, Queue_GetGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) JoinGroup(ctx context.Context, in *JoinGroupRequest, opts ...grpc.CallOption) (*JoinGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinGroupResponse)
+	err := c.cc.Invoke(ctx, Queue_JoinGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) ListGroupMembers(ctx context.Context, in *ListGroupMembersRequest, opts ...grpc.CallOption) (*ListGroupMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGroupMembersResponse)
+	err := c.cc.Invoke(ctx, Queue_ListGroupMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) ReviewGroupMember(ctx context.Context, in *ReviewGroupMemberRequest, opts ...grpc.CallOption) (*ReviewGroupMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewGroupMemberResponse)
+	err := c.cc.Invoke(ctx, Queue_ReviewGroupMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) RemoveGroupMember(ctx context.Context, in *RemoveGroupMemberRequest, opts ...grpc.CallOption) (*RemoveGroupMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveGroupMemberResponse)
+	err := c.cc.Invoke(ctx, Queue_RemoveGroupMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) RegenerateInviteCode(ctx context.Context, in *RegenerateInviteCodeRequest, opts ...grpc.CallOption) (*RegenerateInviteCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegenerateInviteCodeResponse)
+	err := c.cc.Invoke(ctx, Queue_RegenerateInviteCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*CreateTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTemplateResponse)
+	err := c.cc.Invoke(ctx, Queue_CreateTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*GetTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTemplateResponse)
+	err := c.cc.Invoke(ctx, Queue_GetTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTemplatesResponse)
+	err := c.cc.Invoke(ctx, Queue_ListTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...grpc.CallOption) (*UpdateTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTemplateResponse)
+	err := c.cc.Invoke(ctx, Queue_UpdateTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*DeleteTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTemplateResponse)
+	err := c.cc.Invoke(ctx, Queue_DeleteTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueueServer is the server API for Queue service.
 // All implementations must embed UnimplementedQueueServer
 // for forward compatibility.
@@ -182,6 +631,61 @@ type QueueServer interface {
 	DeleteQueue(context.Context, *DeleteQueueRequest) (*DeleteQueueResponse, error)
 	UpdateQueue(context.Context, *UpdateQueueRequest) (*UpdateQueueResponse, error)
 	AddParticipant(context.Context, *AddParticipantRequest) (*AddParticipantResponse, error)
+	// Calls: with a call timeout set, advancing calls the head and waits for them to check in.
+	SetCallPolicy(context.Context, *SetCallPolicyRequest) (*SetCallPolicyResponse, error)
+	CheckIn(context.Context, *CheckInRequest) (*CheckInResponse, error)
+	// Manual order for moderators; positions are renumbered 1..n, not available in slots, random and priority modes.
+	MoveParticipant(context.Context, *MoveParticipantRequest) (*MoveParticipantResponse, error)
+	SwapParticipants(context.Context, *SwapParticipantsRequest) (*SwapParticipantsResponse, error)
+	ReorderQueue(context.Context, *ReorderQueueRequest) (*ReorderQueueResponse, error)
+	// Random mode: registrations keep their order until the draw, which ranks participants by
+	// SHA-256("<lottery_seed>:<user_id>") once and freezes the order. Advancing waits for the draw.
+	SetLottery(context.Context, *SetLotteryRequest) (*SetLotteryResponse, error)
+	DrawLottery(context.Context, *DrawLotteryRequest) (*DrawLotteryResponse, error)
+	// Lets the participants behind go first: the caller moves down 1..10 places, at most twice per queue entry.
+	DeferTurn(context.Context, *DeferTurnRequest) (*DeferTurnResponse, error)
+	// Priority mode: higher priorities go first, the order of joining decides within a level.
+	// Newcomers get priority 0; the owner sets -10..10 and the participant is moved accordingly.
+	SetParticipantPriority(context.Context, *SetParticipantPriorityRequest) (*SetParticipantPriorityResponse, error)
+	// Swaps by agreement: a participant asks another one, who accepts or declines before the request expires.
+	// The owner may forbid swaps per queue; not available in slots, random and priority modes.
+	SetSwapPolicy(context.Context, *SetSwapPolicyRequest) (*SetSwapPolicyResponse, error)
+	RequestSwap(context.Context, *RequestSwapRequest) (*RequestSwapResponse, error)
+	AnswerSwap(context.Context, *AnswerSwapRequest) (*AnswerSwapResponse, error)
+	CancelSwap(context.Context, *CancelSwapRequest) (*CancelSwapResponse, error)
+	// Pending requests the user sent or received in the queue.
+	ListSwapRequests(context.Context, *ListSwapRequestsRequest) (*ListSwapRequestsResponse, error)
+	// Streams queue snapshots: the current state first, then one after every change.
+	WatchQueue(*WatchQueueRequest, grpc.ServerStreamingServer[QueueUpdate]) error
+	// Audit log of the queue, newest first.
+	ListQueueEvents(context.Context, *ListQueueEventsRequest) (*ListQueueEventsResponse, error)
+	// Participants who left the queue (served, skipped, left, removed), newest first.
+	GetQueueHistory(context.Context, *GetQueueHistoryRequest) (*GetQueueHistoryResponse, error)
+	// Queue roles: owners manage members, moderators run the queue, viewers only watch.
+	ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error)
+	GrantRole(context.Context, *GrantRoleRequest) (*GrantRoleResponse, error)
+	RevokeRole(context.Context, *RevokeRoleRequest) (*RevokeRoleResponse, error)
+	// Slot schedule of a queue in slots mode; participants may only book its slots.
+	SetSlotSchedule(context.Context, *SetSlotScheduleRequest) (*SetSlotScheduleResponse, error)
+	// Upcoming slots that still have room, with the schedule they come from.
+	ListFreeSlots(context.Context, *ListFreeSlotsRequest) (*ListFreeSlotsResponse, error)
+	// Groups own queues (by code). Queues are visible to active group members only.
+	CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error)
+	ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error)
+	GetGroup(context.Context, *GetGroupRequest) (*GetGroupResponse, error)
+	JoinGroup(context.Context, *JoinGroupRequest) (*JoinGroupResponse, error)
+	ListGroupMembers(context.Context, *ListGroupMembersRequest) (*ListGroupMembersResponse, error)
+	// Approves or rejects a pending join request (group admins only).
+	ReviewGroupMember(context.Context, *ReviewGroupMemberRequest) (*ReviewGroupMemberResponse, error)
+	// Leave the group (user_id == actor_id) or remove a member (group admins only).
+	RemoveGroupMember(context.Context, *RemoveGroupMemberRequest) (*RemoveGroupMemberResponse, error)
+	RegenerateInviteCode(context.Context, *RegenerateInviteCodeRequest) (*RegenerateInviteCodeResponse, error)
+	// Templates recreate a queue on a weekly rule; the scheduler publishes each instance.
+	CreateTemplate(context.Context, *CreateTemplateRequest) (*CreateTemplateResponse, error)
+	GetTemplate(context.Context, *GetTemplateRequest) (*GetTemplateResponse, error)
+	ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error)
+	UpdateTemplate(context.Context, *UpdateTemplateRequest) (*UpdateTemplateResponse, error)
+	DeleteTemplate(context.Context, *DeleteTemplateRequest) (*DeleteTemplateResponse, error)
 	mustEmbedUnimplementedQueueServer()
 }
 
@@ -207,239 +711,967 @@ func (UnimplementedQueueServer) JoinQueue(context.Context, *JoinQueueRequest) (*
 func (UnimplementedQueueServer) LeaveQueue(context.Context, *LeaveQueueRequest) (*LeaveQueueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveQueue not implemented")
 }
-func (UnimplementedQueueServer) AdvanceQueue(context.Context, *AdvanceQueueRequest) (*AdvanceQueueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AdvanceQueue not implemented")
+func (UnimplementedQueueServer) AdvanceQueue(context.Context, *AdvanceQueueRequest) (*AdvanceQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdvanceQueue not implemented")
+}
+func (UnimplementedQueueServer) RemoveParticipant(context.Context, *RemoveParticipantRequest) (*RemoveParticipantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveParticipant not implemented")
+}
+func (UnimplementedQueueServer) ArchiveQueue(context.Context, *ArchiveQueueRequest) (*ArchiveQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveQueue not implemented")
+}
+func (UnimplementedQueueServer) DeleteQueue(context.Context, *DeleteQueueRequest) (*DeleteQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQueue not implemented")
+}
+func (UnimplementedQueueServer) UpdateQueue(context.Context, *UpdateQueueRequest) (*UpdateQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQueue not implemented")
+}
+func (UnimplementedQueueServer) AddParticipant(context.Context, *AddParticipantRequest) (*AddParticipantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddParticipant not implemented")
+}
+func (UnimplementedQueueServer) SetCallPolicy(context.Context, *SetCallPolicyRequest) (*SetCallPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCallPolicy not implemented")
+}
+func (UnimplementedQueueServer) CheckIn(context.Context, *CheckInRequest) (*CheckInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIn not implemented")
+}
+func (UnimplementedQueueServer) MoveParticipant(context.Context, *MoveParticipantRequest) (*MoveParticipantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveParticipant not implemented")
+}
+func (UnimplementedQueueServer) SwapParticipants(context.Context, *SwapParticipantsRequest) (*SwapParticipantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapParticipants not implemented")
+}
+func (UnimplementedQueueServer) ReorderQueue(context.Context, *ReorderQueueRequest) (*ReorderQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderQueue not implemented")
+}
+func (UnimplementedQueueServer) SetLottery(context.Context, *SetLotteryRequest) (*SetLotteryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLottery not implemented")
+}
+func (UnimplementedQueueServer) DrawLottery(context.Context, *DrawLotteryRequest) (*DrawLotteryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrawLottery not implemented")
+}
+func (UnimplementedQueueServer) DeferTurn(context.Context, *DeferTurnRequest) (*DeferTurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeferTurn not implemented")
+}
+func (UnimplementedQueueServer) SetParticipantPriority(context.Context, *SetParticipantPriorityRequest) (*SetParticipantPriorityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetParticipantPriority not implemented")
+}
+func (UnimplementedQueueServer) SetSwapPolicy(context.Context, *SetSwapPolicyRequest) (*SetSwapPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSwapPolicy not implemented")
+}
+func (UnimplementedQueueServer) RequestSwap(context.Context, *RequestSwapRequest) (*RequestSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestSwap not implemented")
+}
+func (UnimplementedQueueServer) AnswerSwap(context.Context, *AnswerSwapRequest) (*AnswerSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnswerSwap not implemented")
+}
+func (UnimplementedQueueServer) CancelSwap(context.Context, *CancelSwapRequest) (*CancelSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSwap not implemented")
+}
+func (UnimplementedQueueServer) ListSwapRequests(context.Context, *ListSwapRequestsRequest) (*ListSwapRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSwapRequests not implemented")
+}
+func (UnimplementedQueueServer) WatchQueue(*WatchQueueRequest, grpc.ServerStreamingServer[QueueUpdate]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchQueue not implemented")
+}
+func (UnimplementedQueueServer) ListQueueEvents(context.Context, *ListQueueEventsRequest) (*ListQueueEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQueueEvents not implemented")
+}
+func (UnimplementedQueueServer) GetQueueHistory(context.Context, *GetQueueHistoryRequest) (*GetQueueHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueueHistory not implemented")
+}
+func (UnimplementedQueueServer) ListMembers(context.Context, *ListMembersRequest) (*ListMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMembers not implemented")
+}
+func (UnimplementedQueueServer) GrantRole(context.Context, *GrantRoleRequest) (*GrantRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantRole not implemented")
+}
+func (UnimplementedQueueServer) RevokeRole(context.Context, *RevokeRoleRequest) (*RevokeRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRole not implemented")
+}
+func (UnimplementedQueueServer) SetSlotSchedule(context.Context, *SetSlotScheduleRequest) (*SetSlotScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSlotSchedule not implemented")
+}
+func (UnimplementedQueueServer) ListFreeSlots(context.Context, *ListFreeSlotsRequest) (*ListFreeSlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFreeSlots not implemented")
+}
+func (UnimplementedQueueServer) CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (UnimplementedQueueServer) ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroups not implemented")
+}
+func (UnimplementedQueueServer) GetGroup(context.Context, *GetGroupRequest) (*GetGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroup not implemented")
+}
+func (UnimplementedQueueServer) JoinGroup(context.Context, *JoinGroupRequest) (*JoinGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinGroup not implemented")
+}
+func (UnimplementedQueueServer) ListGroupMembers(context.Context, *ListGroupMembersRequest) (*ListGroupMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupMembers not implemented")
+}
+func (UnimplementedQueueServer) ReviewGroupMember(context.Context, *ReviewGroupMemberRequest) (*ReviewGroupMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewGroupMember not implemented")
+}
+func (UnimplementedQueueServer) RemoveGroupMember(context.Context, *RemoveGroupMemberRequest) (*RemoveGroupMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGroupMember not implemented")
+}
+func (UnimplementedQueueServer) RegenerateInviteCode(context.Context, *RegenerateInviteCodeRequest) (*RegenerateInviteCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateInviteCode not implemented")
+}
+func (UnimplementedQueueServer) CreateTemplate(context.Context, *CreateTemplateRequest) (*CreateTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplate not implemented")
+}
+func (UnimplementedQueueServer) GetTemplate(context.Context, *GetTemplateRequest) (*GetTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplate not implemented")
+}
+func (UnimplementedQueueServer) ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
+}
+func (UnimplementedQueueServer) UpdateTemplate(context.Context, *UpdateTemplateRequest) (*UpdateTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplate not implemented")
+}
+func (UnimplementedQueueServer) DeleteTemplate(context.Context, *DeleteTemplateRequest) (*DeleteTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplate not implemented")
+}
+func (UnimplementedQueueServer) mustEmbedUnimplementedQueueServer() {}
+func (UnimplementedQueueServer) testEmbeddedByValue()               {}
+
+// UnsafeQueueServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueueServer will
+// result in compilation errors.
+type UnsafeQueueServer interface {
+	mustEmbedUnimplementedQueueServer()
+}
+
+func RegisterQueueServer(s grpc.ServiceRegistrar, srv QueueServer) {
+	// If the following call pancis, it indicates UnimplementedQueueServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Queue_ServiceDesc, srv)
+}
+
+func _Queue_ListQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).ListQueues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_ListQueues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).ListQueues(ctx, req.(*ListQueuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_CreateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).CreateQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_CreateQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).CreateQueue(ctx, req.(*CreateQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_GetQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).GetQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_GetQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).GetQueue(ctx, req.(*GetQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_JoinQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).JoinQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_JoinQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).JoinQueue(ctx, req.(*JoinQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_LeaveQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).LeaveQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_LeaveQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).LeaveQueue(ctx, req.(*LeaveQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_AdvanceQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdvanceQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).AdvanceQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_AdvanceQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).AdvanceQueue(ctx, req.(*AdvanceQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_RemoveParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveParticipantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).RemoveParticipant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_RemoveParticipant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).RemoveParticipant(ctx, req.(*RemoveParticipantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_ArchiveQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).ArchiveQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_ArchiveQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).ArchiveQueue(ctx, req.(*ArchiveQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_DeleteQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).DeleteQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_DeleteQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).DeleteQueue(ctx, req.(*DeleteQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_UpdateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).UpdateQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_UpdateQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).UpdateQueue(ctx, req.(*UpdateQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_AddParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddParticipantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).AddParticipant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_AddParticipant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).AddParticipant(ctx, req.(*AddParticipantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_SetCallPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCallPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).SetCallPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_SetCallPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).SetCallPolicy(ctx, req.(*SetCallPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_CheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).CheckIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_CheckIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).CheckIn(ctx, req.(*CheckInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_MoveParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveParticipantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).MoveParticipant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_MoveParticipant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).MoveParticipant(ctx, req.(*MoveParticipantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_SwapParticipants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapParticipantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).SwapParticipants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_SwapParticipants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).SwapParticipants(ctx, req.(*SwapParticipantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_ReorderQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).ReorderQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_ReorderQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).ReorderQueue(ctx, req.(*ReorderQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_SetLottery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLotteryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).SetLottery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_SetLottery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).SetLottery(ctx, req.(*SetLotteryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_DrawLottery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawLotteryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).DrawLottery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_DrawLottery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).DrawLottery(ctx, req.(*DrawLotteryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_DeferTurn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeferTurnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).DeferTurn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_DeferTurn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).DeferTurn(ctx, req.(*DeferTurnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_SetParticipantPriority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetParticipantPriorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).SetParticipantPriority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_SetParticipantPriority_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).SetParticipantPriority(ctx, req.(*SetParticipantPriorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_SetSwapPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSwapPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).SetSwapPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_SetSwapPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).SetSwapPolicy(ctx, req.(*SetSwapPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_RequestSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).RequestSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_RequestSwap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).RequestSwap(ctx, req.(*RequestSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_AnswerSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnswerSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).AnswerSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_AnswerSwap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).AnswerSwap(ctx, req.(*AnswerSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_CancelSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).CancelSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_CancelSwap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).CancelSwap(ctx, req.(*CancelSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_ListSwapRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSwapRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).ListSwapRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_ListSwapRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).ListSwapRequests(ctx, req.(*ListSwapRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_WatchQueue_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchQueueRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueueServer).WatchQueue(m, &grpc.GenericServerStream[WatchQueueRequest, QueueUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Queue_WatchQueueServer = grpc.ServerStreamingServer[QueueUpdate]
+
+func _Queue_ListQueueEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueueEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).ListQueueEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_ListQueueEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).ListQueueEvents(ctx, req.(*ListQueueEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_GetQueueHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueueHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).GetQueueHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_GetQueueHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).GetQueueHistory(ctx, req.(*GetQueueHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedQueueServer) RemoveParticipant(context.Context, *RemoveParticipantRequest) (*RemoveParticipantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveParticipant not implemented")
+
+func _Queue_ListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).ListMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_ListMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).ListMembers(ctx, req.(*ListMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedQueueServer) ArchiveQueue(context.Context, *ArchiveQueueRequest) (*ArchiveQueueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ArchiveQueue not implemented")
+
+func _Queue_GrantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).GrantRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_GrantRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).GrantRole(ctx, req.(*GrantRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedQueueServer) DeleteQueue(context.Context, *DeleteQueueRequest) (*DeleteQueueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteQueue not implemented")
+
+func _Queue_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).RevokeRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_RevokeRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).RevokeRole(ctx, req.(*RevokeRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedQueueServer) UpdateQueue(context.Context, *UpdateQueueRequest) (*UpdateQueueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateQueue not implemented")
+
+func _Queue_SetSlotSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSlotScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).SetSlotSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_SetSlotSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).SetSlotSchedule(ctx, req.(*SetSlotScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedQueueServer) AddParticipant(context.Context, *AddParticipantRequest) (*AddParticipantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddParticipant not implemented")
+
+func _Queue_ListFreeSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFreeSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).ListFreeSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_ListFreeSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).ListFreeSlots(ctx, req.(*ListFreeSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedQueueServer) mustEmbedUnimplementedQueueServer() {}
-func (UnimplementedQueueServer) testEmbeddedByValue()               {}
 
-// UnsafeQueueServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to QueueServer will
-// result in compilation errors.
-type UnsafeQueueServer interface {
-	mustEmbedUnimplementedQueueServer()
+func _Queue_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_CreateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).CreateGroup(ctx, req.(*CreateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterQueueServer(s grpc.ServiceRegistrar, srv QueueServer) {
-	// If the following call pancis, it indicates UnimplementedQueueServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _Queue_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&Queue_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(QueueServer).ListGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_ListGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).ListGroups(ctx, req.(*ListGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Queue_ListQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListQueuesRequest)
+func _Queue_GetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueueServer).ListQueues(ctx, in)
+		return srv.(QueueServer).GetGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Queue_ListQueues_FullMethodName,
+		FullMethod: Queue_GetGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServer).ListQueues(ctx, req.(*ListQueuesRequest))
+		return srv.(QueueServer).GetGroup(ctx, req.(*GetGroupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Queue_CreateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateQueueRequest)
+func _Queue_JoinGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinGroupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueueServer).CreateQueue(ctx, in)
+		return srv.(QueueServer).JoinGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Queue_CreateQueue_FullMethodName,
+		FullMethod: Queue_JoinGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServer).CreateQueue(ctx, req.(*CreateQueueRequest))
+		return srv.(QueueServer).JoinGroup(ctx, req.(*JoinGroupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Queue_GetQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetQueueRequest)
+func _Queue_ListGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupMembersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueueServer).GetQueue(ctx, in)
+		return srv.(QueueServer).ListGroupMembers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Queue_GetQueue_FullMethodName,
+		FullMethod: Queue_ListGroupMembers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServer).GetQueue(ctx, req.(*GetQueueRequest))
+		return srv.(QueueServer).ListGroupMembers(ctx, req.(*ListGroupMembersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Queue_JoinQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(JoinQueueRequest)
+func _Queue_ReviewGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewGroupMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueueServer).JoinQueue(ctx, in)
+		return srv.(QueueServer).ReviewGroupMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Queue_JoinQueue_FullMethodName,
+		FullMethod: Queue_ReviewGroupMember_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServer).JoinQueue(ctx, req.(*JoinQueueRequest))
+		return srv.(QueueServer).ReviewGroupMember(ctx, req.(*ReviewGroupMemberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Queue_LeaveQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LeaveQueueRequest)
+func _Queue_RemoveGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGroupMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueueServer).LeaveQueue(ctx, in)
+		return srv.(QueueServer).RemoveGroupMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Queue_LeaveQueue_FullMethodName,
+		FullMethod: Queue_RemoveGroupMember_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServer).LeaveQueue(ctx, req.(*LeaveQueueRequest))
+		return srv.(QueueServer).RemoveGroupMember(ctx, req.(*RemoveGroupMemberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Queue_AdvanceQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdvanceQueueRequest)
+func _Queue_RegenerateInviteCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateInviteCodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueueServer).AdvanceQueue(ctx, in)
+		return srv.(QueueServer).RegenerateInviteCode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Queue_AdvanceQueue_FullMethodName,
+		FullMethod: Queue_RegenerateInviteCode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServer).AdvanceQueue(ctx, req.(*AdvanceQueueRequest))
+		return srv.(QueueServer).RegenerateInviteCode(ctx, req.(*RegenerateInviteCodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Queue_RemoveParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveParticipantRequest)
+func _Queue_CreateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueueServer).RemoveParticipant(ctx, in)
+		return srv.(QueueServer).CreateTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Queue_RemoveParticipant_FullMethodName,
+		FullMethod: Queue_CreateTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServer).RemoveParticipant(ctx, req.(*RemoveParticipantRequest))
+		return srv.(QueueServer).CreateTemplate(ctx, req.(*CreateTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Queue_ArchiveQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ArchiveQueueRequest)
+func _Queue_GetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueueServer).ArchiveQueue(ctx, in)
+		return srv.(QueueServer).GetTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Queue_ArchiveQueue_FullMethodName,
+		FullMethod: Queue_GetTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServer).ArchiveQueue(ctx, req.(*ArchiveQueueRequest))
+		return srv.(QueueServer).GetTemplate(ctx, req.(*GetTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Queue_DeleteQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteQueueRequest)
+func _Queue_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueueServer).DeleteQueue(ctx, in)
+		return srv.(QueueServer).ListTemplates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Queue_DeleteQueue_FullMethodName,
+		FullMethod: Queue_ListTemplates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServer).DeleteQueue(ctx, req.(*DeleteQueueRequest))
+		return srv.(QueueServer).ListTemplates(ctx, req.(*ListTemplatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Queue_UpdateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateQueueRequest)
+func _Queue_UpdateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueueServer).UpdateQueue(ctx, in)
+		return srv.(QueueServer).UpdateTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Queue_UpdateQueue_FullMethodName,
+		FullMethod: Queue_UpdateTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServer).UpdateQueue(ctx, req.(*UpdateQueueRequest))
+		return srv.(QueueServer).UpdateTemplate(ctx, req.(*UpdateTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Queue_AddParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddParticipantRequest)
+func _Queue_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueueServer).AddParticipant(ctx, in)
+		return srv.(QueueServer).DeleteTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Queue_AddParticipant_FullMethodName,
+		FullMethod: Queue_DeleteTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueueServer).AddParticipant(ctx, req.(*AddParticipantRequest))
+		return srv.(QueueServer).DeleteTemplate(ctx, req.(*DeleteTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -495,7 +1727,149 @@ var Queue_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddParticipant",
 			Handler:    _Queue_AddParticipant_Handler,
 		},
+		{
+			MethodName: "SetCallPolicy",
+			Handler:    _Queue_SetCallPolicy_Handler,
+		},
+		{
+			MethodName: "CheckIn",
+			Handler:    _Queue_CheckIn_Handler,
+		},
+		{
+			MethodName: "MoveParticipant",
+			Handler:    _Queue_MoveParticipant_Handler,
+		},
+		{
+			MethodName: "SwapParticipants",
+			Handler:    _Queue_SwapParticipants_Handler,
+		},
+		{
+			MethodName: "ReorderQueue",
+			Handler:    _Queue_ReorderQueue_Handler,
+		},
+		{
+			MethodName: "SetLottery",
+			Handler:    _Queue_SetLottery_Handler,
+		},
+		{
+			MethodName: "DrawLottery",
+			Handler:    _Queue_DrawLottery_Handler,
+		},
+		{
+			MethodName: "DeferTurn",
+			Handler:    _Queue_DeferTurn_Handler,
+		},
+		{
+			MethodName: "SetParticipantPriority",
+			Handler:    _Queue_SetParticipantPriority_Handler,
+		},
+		{
+			MethodName: "SetSwapPolicy",
+			Handler:    _Queue_SetSwapPolicy_Handler,
+		},
+		{
+			MethodName: "RequestSwap",
+			Handler:    _Queue_RequestSwap_Handler,
+		},
+		{
+			MethodName: "AnswerSwap",
+			Handler:    _Queue_AnswerSwap_Handler,
+		},
+		{
+			MethodName: "CancelSwap",
+			Handler:    _Queue_CancelSwap_Handler,
+		},
+		{
+			MethodName: "ListSwapRequests",
+			Handler:    _Queue_ListSwapRequests_Handler,
+		},
+		{
+			MethodName: "ListQueueEvents",
+			Handler:    _Queue_ListQueueEvents_Handler,
+		},
+		{
+			MethodName: "GetQueueHistory",
+			Handler:    _Queue_GetQueueHistory_Handler,
+		},
+		{
+			MethodName: "ListMembers",
+			Handler:    _Queue_ListMembers_Handler,
+		},
+		{
+			MethodName: "GrantRole",
+			Handler:    _Queue_GrantRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _Queue_RevokeRole_Handler,
+		},
+		{
+			MethodName: "SetSlotSchedule",
+			Handler:    _Queue_SetSlotSchedule_Handler,
+		},
+		{
+			MethodName: "ListFreeSlots",
+			Handler:    _Queue_ListFreeSlots_Handler,
+		},
+		{
+			MethodName: "CreateGroup",
+			Handler:    _Queue_CreateGroup_Handler,
+		},
+		{
+			MethodName: "ListGroups",
+			Handler:    _Queue_ListGroups_Handler,
+		},
+		{
+			MethodName: "GetGroup",
+			Handler:    _Queue_GetGroup_Handler,
+		},
+		{
+			MethodName: "JoinGroup",
+			Handler:    _Queue_JoinGroup_Handler,
+		},
+		{
+			MethodName: "ListGroupMembers",
+			Handler:    _Queue_ListGroupMembers_Handler,
+		},
+		{
+			MethodName: "ReviewGroupMember",
+			Handler:    _Queue_ReviewGroupMember_Handler,
+		},
+		{
+			MethodName: "RemoveGroupMember",
+			Handler:    _Queue_RemoveGroupMember_Handler,
+		},
+		{
+			MethodName: "RegenerateInviteCode",
+			Handler:    _Queue_RegenerateInviteCode_Handler,
+		},
+		{
+			MethodName: "CreateTemplate",
+			Handler:    _Queue_CreateTemplate_Handler,
+		},
+		{
+			MethodName: "GetTemplate",
+			Handler:    _Queue_GetTemplate_Handler,
+		},
+		{
+			MethodName: "ListTemplates",
+			Handler:    _Queue_ListTemplates_Handler,
+		},
+		{
+			MethodName: "UpdateTemplate",
+			Handler:    _Queue_UpdateTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteTemplate",
+			Handler:    _Queue_DeleteTemplate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchQueue",
+			Handler:       _Queue_WatchQueue_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "queue/queue.proto",
 }
@@ -11,10 +11,12 @@ import (
 )
 
 type Config struct {
-	Env  string
-	HTTP HTTPConfig
-	GRPC GRPCConfig
-	App  AppConfig
+	Env      string
+	HTTP     HTTPConfig
+	GRPC     GRPCConfig
+	App      AppConfig
+	JWKS     JWKSConfig
+	Telegram TelegramConfig
 }
 
 type HTTPConfig struct {
@@ -29,8 +31,30 @@ type GRPCConfig struct {
 }
 
 type AppConfig struct {
-	ID     int    `yaml:"id"`
-	Secret string `yaml:"secret"`
+	ID int `yaml:"id"`
+}
+
+type JWKSConfig struct {
+	// CacheTTL is how long the auth service's public keys are trusted before refetching.
+	CacheTTL time.Duration `mapstructure:"cache_ttl"`
+}
+
+const (
+	TelegramModeWebhook = "webhook"
+	TelegramModePolling = "polling"
+)
+
+type TelegramConfig struct {
+	// Token of the bot; when empty the bot only logs its replies.
+	Token   string        `mapstructure:"token"`
+	Timeout time.Duration `mapstructure:"timeout"`
+	// Mode is "webhook" or "polling" (getUpdates, for hosts without public HTTPS).
+	Mode string `mapstructure:"mode"`
+	// WebhookURL is registered with setWebhook at startup when set.
+	WebhookURL string `mapstructure:"webhook_url"`
+	// SecretToken must match the X-Telegram-Bot-Api-Secret-Token header.
+	SecretToken string        `mapstructure:"secret_token"`
+	PollTimeout time.Duration `mapstructure:"poll_timeout"`
 }
 
 //go:embed config.yaml
@@ -54,6 +78,14 @@ func Load() (*Config, error) {
 
 	applyDefaults(&cfg)
 
+	if cfg.Telegram.Mode != TelegramModeWebhook && cfg.Telegram.Mode != TelegramModePolling {
+		return nil, fmt.Errorf("config: unknown telegram mode %q", cfg.Telegram.Mode)
+	}
+	// Every replica must check the same secret Telegram was given.
+	if cfg.Telegram.Mode == TelegramModeWebhook && cfg.Telegram.WebhookURL != "" && cfg.Telegram.SecretToken == "" {
+		return nil, fmt.Errorf("config: telegram.secret_token is required with telegram.webhook_url")
+	}
+
 	return &cfg, nil
 }
 
@@ -79,9 +111,21 @@ func applyDefaults(cfg *Config) {
 	if cfg.App.ID == 0 {
 		cfg.App.ID = 1
 	}
-	if cfg.App.Secret == "" {
-		cfg.App.Secret = "supersecret"
+
+	if cfg.JWKS.CacheTTL == 0 {
+		cfg.JWKS.CacheTTL = 10 * time.Minute
+	}
+
+	if cfg.Telegram.Timeout == 0 {
+		cfg.Telegram.Timeout = 10 * time.Second
+	}
+	if cfg.Telegram.Mode == "" {
+		cfg.Telegram.Mode = TelegramModeWebhook
+	}
+	if cfg.Telegram.PollTimeout == 0 {
+		cfg.Telegram.PollTimeout = 30 * time.Second
 	}
 }
+
 // фреймворк quartz
-// реализовать 
\ No newline at end of file
+// реализовать
@@ -7,9 +7,12 @@ import (
 	"strconv"
 
 	"github.com/s1lentmol/q-flow-backend/services/api-gateway/config"
+	"github.com/s1lentmol/q-flow-backend/services/api-gateway/internal/bot"
 	authclient "github.com/s1lentmol/q-flow-backend/services/api-gateway/internal/clients/auth"
 	notifyclient "github.com/s1lentmol/q-flow-backend/services/api-gateway/internal/clients/notification"
 	queueclient "github.com/s1lentmol/q-flow-backend/services/api-gateway/internal/clients/queue"
+	"github.com/s1lentmol/q-flow-backend/services/api-gateway/internal/clients/telegram"
+	"github.com/s1lentmol/q-flow-backend/services/api-gateway/internal/middleware"
 	"github.com/s1lentmol/q-flow-backend/services/api-gateway/internal/server"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -39,12 +42,23 @@ func New(ctx context.Context, log *slog.Logger, cfg *config.Config) (*App, error
 		return nil, err
 	}
 
+	auth := authclient.New(authConn)
+	queue := queueclient.New(queueConn)
+	notif := notifyclient.New(notifConn)
+	tg := telegram.New(cfg.Telegram.Token, cfg.Telegram.Timeout)
+
+	tgBot, err := newBot(ctx, log, cfg.Telegram, tg, queue, notif)
+	if err != nil {
+		return nil, err
+	}
+
 	srv := server.New(
 		log,
-		authclient.New(authConn),
-		queueclient.New(queueConn),
-		notifyclient.New(notifConn),
-		cfg.App.Secret,
+		auth,
+		queue,
+		notif,
+		middleware.NewKeySet(auth, cfg.JWKS.CacheTTL),
+		tgBot,
 		cfg.App.ID,
 	)
 
@@ -54,6 +68,32 @@ func New(ctx context.Context, log *slog.Logger, cfg *config.Config) (*App, error
 	}, nil
 }
 
+// newBot sets up how Telegram updates reach the bot. In polling mode the
+// webhook is closed and updates are fetched in the background. In webhook
+// mode the webhook is registered with the configured secret when a URL is
+// set; failing to register it stops the start, since no updates would arrive.
+func newBot(ctx context.Context, log *slog.Logger, cfg config.TelegramConfig, tg *telegram.Client, queue *queueclient.Client, notif *notifyclient.Client) (*bot.Bot, error) {
+	if cfg.Mode == config.TelegramModePolling {
+		b := bot.New(log, tg, queue, notif, "")
+		if tg.Enabled() {
+			go b.Poll(ctx, cfg.PollTimeout)
+		}
+		return b, nil
+	}
+
+	if cfg.SecretToken == "" {
+		log.Warn("telegram secret token is not set, webhook requests will be rejected")
+	}
+
+	b := bot.New(log, tg, queue, notif, cfg.SecretToken)
+	if tg.Enabled() && cfg.WebhookURL != "" {
+		if err := b.RegisterWebhook(ctx, cfg.WebhookURL); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
 func (a *App) Run(ctx context.Context, addr string) error {
 	errCh := make(chan error, 1)
 	go func() {
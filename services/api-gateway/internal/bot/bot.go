@@ -0,0 +1,234 @@
+package bot
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	queuev1 "github.com/s1lentmol/q-flow-backend/protos/gen/go/queue"
+	notifyclient "github.com/s1lentmol/q-flow-backend/services/api-gateway/internal/clients/notification"
+	queueclient "github.com/s1lentmol/q-flow-backend/services/api-gateway/internal/clients/queue"
+	"github.com/s1lentmol/q-flow-backend/services/api-gateway/internal/clients/telegram"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errNotLinked = errors.New("chat is not linked")
+
+// Bot answers commands sent to the Telegram bot. The chat is mapped to a Q-Flow
+// user through the contact bound with /start, and every action is performed
+// by the queue service on behalf of that user.
+type Bot struct {
+	log   *slog.Logger
+	tg    *telegram.Client
+	queue *queueclient.Client
+	notif *notifyclient.Client
+	// secret is expected in the webhook header; empty rejects every webhook call.
+	secret string
+}
+
+func New(log *slog.Logger, tg *telegram.Client, queue *queueclient.Client, notif *notifyclient.Client, secret string) *Bot {
+	return &Bot{log: log, tg: tg, queue: queue, notif: notif, secret: secret}
+}
+
+// Authorized reports whether a webhook request carries the secret passed to setWebhook.
+func (b *Bot) Authorized(header string) bool {
+	if b.secret == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(header), []byte(b.secret)) == 1
+}
+
+// HandleUpdate processes one update. Failures are reported to the chat, so
+// only errors of the bot itself are returned.
+func (b *Bot) HandleUpdate(ctx context.Context, upd telegram.Update) error {
+	switch {
+	case upd.CallbackQuery != nil:
+		return b.handleCallback(ctx, upd.CallbackQuery)
+	case upd.Message != nil:
+		return b.handleMessage(ctx, upd.Message)
+	default:
+		return nil
+	}
+}
+
+func (b *Bot) handleMessage(ctx context.Context, msg *telegram.Message) error {
+	command, arg := parseCommand(msg.Text)
+	if command == "" {
+		return nil
+	}
+	chatID := msg.Chat.ID
+	var from telegram.User
+	if msg.From != nil {
+		from = *msg.From
+	}
+
+	if command == "/start" {
+		locale := telegramLocale(from.LanguageCode)
+		if arg == "" {
+			return b.reply(ctx, chatID, text(locale, txtHelp), nil)
+		}
+		// On success the notification service confirms the binding itself.
+		err := b.notif.BindByToken(ctx, arg, strconv.FormatInt(chatID, 10), from.Username)
+		if status.Code(err) == codes.NotFound {
+			return b.reply(ctx, chatID, text(locale, txtExpiredLink), nil)
+		}
+		if err != nil {
+			b.log.Warn("failed to bind telegram token", slog.Any("err", err))
+		}
+		return nil
+	}
+
+	userID, err := b.resolve(ctx, chatID)
+	if err != nil {
+		if errors.Is(err, errNotLinked) {
+			return b.reply(ctx, chatID, text(telegramLocale(from.LanguageCode), txtNotLinked), nil)
+		}
+		return err
+	}
+
+	reply, markup := b.run(ctx, b.locale(ctx, userID, from), userID, from, command, arg)
+	return b.reply(ctx, chatID, reply, markup)
+}
+
+func (b *Bot) handleCallback(ctx context.Context, cb *telegram.CallbackQuery) error {
+	if b.tg.Enabled() {
+		if err := b.tg.AnswerCallbackQuery(ctx, cb.ID, ""); err != nil {
+			b.log.Warn("failed to answer callback query", slog.Any("err", err))
+		}
+	}
+	if cb.Message == nil {
+		return nil
+	}
+	chatID := cb.Message.Chat.ID
+
+	// Buttons carry "<command>:<id>", e.g. "join:42"; the id is a queue id
+	// except for swap requests.
+	action, arg, ok := strings.Cut(cb.Data, ":")
+	if !ok {
+		return nil
+	}
+
+	userID, err := b.resolve(ctx, chatID)
+	if err != nil {
+		if errors.Is(err, errNotLinked) {
+			return b.reply(ctx, chatID, text(telegramLocale(cb.From.LanguageCode), txtNotLinked), nil)
+		}
+		return err
+	}
+
+	reply, markup := b.run(ctx, b.locale(ctx, userID, cb.From), userID, cb.From, "/"+action, arg)
+	return b.reply(ctx, chatID, reply, markup)
+}
+
+// run executes a command and returns the reply in the locale.
+func (b *Bot) run(ctx context.Context, locale string, userID int64, from telegram.User, command, arg string) (string, *telegram.InlineKeyboardMarkup) {
+	switch command {
+	case "/queues":
+		return b.listQueues(ctx, locale, userID)
+	case "/join":
+		return b.join(ctx, locale, userID, from, arg)
+	case "/leave":
+		return b.leave(ctx, locale, userID, arg)
+	case "/position":
+		return b.positions(ctx, locale, userID)
+	case "/checkin":
+		return b.checkIn(ctx, locale, userID, arg)
+	case "/swapaccept":
+		return b.answerSwap(ctx, locale, userID, arg, true)
+	case "/swapdecline":
+		return b.answerSwap(ctx, locale, userID, arg, false)
+	case "/next":
+		return b.next(ctx, locale, userID, arg)
+	default:
+		return text(locale, txtHelp), nil
+	}
+}
+
+// locale is the language the user chose for notifications; when the
+// preferences cannot be read the Telegram language is used.
+func (b *Bot) locale(ctx context.Context, userID int64, from telegram.User) string {
+	prefs, err := b.notif.Preferences(ctx, userID)
+	if err != nil {
+		b.log.Warn("failed to get user locale", slog.Int64("user_id", userID), slog.Any("err", err))
+		return telegramLocale(from.LanguageCode)
+	}
+	if _, ok := texts[prefs.GetLocale()]; !ok {
+		return telegramLocale(from.LanguageCode)
+	}
+	return prefs.GetLocale()
+}
+
+func (b *Bot) resolve(ctx context.Context, chatID int64) (int64, error) {
+	userID, err := b.notif.ResolveChat(ctx, strconv.FormatInt(chatID, 10))
+	if status.Code(err) == codes.NotFound {
+		return 0, errNotLinked
+	}
+	if err != nil {
+		return 0, fmt.Errorf("resolve chat: %w", err)
+	}
+	return userID, nil
+}
+
+// reply sends text to the chat; without a bot token it is only logged.
+func (b *Bot) reply(ctx context.Context, chatID int64, text string, markup *telegram.InlineKeyboardMarkup) error {
+	if !b.tg.Enabled() {
+		b.log.Info("telegram token not set, logging bot reply", slog.Int64("chat_id", chatID), slog.String("text", text))
+		return nil
+	}
+	if err := b.tg.SendMessage(ctx, chatID, text, markup); err != nil {
+		return fmt.Errorf("send reply: %w", err)
+	}
+	return nil
+}
+
+// parseCommand splits "/join@qflow_bot 42" into "/join" and "42".
+func parseCommand(text string) (string, string) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 || !strings.HasPrefix(fields[0], "/") {
+		return "", ""
+	}
+	command, _, _ := strings.Cut(fields[0], "@")
+	arg := ""
+	if len(fields) > 1 {
+		arg = fields[1]
+	}
+	return strings.ToLower(command), arg
+}
+
+// errorText turns a queue service error into a message for the user.
+func errorText(locale string, err error) string {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return text(locale, txtQueueNotFound)
+	case codes.PermissionDenied:
+		return text(locale, txtNoAccess)
+	case codes.AlreadyExists:
+		return text(locale, txtAlreadyJoined)
+	case codes.FailedPrecondition, codes.InvalidArgument:
+		return text(locale, txtFailed, status.Convert(err).Message())
+	default:
+		return text(locale, txtInternal)
+	}
+}
+
+func modeTitle(locale string, mode queuev1.QueueMode) string {
+	switch mode {
+	case queuev1.QueueMode_QUEUE_MODE_LIVE:
+		return text(locale, txtModeLive)
+	case queuev1.QueueMode_QUEUE_MODE_MANAGED:
+		return text(locale, txtModeManaged)
+	case queuev1.QueueMode_QUEUE_MODE_RANDOM:
+		return text(locale, txtModeRandom)
+	case queuev1.QueueMode_QUEUE_MODE_SLOTS:
+		return text(locale, txtModeSlots)
+	case queuev1.QueueMode_QUEUE_MODE_PRIORITY:
+		return text(locale, txtModePriority)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,278 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	queuev1 "github.com/s1lentmol/q-flow-backend/protos/gen/go/queue"
+	"github.com/s1lentmol/q-flow-backend/services/api-gateway/internal/clients/telegram"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxButtons keeps keyboards readable in a chat.
+const maxButtons = 20
+
+// Queue roles that may advance a queue.
+const (
+	roleOwner     = "owner"
+	roleModerator = "moderator"
+)
+
+func (b *Bot) listQueues(ctx context.Context, locale string, userID int64) (string, *telegram.InlineKeyboardMarkup) {
+	queues, err := b.queue.List(ctx, "", userID)
+	if err != nil {
+		return errorText(locale, err), nil
+	}
+	open := openQueues(queues)
+	if len(open) == 0 {
+		return text(locale, txtNoOpenQueues), nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString(text(locale, txtOpenQueues) + "\n")
+	for _, q := range open {
+		fmt.Fprintf(&sb, "%d. %s (%s)\n", q.GetId(), q.GetTitle(), modeTitle(locale, q.GetMode()))
+	}
+	sb.WriteString("\n" + text(locale, txtPressToJoin))
+	return sb.String(), keyboard("join", open)
+}
+
+func (b *Bot) join(ctx context.Context, locale string, userID int64, from telegram.User, arg string) (string, *telegram.InlineKeyboardMarkup) {
+	if arg == "" {
+		queues, err := b.queue.List(ctx, "", userID)
+		if err != nil {
+			return errorText(locale, err), nil
+		}
+		open := openQueues(queues)
+		if len(open) == 0 {
+			return text(locale, txtNoOpenQueues), nil
+		}
+		return text(locale, txtChooseQueue), keyboard("join", open)
+	}
+
+	queueID, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return text(locale, txtJoinUsage), nil
+	}
+	resp, err := b.queue.Get(ctx, queueID, userID, "")
+	if err != nil {
+		return errorText(locale, err), nil
+	}
+	if resp.GetQueue().GetMode() == queuev1.QueueMode_QUEUE_MODE_SLOTS {
+		return text(locale, txtSlotsInApp), nil
+	}
+
+	position, err := b.queue.Join(ctx, queueID, userID, fullName(from), "", "")
+	if err != nil {
+		return errorText(locale, err), nil
+	}
+	if q := resp.GetQueue(); q.GetMode() == queuev1.QueueMode_QUEUE_MODE_RANDOM && q.GetDrawnAt() == 0 {
+		return text(locale, txtJoinedLottery, q.GetTitle()), nil
+	}
+	return text(locale, txtJoined, resp.GetQueue().GetTitle(), position), nil
+}
+
+func (b *Bot) leave(ctx context.Context, locale string, userID int64, arg string) (string, *telegram.InlineKeyboardMarkup) {
+	if arg == "" {
+		entries, err := b.entries(ctx, userID)
+		if err != nil {
+			return errorText(locale, err), nil
+		}
+		if len(entries) == 0 {
+			return text(locale, txtNotInQueues), nil
+		}
+		queues := make([]*queuev1.QueueDTO, 0, len(entries))
+		for _, e := range entries {
+			queues = append(queues, e.queue)
+		}
+		return text(locale, txtChooseLeave), keyboard("leave", queues)
+	}
+
+	queueID, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return text(locale, txtLeaveUsage), nil
+	}
+	if err := b.queue.Leave(ctx, queueID, userID, ""); err != nil {
+		return errorText(locale, err), nil
+	}
+	return text(locale, txtLeft), nil
+}
+
+func (b *Bot) positions(ctx context.Context, locale string, userID int64) (string, *telegram.InlineKeyboardMarkup) {
+	entries, err := b.entries(ctx, userID)
+	if err != nil {
+		return errorText(locale, err), nil
+	}
+	if len(entries) == 0 {
+		return text(locale, txtNotInQueues), nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString(text(locale, txtPositions) + "\n")
+	for _, e := range entries {
+		sb.WriteString(text(locale, txtPositionLine, e.queue.GetTitle(), e.position, e.total) + "\n")
+	}
+	return sb.String(), nil
+}
+
+func (b *Bot) next(ctx context.Context, locale string, userID int64, arg string) (string, *telegram.InlineKeyboardMarkup) {
+	if arg == "" {
+		queues, err := b.moderated(ctx, userID)
+		if err != nil {
+			return errorText(locale, err), nil
+		}
+		if len(queues) == 0 {
+			return text(locale, txtNothingToAdvance), nil
+		}
+		return text(locale, txtChooseNext), keyboard("next", queues)
+	}
+
+	queueID, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return text(locale, txtNextUsage), nil
+	}
+	resp, err := b.queue.Advance(ctx, queueID, userID, "", false)
+	if err != nil {
+		return errorText(locale, err), nil
+	}
+	served, called := resp.GetRemoved(), resp.GetCalled()
+	switch {
+	case served != nil && called != nil:
+		return text(locale, txtServedCalled, served.GetFullName(), called.GetFullName()), nil
+	case served != nil:
+		return text(locale, txtServed, served.GetFullName()), nil
+	case called != nil:
+		return text(locale, txtCalled, called.GetFullName()), nil
+	default:
+		return text(locale, txtQueueEmpty), nil
+	}
+}
+
+// checkIn confirms a call; the call notification's button sends "checkin:<queue id>".
+func (b *Bot) checkIn(ctx context.Context, locale string, userID int64, arg string) (string, *telegram.InlineKeyboardMarkup) {
+	queueID, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return text(locale, txtCheckInUsage), nil
+	}
+	if _, err := b.queue.CheckIn(ctx, queueID, userID, ""); err != nil {
+		return errorText(locale, err), nil
+	}
+	return text(locale, txtCheckedIn), nil
+}
+
+// answerSwap answers a swap request; the request notification's button sends "swapaccept:<request id>".
+func (b *Bot) answerSwap(ctx context.Context, locale string, userID int64, arg string, accept bool) (string, *telegram.InlineKeyboardMarkup) {
+	requestID, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return text(locale, txtSwapUsage), nil
+	}
+	resp, err := b.queue.AnswerSwap(ctx, requestID, userID, "", accept)
+	if status.Code(err) == codes.NotFound {
+		return text(locale, txtSwapNotFound), nil
+	}
+	if err != nil {
+		return errorText(locale, err), nil
+	}
+	if !accept {
+		return text(locale, txtSwapDeclined), nil
+	}
+	for _, p := range resp.GetParticipants() {
+		if p.GetUserId() == userID {
+			return text(locale, txtSwappedAt, p.GetPosition()), nil
+		}
+	}
+	return text(locale, txtSwapped), nil
+}
+
+type entry struct {
+	queue    *queuev1.QueueDTO
+	position int32
+	total    int
+}
+
+// entries finds the queues the user is standing in.
+func (b *Bot) entries(ctx context.Context, userID int64) ([]entry, error) {
+	queues, err := b.queue.List(ctx, "", userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []entry
+	for _, q := range queues {
+		resp, err := b.queue.Get(ctx, q.GetId(), userID, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range resp.GetParticipants() {
+			if p.GetUserId() == userID {
+				list = append(list, entry{queue: q, position: p.GetPosition(), total: len(resp.GetParticipants())})
+				break
+			}
+		}
+	}
+	return list, nil
+}
+
+// moderated finds the queues the user may advance: their own and those where
+// they were made an owner or a moderator.
+func (b *Bot) moderated(ctx context.Context, userID int64) ([]*queuev1.QueueDTO, error) {
+	queues, err := b.queue.List(ctx, "", userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*queuev1.QueueDTO
+	for _, q := range queues {
+		if q.GetOwnerId() == userID {
+			list = append(list, q)
+			continue
+		}
+		members, err := b.queue.Members(ctx, q.GetId(), userID, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range members {
+			if m.GetUserId() == userID && (m.GetRole() == roleOwner || m.GetRole() == roleModerator) {
+				list = append(list, q)
+				break
+			}
+		}
+	}
+	return list, nil
+}
+
+func openQueues(queues []*queuev1.QueueDTO) []*queuev1.QueueDTO {
+	var open []*queuev1.QueueDTO
+	for _, q := range queues {
+		if q.GetStatus() == queuev1.QueueStatus_QUEUE_STATUS_ACTIVE {
+			open = append(open, q)
+		}
+	}
+	return open
+}
+
+// keyboard makes a button per queue that sends "<action>:<queue id>" back.
+func keyboard(action string, queues []*queuev1.QueueDTO) *telegram.InlineKeyboardMarkup {
+	markup := &telegram.InlineKeyboardMarkup{}
+	for i, q := range queues {
+		if i == maxButtons {
+			break
+		}
+		markup.InlineKeyboard = append(markup.InlineKeyboard, []telegram.InlineKeyboardButton{{
+			Text:         q.GetTitle(),
+			CallbackData: fmt.Sprintf("%s:%d", action, q.GetId()),
+		}})
+	}
+	return markup
+}
+
+func fullName(u telegram.User) string {
+	name := strings.TrimSpace(u.FirstName + " " + u.LastName)
+	if name == "" && u.Username != "" {
+		name = "@" + u.Username
+	}
+	return name
+}
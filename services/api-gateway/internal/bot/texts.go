@@ -0,0 +1,185 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+)
+
+// defaultLocale is used when the user has no preference and Telegram does not
+// tell the language either; it matches the notification service default.
+const defaultLocale = "ru"
+
+type textKey int
+
+const (
+	txtHelp textKey = iota
+	txtNotLinked
+	txtExpiredLink
+	txtQueueNotFound
+	txtNoAccess
+	txtAlreadyJoined
+	txtFailed
+	txtInternal
+	txtModeLive
+	txtModeManaged
+	txtModeRandom
+	txtModeSlots
+	txtModePriority
+	txtNoOpenQueues
+	txtOpenQueues
+	txtPressToJoin
+	txtChooseQueue
+	txtJoinUsage
+	txtSlotsInApp
+	txtJoinedLottery
+	txtJoined
+	txtNotInQueues
+	txtChooseLeave
+	txtLeaveUsage
+	txtLeft
+	txtPositions
+	txtPositionLine
+	txtNothingToAdvance
+	txtChooseNext
+	txtNextUsage
+	txtServedCalled
+	txtServed
+	txtCalled
+	txtQueueEmpty
+	txtCheckInUsage
+	txtCheckedIn
+	txtSwapUsage
+	txtSwapNotFound
+	txtSwapDeclined
+	txtSwappedAt
+	txtSwapped
+)
+
+// texts holds the bot replies per locale; a locale must define every key.
+var texts = map[string]map[textKey]string{
+	"ru": {
+		txtHelp: `Команды:
+/queues — доступные очереди
+/join <id> — записаться в очередь
+/leave <id> — выйти из очереди
+/position — ваши места в очередях
+/checkin <id> — подтвердить, что идёте, когда вас вызвали
+/swapaccept <id> — принять запрос на обмен местами
+/swapdecline <id> — отказаться от обмена местами
+/next <id> — вызвать следующего (для владельцев и модераторов)`,
+		txtNotLinked:        "Чат не привязан к аккаунту. Откройте профиль в приложении и нажмите «Привязать Telegram».",
+		txtExpiredLink:      "Ссылка устарела или уже использована. Получите новую в профиле приложения.",
+		txtQueueNotFound:    "Очередь не найдена.",
+		txtNoAccess:         "Нет доступа к этой очереди.",
+		txtAlreadyJoined:    "Вы уже записаны в эту очередь.",
+		txtFailed:           "Не получилось: %s.",
+		txtInternal:         "Что-то пошло не так, попробуйте позже.",
+		txtModeLive:         "живая",
+		txtModeManaged:      "управляемая",
+		txtModeRandom:       "случайная",
+		txtModeSlots:        "по слотам",
+		txtModePriority:     "приоритетная",
+		txtNoOpenQueues:     "Сейчас нет открытых очередей.",
+		txtOpenQueues:       "Открытые очереди:",
+		txtPressToJoin:      "Нажмите, чтобы записаться:",
+		txtChooseQueue:      "Выберите очередь:",
+		txtJoinUsage:        "Укажите номер очереди: /join <id>.",
+		txtSlotsInApp:       "В очередь по слотам можно записаться только в приложении: там выбирается время.",
+		txtJoinedLottery:    "Вы записаны в «%s». Место определит жеребьёвка.",
+		txtJoined:           "Вы записаны в «%s». Ваше место: %d.",
+		txtNotInQueues:      "Вы не записаны ни в одну очередь.",
+		txtChooseLeave:      "Из какой очереди выйти?",
+		txtLeaveUsage:       "Укажите номер очереди: /leave <id>.",
+		txtLeft:             "Вы вышли из очереди.",
+		txtPositions:        "Ваши места:",
+		txtPositionLine:     "%s — %d из %d",
+		txtNothingToAdvance: "Нет очередей, которые вы можете продвинуть.",
+		txtChooseNext:       "Какую очередь продвинуть?",
+		txtNextUsage:        "Укажите номер очереди: /next <id>.",
+		txtServedCalled:     "Готово: %s обслужен(а). Вызван(а) %s.",
+		txtServed:           "Готово: %s обслужен(а).",
+		txtCalled:           "Вызван(а) %s, ждём подтверждения.",
+		txtQueueEmpty:       "Очередь пуста.",
+		txtCheckInUsage:     "Укажите номер очереди: /checkin <id>.",
+		txtCheckedIn:        "Отлично, ждём вас!",
+		txtSwapUsage:        "Укажите номер запроса: /swapaccept <id> или /swapdecline <id>.",
+		txtSwapNotFound:     "Запрос на обмен не найден.",
+		txtSwapDeclined:     "Вы отказались от обмена.",
+		txtSwappedAt:        "Готово, вы поменялись местами. Ваше место: %d.",
+		txtSwapped:          "Готово, вы поменялись местами.",
+	},
+	"en": {
+		txtHelp: `Commands:
+/queues — available queues
+/join <id> — join a queue
+/leave <id> — leave a queue
+/position — your places in queues
+/checkin <id> — confirm you are coming when called
+/swapaccept <id> — accept a swap request
+/swapdecline <id> — decline a swap request
+/next <id> — call the next one (for owners and moderators)`,
+		txtNotLinked:        "This chat is not linked to an account. Open your profile in the app and press “Link Telegram”.",
+		txtExpiredLink:      "The link has expired or was already used. Get a new one in your profile.",
+		txtQueueNotFound:    "Queue not found.",
+		txtNoAccess:         "You have no access to this queue.",
+		txtAlreadyJoined:    "You are already in this queue.",
+		txtFailed:           "Could not do that: %s.",
+		txtInternal:         "Something went wrong, please try again later.",
+		txtModeLive:         "live",
+		txtModeManaged:      "managed",
+		txtModeRandom:       "random",
+		txtModeSlots:        "slots",
+		txtModePriority:     "priority",
+		txtNoOpenQueues:     "There are no open queues right now.",
+		txtOpenQueues:       "Open queues:",
+		txtPressToJoin:      "Press to join:",
+		txtChooseQueue:      "Choose a queue:",
+		txtJoinUsage:        "Give the queue number: /join <id>.",
+		txtSlotsInApp:       "Slot queues can only be joined in the app, where you pick the time.",
+		txtJoinedLottery:    "You joined “%s”. Your place will be drawn.",
+		txtJoined:           "You joined “%s”. Your place: %d.",
+		txtNotInQueues:      "You are not in any queue.",
+		txtChooseLeave:      "Which queue do you want to leave?",
+		txtLeaveUsage:       "Give the queue number: /leave <id>.",
+		txtLeft:             "You left the queue.",
+		txtPositions:        "Your places:",
+		txtPositionLine:     "%s — %d of %d",
+		txtNothingToAdvance: "There are no queues you can advance.",
+		txtChooseNext:       "Which queue to advance?",
+		txtNextUsage:        "Give the queue number: /next <id>.",
+		txtServedCalled:     "Done: %s served. %s is called.",
+		txtServed:           "Done: %s served.",
+		txtCalled:           "%s is called, waiting for confirmation.",
+		txtQueueEmpty:       "The queue is empty.",
+		txtCheckInUsage:     "Give the queue number: /checkin <id>.",
+		txtCheckedIn:        "Great, we are waiting for you!",
+		txtSwapUsage:        "Give the request number: /swapaccept <id> or /swapdecline <id>.",
+		txtSwapNotFound:     "Swap request not found.",
+		txtSwapDeclined:     "You declined the swap.",
+		txtSwappedAt:        "Done, you swapped places. Your place: %d.",
+		txtSwapped:          "Done, you swapped places.",
+	},
+}
+
+// text returns the reply in the locale, or in defaultLocale when the locale
+// has no texts; args fill in the verbs of the text.
+func text(locale string, key textKey, args ...any) string {
+	t, ok := texts[locale]
+	if !ok {
+		t = texts[defaultLocale]
+	}
+	if len(args) == 0 {
+		return t[key]
+	}
+	return fmt.Sprintf(t[key], args...)
+}
+
+// telegramLocale maps a Telegram language code such as "en-US" to a locale
+// with texts; unknown languages get defaultLocale.
+func telegramLocale(code string) string {
+	lang, _, _ := strings.Cut(strings.ToLower(code), "-")
+	if _, ok := texts[lang]; ok {
+		return lang
+	}
+	return defaultLocale
+}
@@ -0,0 +1,54 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// pollRetry is the pause after a failed getUpdates call.
+const pollRetry = 5 * time.Second
+
+// RegisterWebhook points Telegram at url so updates arrive on the webhook.
+func (b *Bot) RegisterWebhook(ctx context.Context, url string) error {
+	if err := b.tg.SetWebhook(ctx, url, b.secret); err != nil {
+		return fmt.Errorf("set webhook: %w", err)
+	}
+	b.log.Info("telegram webhook registered", slog.String("url", url))
+	return nil
+}
+
+// Poll fetches updates with getUpdates until ctx is done. It is meant for
+// environments without a public HTTPS endpoint; the webhook is removed first
+// because Telegram refuses getUpdates while one is set.
+func (b *Bot) Poll(ctx context.Context, timeout time.Duration) {
+	if err := b.tg.DeleteWebhook(ctx); err != nil {
+		b.log.Warn("failed to delete telegram webhook", slog.Any("err", err))
+	}
+	b.log.Info("telegram long polling started")
+
+	var offset int64
+	for ctx.Err() == nil {
+		updates, err := b.tg.GetUpdates(ctx, offset, timeout)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			b.log.Warn("failed to get telegram updates", slog.Any("err", err))
+			select {
+			case <-ctx.Done():
+			case <-time.After(pollRetry):
+			}
+			continue
+		}
+
+		for _, upd := range updates {
+			// Confirm the update even if handling fails, as the webhook does.
+			offset = upd.UpdateID + 1
+			if err := b.HandleUpdate(ctx, upd); err != nil {
+				b.log.Warn("failed to handle telegram update", slog.Any("err", err))
+			}
+		}
+	}
+}
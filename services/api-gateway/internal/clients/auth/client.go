@@ -29,14 +29,41 @@ func (c *Client) Register(ctx context.Context, email, fullName, password string)
 	return resp.GetUserId(), nil
 }
 
-func (c *Client) Login(ctx context.Context, email, password string, appID int32) (string, error) {
-	resp, err := c.api.Login(ctx, &authv1.LoginRequest{
+func (c *Client) Login(ctx context.Context, email, password string, appID int32) (*authv1.LoginResponse, error) {
+	return c.api.Login(ctx, &authv1.LoginRequest{
 		Email:    email,
 		Password: password,
 		AppId:    appID,
 	})
+}
+
+func (c *Client) Refresh(ctx context.Context, refreshToken string, appID int32) (*authv1.RefreshResponse, error) {
+	return c.api.Refresh(ctx, &authv1.RefreshRequest{
+		RefreshToken: refreshToken,
+		AppId:        appID,
+	})
+}
+
+func (c *Client) Logout(ctx context.Context, token, refreshToken string) error {
+	_, err := c.api.Logout(ctx, &authv1.LogoutRequest{
+		Token:        token,
+		RefreshToken: refreshToken,
+	})
+	return err
+}
+
+func (c *Client) IsTokenRevoked(ctx context.Context, tokenID string) (bool, error) {
+	resp, err := c.api.IsTokenRevoked(ctx, &authv1.IsTokenRevokedRequest{TokenId: tokenID})
+	if err != nil {
+		return false, err
+	}
+	return resp.GetRevoked(), nil
+}
+
+func (c *Client) JWKS(ctx context.Context) ([]*authv1.JWK, error) {
+	resp, err := c.api.GetJWKS(ctx, &authv1.GetJWKSRequest{})
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	return resp.GetToken(), nil
+	return resp.GetKeys(), nil
 }
@@ -26,15 +26,24 @@ func (c *Client) SetContact(ctx context.Context, userID int64, username, chatID
 	return err
 }
 
-func (c *Client) CreateLinkToken(ctx context.Context, userID int64, username string) (token string, link string, err error) {
-	resp, err := c.api.CreateLinkToken(ctx, &notificationv1.CreateLinkTokenRequest{
+func (c *Client) Contact(ctx context.Context, userID int64) (*notificationv1.ContactDTO, error) {
+	resp, err := c.api.GetContact(ctx, &notificationv1.GetContactRequest{UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetContact(), nil
+}
+
+func (c *Client) UnlinkContact(ctx context.Context, userID int64) error {
+	_, err := c.api.UnlinkContact(ctx, &notificationv1.UnlinkContactRequest{UserId: userID})
+	return err
+}
+
+func (c *Client) CreateLinkToken(ctx context.Context, userID int64, username string) (*notificationv1.CreateLinkTokenResponse, error) {
+	return c.api.CreateLinkToken(ctx, &notificationv1.CreateLinkTokenRequest{
 		UserId:           userID,
 		TelegramUsername: username,
 	})
-	if err != nil {
-		return "", "", err
-	}
-	return resp.GetToken(), resp.GetLink(), nil
 }
 
 func (c *Client) BindByToken(ctx context.Context, token, chatID, username string) error {
@@ -45,3 +54,81 @@ func (c *Client) BindByToken(ctx context.Context, token, chatID, username string
 	})
 	return err
 }
+
+func (c *Client) Notification(ctx context.Context, notificationID, userID int64) (*notificationv1.NotificationDTO, error) {
+	resp, err := c.api.GetDeliveryStatus(ctx, &notificationv1.GetDeliveryStatusRequest{
+		NotificationId: notificationID,
+		UserId:         userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetNotification(), nil
+}
+
+func (c *Client) Notifications(ctx context.Context, req *notificationv1.ListNotificationsRequest) (*notificationv1.ListNotificationsResponse, error) {
+	return c.api.ListNotifications(ctx, req)
+}
+
+func (c *Client) SetChannel(ctx context.Context, req *notificationv1.SetChannelRequest) (*notificationv1.ChannelDTO, error) {
+	resp, err := c.api.SetChannel(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetChannel(), nil
+}
+
+func (c *Client) Channels(ctx context.Context, userID int64) ([]*notificationv1.ChannelDTO, error) {
+	resp, err := c.api.ListChannels(ctx, &notificationv1.ListChannelsRequest{UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetChannels(), nil
+}
+
+func (c *Client) DeleteChannel(ctx context.Context, userID int64, channel string) error {
+	_, err := c.api.DeleteChannel(ctx, &notificationv1.DeleteChannelRequest{UserId: userID, Channel: channel})
+	return err
+}
+
+func (c *Client) WebPushKey(ctx context.Context) (string, error) {
+	resp, err := c.api.GetWebPushKey(ctx, &notificationv1.GetWebPushKeyRequest{})
+	if err != nil {
+		return "", err
+	}
+	return resp.GetPublicKey(), nil
+}
+
+func (c *Client) ResolveChat(ctx context.Context, chatID string) (int64, error) {
+	resp, err := c.api.ResolveChat(ctx, &notificationv1.ResolveChatRequest{ChatId: chatID})
+	if err != nil {
+		return 0, err
+	}
+	return resp.GetUserId(), nil
+}
+
+func (c *Client) Preferences(ctx context.Context, userID int64) (*notificationv1.PreferencesDTO, error) {
+	resp, err := c.api.GetPreferences(ctx, &notificationv1.GetPreferencesRequest{UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetPreferences(), nil
+}
+
+func (c *Client) UpdatePreferences(ctx context.Context, req *notificationv1.UpdatePreferencesRequest) (*notificationv1.PreferencesDTO, error) {
+	resp, err := c.api.UpdatePreferences(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetPreferences(), nil
+}
+
+func (c *Client) MuteQueue(ctx context.Context, userID, queueID int64) error {
+	_, err := c.api.MuteQueue(ctx, &notificationv1.MuteQueueRequest{UserId: userID, QueueId: queueID})
+	return err
+}
+
+func (c *Client) UnmuteQueue(ctx context.Context, userID, queueID int64) error {
+	_, err := c.api.UnmuteQueue(ctx, &notificationv1.UnmuteQueueRequest{UserId: userID, QueueId: queueID})
+	return err
+}
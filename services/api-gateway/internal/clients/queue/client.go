@@ -17,8 +17,8 @@ func New(conn *grpc.ClientConn) *Client {
 	}
 }
 
-func (c *Client) List(ctx context.Context, group string) ([]*queuev1.QueueDTO, error) {
-	resp, err := c.api.ListQueues(ctx, &queuev1.ListQueuesRequest{GroupCode: group})
+func (c *Client) List(ctx context.Context, group string, requesterID int64) ([]*queuev1.QueueDTO, error) {
+	resp, err := c.api.ListQueues(ctx, &queuev1.ListQueuesRequest{GroupCode: group, RequesterId: requesterID})
 	if err != nil {
 		return nil, err
 	}
@@ -33,8 +33,8 @@ func (c *Client) Create(ctx context.Context, req *queuev1.CreateQueueRequest) (*
 	return resp.GetQueue(), nil
 }
 
-func (c *Client) Get(ctx context.Context, queueID int64, group string) (*queuev1.GetQueueResponse, error) {
-	return c.api.GetQueue(ctx, &queuev1.GetQueueRequest{QueueId: queueID, GroupCode: group})
+func (c *Client) Get(ctx context.Context, queueID, requesterID int64, group string) (*queuev1.GetQueueResponse, error) {
+	return c.api.GetQueue(ctx, &queuev1.GetQueueRequest{QueueId: queueID, GroupCode: group, RequesterId: requesterID})
 }
 
 func (c *Client) Join(ctx context.Context, queueID, userID int64, fullName string, group string, slotTime string) (int32, error) {
@@ -60,16 +60,187 @@ func (c *Client) Leave(ctx context.Context, queueID, userID int64, group string)
 	return err
 }
 
-func (c *Client) Advance(ctx context.Context, queueID, actorID int64, group string) (*queuev1.ParticipantDTO, error) {
-	resp, err := c.api.AdvanceQueue(ctx, &queuev1.AdvanceQueueRequest{
+// Advance returns the removed participant and the one called to the head; either may be unset.
+func (c *Client) Advance(ctx context.Context, queueID, actorID int64, group string, skip bool) (*queuev1.AdvanceQueueResponse, error) {
+	return c.api.AdvanceQueue(ctx, &queuev1.AdvanceQueueRequest{
 		QueueId:   queueID,
 		GroupCode: group,
 		ActorId:   actorID,
+		Skip:      skip,
+	})
+}
+
+func (c *Client) SetCallPolicy(ctx context.Context, queueID, actorID int64, group string, timeout, missShift int32) (*queuev1.QueueDTO, error) {
+	resp, err := c.api.SetCallPolicy(ctx, &queuev1.SetCallPolicyRequest{
+		QueueId:     queueID,
+		GroupCode:   group,
+		ActorId:     actorID,
+		CallTimeout: timeout,
+		MissShift:   missShift,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetQueue(), nil
+}
+
+func (c *Client) CheckIn(ctx context.Context, queueID, userID int64, group string) (*queuev1.ParticipantDTO, error) {
+	resp, err := c.api.CheckIn(ctx, &queuev1.CheckInRequest{
+		QueueId:   queueID,
+		UserId:    userID,
+		GroupCode: group,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetParticipant(), nil
+}
+
+func (c *Client) Move(ctx context.Context, queueID, userID, actorID int64, position int32, group string) ([]*queuev1.ParticipantDTO, error) {
+	resp, err := c.api.MoveParticipant(ctx, &queuev1.MoveParticipantRequest{
+		QueueId:   queueID,
+		GroupCode: group,
+		ActorId:   actorID,
+		UserId:    userID,
+		Position:  position,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetParticipants(), nil
+}
+
+func (c *Client) Swap(ctx context.Context, queueID, firstUserID, secondUserID, actorID int64, group string) ([]*queuev1.ParticipantDTO, error) {
+	resp, err := c.api.SwapParticipants(ctx, &queuev1.SwapParticipantsRequest{
+		QueueId:      queueID,
+		GroupCode:    group,
+		ActorId:      actorID,
+		FirstUserId:  firstUserID,
+		SecondUserId: secondUserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetParticipants(), nil
+}
+
+func (c *Client) Reorder(ctx context.Context, queueID, actorID int64, userIDs []int64, group string) ([]*queuev1.ParticipantDTO, error) {
+	resp, err := c.api.ReorderQueue(ctx, &queuev1.ReorderQueueRequest{
+		QueueId:   queueID,
+		GroupCode: group,
+		ActorId:   actorID,
+		UserIds:   userIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetParticipants(), nil
+}
+
+func (c *Client) SetLottery(ctx context.Context, queueID, actorID int64, group string, lotteryAt int64) (*queuev1.QueueDTO, error) {
+	resp, err := c.api.SetLottery(ctx, &queuev1.SetLotteryRequest{
+		QueueId:   queueID,
+		GroupCode: group,
+		ActorId:   actorID,
+		LotteryAt: lotteryAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetQueue(), nil
+}
+
+func (c *Client) DrawLottery(ctx context.Context, queueID, actorID int64, group string) (*queuev1.DrawLotteryResponse, error) {
+	return c.api.DrawLottery(ctx, &queuev1.DrawLotteryRequest{
+		QueueId:   queueID,
+		GroupCode: group,
+		ActorId:   actorID,
+	})
+}
+
+func (c *Client) DeferTurn(ctx context.Context, queueID, userID int64, places int32, group string) (*queuev1.ParticipantDTO, error) {
+	resp, err := c.api.DeferTurn(ctx, &queuev1.DeferTurnRequest{
+		QueueId:   queueID,
+		GroupCode: group,
+		UserId:    userID,
+		Places:    places,
 	})
 	if err != nil {
 		return nil, err
 	}
-	return resp.GetRemoved(), nil
+	return resp.GetParticipant(), nil
+}
+
+func (c *Client) SetPriority(ctx context.Context, queueID, userID, actorID int64, priority int32, group string) ([]*queuev1.ParticipantDTO, error) {
+	resp, err := c.api.SetParticipantPriority(ctx, &queuev1.SetParticipantPriorityRequest{
+		QueueId:   queueID,
+		GroupCode: group,
+		ActorId:   actorID,
+		UserId:    userID,
+		Priority:  priority,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetParticipants(), nil
+}
+
+func (c *Client) SetSwapPolicy(ctx context.Context, queueID, actorID int64, group string, allowed bool) (*queuev1.QueueDTO, error) {
+	resp, err := c.api.SetSwapPolicy(ctx, &queuev1.SetSwapPolicyRequest{
+		QueueId:   queueID,
+		GroupCode: group,
+		ActorId:   actorID,
+		Allowed:   allowed,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetQueue(), nil
+}
+
+func (c *Client) RequestSwap(ctx context.Context, queueID, userID, targetUserID int64, group string) (*queuev1.SwapRequestDTO, error) {
+	resp, err := c.api.RequestSwap(ctx, &queuev1.RequestSwapRequest{
+		QueueId:      queueID,
+		GroupCode:    group,
+		UserId:       userID,
+		TargetUserId: targetUserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetRequest(), nil
+}
+
+func (c *Client) AnswerSwap(ctx context.Context, requestID, userID int64, group string, accept bool) (*queuev1.AnswerSwapResponse, error) {
+	return c.api.AnswerSwap(ctx, &queuev1.AnswerSwapRequest{
+		RequestId: requestID,
+		GroupCode: group,
+		UserId:    userID,
+		Accept:    accept,
+	})
+}
+
+func (c *Client) CancelSwap(ctx context.Context, requestID, userID int64) (*queuev1.SwapRequestDTO, error) {
+	resp, err := c.api.CancelSwap(ctx, &queuev1.CancelSwapRequest{
+		RequestId: requestID,
+		UserId:    userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetRequest(), nil
+}
+
+func (c *Client) ListSwapRequests(ctx context.Context, queueID, userID int64, group string) ([]*queuev1.SwapRequestDTO, error) {
+	resp, err := c.api.ListSwapRequests(ctx, &queuev1.ListSwapRequestsRequest{
+		QueueId:   queueID,
+		GroupCode: group,
+		UserId:    userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetRequests(), nil
 }
 
 func (c *Client) Remove(ctx context.Context, queueID, userID, actorID int64, group string) error {
@@ -100,13 +271,14 @@ func (c *Client) Delete(ctx context.Context, queueID, actorID int64, group strin
 	return err
 }
 
-func (c *Client) Update(ctx context.Context, queueID, actorID int64, group string, title, description string) (*queuev1.QueueDTO, error) {
+func (c *Client) Update(ctx context.Context, queueID, actorID int64, group string, title, description string, notifyTop int32) (*queuev1.QueueDTO, error) {
 	resp, err := c.api.UpdateQueue(ctx, &queuev1.UpdateQueueRequest{
 		QueueId:     queueID,
 		GroupCode:   group,
 		ActorId:     actorID,
 		Title:       title,
 		Description: description,
+		NotifyTop:   notifyTop,
 	})
 	if err != nil {
 		return nil, err
@@ -128,3 +300,172 @@ func (c *Client) Add(ctx context.Context, queueID, userID, actorID int64, fullNa
 	}
 	return resp.GetPosition(), nil
 }
+
+// Watch opens a stream of queue snapshots. The stream lives until ctx is cancelled.
+func (c *Client) Watch(ctx context.Context, queueID, requesterID int64, group string) (queuev1.Queue_WatchQueueClient, error) {
+	return c.api.WatchQueue(ctx, &queuev1.WatchQueueRequest{QueueId: queueID, GroupCode: group, RequesterId: requesterID})
+}
+
+func (c *Client) Events(ctx context.Context, req *queuev1.ListQueueEventsRequest) (*queuev1.ListQueueEventsResponse, error) {
+	return c.api.ListQueueEvents(ctx, req)
+}
+
+func (c *Client) History(ctx context.Context, req *queuev1.GetQueueHistoryRequest) (*queuev1.GetQueueHistoryResponse, error) {
+	return c.api.GetQueueHistory(ctx, req)
+}
+
+func (c *Client) Members(ctx context.Context, queueID, requesterID int64, group string) ([]*queuev1.QueueMemberDTO, error) {
+	resp, err := c.api.ListMembers(ctx, &queuev1.ListMembersRequest{QueueId: queueID, GroupCode: group, RequesterId: requesterID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetMembers(), nil
+}
+
+func (c *Client) GrantRole(ctx context.Context, queueID, userID, actorID int64, role string, group string) (*queuev1.QueueMemberDTO, error) {
+	resp, err := c.api.GrantRole(ctx, &queuev1.GrantRoleRequest{
+		QueueId:   queueID,
+		GroupCode: group,
+		UserId:    userID,
+		Role:      role,
+		ActorId:   actorID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetMember(), nil
+}
+
+func (c *Client) RevokeRole(ctx context.Context, queueID, userID, actorID int64, group string) error {
+	_, err := c.api.RevokeRole(ctx, &queuev1.RevokeRoleRequest{
+		QueueId:   queueID,
+		GroupCode: group,
+		UserId:    userID,
+		ActorId:   actorID,
+	})
+	return err
+}
+
+func (c *Client) CreateGroup(ctx context.Context, req *queuev1.CreateGroupRequest) (*queuev1.GroupDTO, error) {
+	resp, err := c.api.CreateGroup(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetGroup(), nil
+}
+
+func (c *Client) Groups(ctx context.Context, userID int64) ([]*queuev1.GroupDTO, error) {
+	resp, err := c.api.ListGroups(ctx, &queuev1.ListGroupsRequest{UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetGroups(), nil
+}
+
+func (c *Client) Group(ctx context.Context, groupID, requesterID int64) (*queuev1.GroupDTO, error) {
+	resp, err := c.api.GetGroup(ctx, &queuev1.GetGroupRequest{GroupId: groupID, RequesterId: requesterID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetGroup(), nil
+}
+
+func (c *Client) JoinGroup(ctx context.Context, inviteCode string, userID int64, fullName string) (*queuev1.GroupMemberDTO, error) {
+	resp, err := c.api.JoinGroup(ctx, &queuev1.JoinGroupRequest{
+		InviteCode: inviteCode,
+		UserId:     userID,
+		UserName:   fullName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetMember(), nil
+}
+
+func (c *Client) GroupMembers(ctx context.Context, groupID, requesterID int64, status string) ([]*queuev1.GroupMemberDTO, error) {
+	resp, err := c.api.ListGroupMembers(ctx, &queuev1.ListGroupMembersRequest{
+		GroupId:     groupID,
+		RequesterId: requesterID,
+		Status:      status,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetMembers(), nil
+}
+
+func (c *Client) ReviewGroupMember(ctx context.Context, groupID, userID, actorID int64, approve bool) error {
+	_, err := c.api.ReviewGroupMember(ctx, &queuev1.ReviewGroupMemberRequest{
+		GroupId: groupID,
+		UserId:  userID,
+		ActorId: actorID,
+		Approve: approve,
+	})
+	return err
+}
+
+func (c *Client) RemoveGroupMember(ctx context.Context, groupID, userID, actorID int64) error {
+	_, err := c.api.RemoveGroupMember(ctx, &queuev1.RemoveGroupMemberRequest{
+		GroupId: groupID,
+		UserId:  userID,
+		ActorId: actorID,
+	})
+	return err
+}
+
+func (c *Client) RegenerateInvite(ctx context.Context, groupID, actorID int64) (string, error) {
+	resp, err := c.api.RegenerateInviteCode(ctx, &queuev1.RegenerateInviteCodeRequest{GroupId: groupID, ActorId: actorID})
+	if err != nil {
+		return "", err
+	}
+	return resp.GetInviteCode(), nil
+}
+
+func (c *Client) SetSlotSchedule(ctx context.Context, req *queuev1.SetSlotScheduleRequest) (*queuev1.SlotScheduleDTO, error) {
+	resp, err := c.api.SetSlotSchedule(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetSchedule(), nil
+}
+
+func (c *Client) FreeSlots(ctx context.Context, queueID, requesterID int64, group string) (*queuev1.ListFreeSlotsResponse, error) {
+	return c.api.ListFreeSlots(ctx, &queuev1.ListFreeSlotsRequest{QueueId: queueID, GroupCode: group, RequesterId: requesterID})
+}
+
+func (c *Client) CreateTemplate(ctx context.Context, ownerID int64, spec *queuev1.QueueTemplateSpec) (*queuev1.QueueTemplateDTO, error) {
+	resp, err := c.api.CreateTemplate(ctx, &queuev1.CreateTemplateRequest{OwnerId: ownerID, Spec: spec})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetTemplate(), nil
+}
+
+func (c *Client) Template(ctx context.Context, templateID, requesterID int64) (*queuev1.QueueTemplateDTO, error) {
+	resp, err := c.api.GetTemplate(ctx, &queuev1.GetTemplateRequest{TemplateId: templateID, RequesterId: requesterID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetTemplate(), nil
+}
+
+func (c *Client) Templates(ctx context.Context, requesterID int64, group string) ([]*queuev1.QueueTemplateDTO, error) {
+	resp, err := c.api.ListTemplates(ctx, &queuev1.ListTemplatesRequest{GroupCode: group, RequesterId: requesterID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetTemplates(), nil
+}
+
+func (c *Client) UpdateTemplate(ctx context.Context, templateID, actorID int64, spec *queuev1.QueueTemplateSpec) (*queuev1.QueueTemplateDTO, error) {
+	resp, err := c.api.UpdateTemplate(ctx, &queuev1.UpdateTemplateRequest{TemplateId: templateID, ActorId: actorID, Spec: spec})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetTemplate(), nil
+}
+
+func (c *Client) DeleteTemplate(ctx context.Context, templateID, actorID int64) error {
+	_, err := c.api.DeleteTemplate(ctx, &queuev1.DeleteTemplateRequest{TemplateId: templateID, ActorId: actorID})
+	return err
+}
@@ -0,0 +1,124 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const apiURL = "https://api.telegram.org"
+
+// SecretHeader carries the secret given to setWebhook in every webhook request.
+const SecretHeader = "X-Telegram-Bot-Api-Secret-Token"
+
+// Client calls the Telegram Bot API on behalf of the bot.
+type Client struct {
+	token      string
+	timeout    time.Duration
+	httpClient *http.Client
+	// pollClient has no timeout of its own: getUpdates holds the request
+	// open, so the deadline comes from the context.
+	pollClient *http.Client
+}
+
+func New(token string, timeout time.Duration) *Client {
+	return &Client{
+		token:      token,
+		timeout:    timeout,
+		httpClient: &http.Client{Timeout: timeout},
+		pollClient: &http.Client{},
+	}
+}
+
+// Enabled tells whether a bot token is configured.
+func (c *Client) Enabled() bool {
+	return c.token != ""
+}
+
+func (c *Client) SendMessage(ctx context.Context, chatID int64, text string, markup *InlineKeyboardMarkup) error {
+	return c.call(ctx, "sendMessage", sendMessageRequest{ChatID: chatID, Text: text, ReplyMarkup: markup}, nil)
+}
+
+// AnswerCallbackQuery stops the loading indicator on the pressed button,
+// optionally showing text as a toast.
+func (c *Client) AnswerCallbackQuery(ctx context.Context, id, text string) error {
+	return c.call(ctx, "answerCallbackQuery", answerCallbackRequest{CallbackQueryID: id, Text: text}, nil)
+}
+
+// SetWebhook makes Telegram push updates to url, signing them with secret.
+func (c *Client) SetWebhook(ctx context.Context, url, secret string) error {
+	return c.call(ctx, "setWebhook", setWebhookRequest{
+		URL:            url,
+		SecretToken:    secret,
+		AllowedUpdates: allowedUpdates,
+	}, nil)
+}
+
+// DeleteWebhook switches the bot back to getUpdates; pending updates are kept.
+func (c *Client) DeleteWebhook(ctx context.Context) error {
+	return c.call(ctx, "deleteWebhook", struct{}{}, nil)
+}
+
+// GetUpdates waits up to timeout for updates with ids from offset on.
+func (c *Client) GetUpdates(ctx context.Context, offset int64, timeout time.Duration) ([]Update, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout+c.timeout)
+	defer cancel()
+
+	var updates []Update
+	err := c.do(ctx, c.pollClient, "getUpdates", getUpdatesRequest{
+		Offset:         offset,
+		Timeout:        int(timeout.Seconds()),
+		AllowedUpdates: allowedUpdates,
+	}, &updates)
+	if err != nil {
+		return nil, err
+	}
+	return updates, nil
+}
+
+type apiResponse struct {
+	OK          bool            `json:"ok"`
+	Description string          `json:"description"`
+	Result      json.RawMessage `json:"result"`
+}
+
+func (c *Client) call(ctx context.Context, method string, params, result any) error {
+	return c.do(ctx, c.httpClient, method, params, result)
+}
+
+func (c *Client) do(ctx context.Context, httpClient *http.Client, method string, params, result any) error {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return fmt.Errorf("encode %s: %w", method, err)
+	}
+	endpoint := fmt.Sprintf("%s/bot%s/%s", apiURL, c.token, method)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("send telegram request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var out apiResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return fmt.Errorf("decode %s response: %w", method, err)
+	}
+	if !out.OK {
+		return fmt.Errorf("telegram %s failed with status %d: %s", method, resp.StatusCode, out.Description)
+	}
+	if result != nil {
+		if err := json.Unmarshal(out.Result, result); err != nil {
+			return fmt.Errorf("decode %s result: %w", method, err)
+		}
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package middleware
 
 import (
+	"context"
+	"errors"
 	"strings"
 
 	"github.com/gofiber/fiber/v2"
@@ -12,9 +14,15 @@ type UserContext struct {
 	Email string
 	AppID int
 	Name  string
+	Token string // raw access token, needed to revoke it on logout
 }
 
-func Auth(secret string) fiber.Handler {
+// RevocationChecker reports whether an access token (by jti) was revoked before its expiry.
+type RevocationChecker interface {
+	IsTokenRevoked(ctx context.Context, tokenID string) (bool, error)
+}
+
+func Auth(keys *KeySet, revocations RevocationChecker) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		authHeader := c.Get("Authorization")
 		if authHeader == "" {
@@ -26,11 +34,15 @@ func Auth(secret string) fiber.Handler {
 		}
 
 		token, err := jwt.Parse(parts[1], func(t *jwt.Token) (interface{}, error) {
-			if t.Method.Alg() != jwt.SigningMethodHS256.Name {
-				return nil, fiber.NewError(fiber.StatusUnauthorized, "unexpected signing method")
+			kid, _ := t.Header["kid"].(string)
+			if kid == "" {
+				return nil, ErrUnknownKey
 			}
-			return []byte(secret), nil
-		})
+			return keys.Key(c.Context(), kid)
+		}, jwt.WithValidMethods([]string{jwt.SigningMethodEdDSA.Alg()}))
+		if errors.Is(err, ErrKeysUnavailable) {
+			return fiber.NewError(fiber.StatusServiceUnavailable, "failed to validate token")
+		}
 		if err != nil || !token.Valid {
 			return fiber.NewError(fiber.StatusUnauthorized, "invalid token")
 		}
@@ -40,6 +52,17 @@ func Auth(secret string) fiber.Handler {
 			return fiber.NewError(fiber.StatusUnauthorized, "invalid claims")
 		}
 
+		// Tokens issued before revocation support carry no jti and simply expire.
+		if jti, _ := claims["jti"].(string); jti != "" {
+			revoked, err := revocations.IsTokenRevoked(c.Context(), jti)
+			if err != nil {
+				return fiber.NewError(fiber.StatusServiceUnavailable, "failed to validate token")
+			}
+			if revoked {
+				return fiber.NewError(fiber.StatusUnauthorized, "token revoked")
+			}
+		}
+
 		uid, _ := claims["uid"].(float64)
 		email, _ := claims["email"].(string)
 		appID, _ := claims["app_id"].(float64)
@@ -50,6 +73,7 @@ func Auth(secret string) fiber.Handler {
 			Email: email,
 			AppID: int(appID),
 			Name:  name,
+			Token: parts[1],
 		})
 
 		return c.Next()
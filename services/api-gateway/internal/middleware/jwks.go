@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	authv1 "github.com/s1lentmol/q-flow-backend/protos/gen/go/auth"
+)
+
+// minRefetchInterval throttles refetches, successful or not, so garbage
+// tokens or an auth service that is down can't make every request wait for
+// another fetch.
+const minRefetchInterval = 30 * time.Second
+
+var (
+	ErrUnknownKey      = errors.New("unknown signing key")
+	ErrKeysUnavailable = errors.New("signing keys unavailable")
+)
+
+// JWKSFetcher loads the current public keys from the auth service.
+type JWKSFetcher interface {
+	JWKS(ctx context.Context) ([]*authv1.JWK, error)
+}
+
+// KeySet caches the auth service's JWKS. It is refreshed after ttl, or earlier
+// when a token names a key we haven't seen yet (the auth service rotated).
+type KeySet struct {
+	fetcher JWKSFetcher
+	ttl     time.Duration
+
+	mu        sync.Mutex
+	jwks      []*authv1.JWK
+	keys      map[string]ed25519.PublicKey
+	fetchedAt time.Time
+	// lastAttempt is when a fetch was last started and fetchErr how the last
+	// one failed, nil after a success.
+	lastAttempt time.Time
+	fetchErr    error
+}
+
+func NewKeySet(fetcher JWKSFetcher, ttl time.Duration) *KeySet {
+	return &KeySet{
+		fetcher: fetcher,
+		ttl:     ttl,
+	}
+}
+
+// Key returns the public key for kid.
+func (ks *KeySet) Key(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	key, ok := ks.keys[kid]
+	stale := time.Since(ks.fetchedAt) > ks.ttl
+	if ok && !stale {
+		return key, nil
+	}
+	if time.Since(ks.lastAttempt) > minRefetchInterval {
+		ks.refresh(ctx)
+	}
+
+	if key, ok := ks.keys[kid]; ok {
+		return key, nil
+	}
+	// The auth service is down: keys we already know are still trusted, but
+	// an unknown kid may be a key we failed to fetch.
+	if ks.fetchErr != nil {
+		return nil, ks.fetchErr
+	}
+	return nil, ErrUnknownKey
+}
+
+// JWKS returns the cached key set, refreshing it if stale.
+func (ks *KeySet) JWKS(ctx context.Context) ([]*authv1.JWK, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	if (ks.jwks == nil || time.Since(ks.fetchedAt) > ks.ttl) && time.Since(ks.lastAttempt) > minRefetchInterval {
+		ks.refresh(ctx)
+	}
+	if ks.jwks == nil {
+		if ks.fetchErr != nil {
+			return nil, ks.fetchErr
+		}
+		return nil, ErrKeysUnavailable
+	}
+	return ks.jwks, nil
+}
+
+// refresh fetches the keys; it is recorded as an attempt before the call, so
+// a fetch that fails or hangs is not repeated until minRefetchInterval passes.
+// A failure is kept in fetchErr.
+func (ks *KeySet) refresh(ctx context.Context) {
+	ks.lastAttempt = time.Now()
+	jwks, err := ks.fetcher.JWKS(ctx)
+	if err != nil {
+		ks.fetchErr = fmt.Errorf("%w: %v", ErrKeysUnavailable, err)
+		return
+	}
+
+	keys := make(map[string]ed25519.PublicKey, len(jwks))
+	for _, jwk := range jwks {
+		if jwk.GetKty() != "OKP" || jwk.GetCrv() != "Ed25519" {
+			continue
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.GetX())
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			continue
+		}
+		keys[jwk.GetKid()] = ed25519.PublicKey(x)
+	}
+
+	ks.jwks = jwks
+	ks.keys = keys
+	ks.fetchedAt = time.Now()
+	ks.fetchErr = nil
+}
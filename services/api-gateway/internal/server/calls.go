@@ -0,0 +1,60 @@
+package server
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/s1lentmol/q-flow-backend/services/api-gateway/internal/middleware"
+)
+
+type callPolicyReq struct {
+	GroupCode   string `json:"group_code"`
+	CallTimeout int32  `json:"call_timeout" validate:"gte=0,lte=3600"`
+	MissShift   int32  `json:"miss_shift" validate:"gte=0,lte=50"`
+}
+
+func (s *Server) handleSetCallPolicy(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+	var req callPolicyReq
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid body")
+	}
+	if err := s.validator.Struct(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	dto, err := s.queue.SetCallPolicy(c.Context(), id, user.ID, req.GroupCode, req.CallTimeout, req.MissShift)
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": dto})
+}
+
+func (s *Server) handleCheckIn(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+	var req groupReq
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid body")
+	}
+	if err := s.validator.Struct(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	dto, err := s.queue.CheckIn(c.Context(), id, user.ID, req.GroupCode)
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": dto})
+}
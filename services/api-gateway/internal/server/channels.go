@@ -0,0 +1,77 @@
+package server
+
+import (
+	"github.com/gofiber/fiber/v2"
+	notificationv1 "github.com/s1lentmol/q-flow-backend/protos/gen/go/notification"
+	"github.com/s1lentmol/q-flow-backend/services/api-gateway/internal/middleware"
+)
+
+type channelReq struct {
+	Address string `json:"address"`
+	Secret  string `json:"secret"`
+	P256dh  string `json:"p256dh"`
+	Auth    string `json:"auth"`
+	// Enabled defaults to true.
+	Enabled *bool `json:"enabled"`
+}
+
+func (s *Server) handleListChannels(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	channels, err := s.notif.Channels(c.Context(), user.ID)
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": channels})
+}
+
+func (s *Server) handleSetChannel(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	var req channelReq
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid body")
+	}
+	enabled := req.Enabled == nil || *req.Enabled
+
+	channel, err := s.notif.SetChannel(c.Context(), &notificationv1.SetChannelRequest{
+		UserId:  user.ID,
+		Channel: c.Params("channel"),
+		Address: req.Address,
+		Secret:  req.Secret,
+		P256Dh:  req.P256dh,
+		Auth:    req.Auth,
+		Enabled: enabled,
+	})
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": channel})
+}
+
+func (s *Server) handleDeleteChannel(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	if err := s.notif.DeleteChannel(c.Context(), user.ID, c.Params("channel")); err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": "ok"})
+}
+
+func (s *Server) handleWebPushKey(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	key, err := s.notif.WebPushKey(c.Context())
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": fiber.Map{"public_key": key}})
+}
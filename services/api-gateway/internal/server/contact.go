@@ -0,0 +1,40 @@
+package server
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/s1lentmol/q-flow-backend/services/api-gateway/internal/middleware"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// handleGetContact reports whether the caller's Telegram is linked; a missing
+// contact is a normal state for the profile page, not an error.
+func (s *Server) handleGetContact(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	contact, err := s.notif.Contact(c.Context(), user.ID)
+	if status.Code(err) == codes.NotFound {
+		return c.JSON(fiber.Map{"data": fiber.Map{"linked": false}})
+	}
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": fiber.Map{
+		"linked":            contact.GetChatLinked(),
+		"telegram_username": contact.GetTelegramUsername(),
+		"updated_at":        contact.GetUpdatedAt(),
+	}})
+}
+
+func (s *Server) handleUnlinkContact(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	if err := s.notif.UnlinkContact(c.Context(), user.ID); err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": "ok"})
+}
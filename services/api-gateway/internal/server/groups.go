@@ -0,0 +1,171 @@
+package server
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	queuev1 "github.com/s1lentmol/q-flow-backend/protos/gen/go/queue"
+	"github.com/s1lentmol/q-flow-backend/services/api-gateway/internal/middleware"
+)
+
+type (
+	createGroupReq struct {
+		Code            string `json:"code" validate:"required,max=64"`
+		Name            string `json:"name" validate:"required"`
+		RequireApproval bool   `json:"require_approval"`
+	}
+
+	joinGroupReq struct {
+		InviteCode string `json:"invite_code" validate:"required"`
+	}
+)
+
+func (s *Server) handleCreateGroup(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	var req createGroupReq
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid body")
+	}
+	if err := s.validator.Struct(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	group, err := s.queue.CreateGroup(c.Context(), &queuev1.CreateGroupRequest{
+		Code:            req.Code,
+		Name:            req.Name,
+		RequireApproval: req.RequireApproval,
+		OwnerId:         user.ID,
+		OwnerName:       user.Name,
+	})
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{"data": group})
+}
+
+func (s *Server) handleListGroups(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	groups, err := s.queue.Groups(c.Context(), user.ID)
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": groups})
+}
+
+func (s *Server) handleGetGroup(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+	group, err := s.queue.Group(c.Context(), id, user.ID)
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": group})
+}
+
+func (s *Server) handleJoinGroup(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	var req joinGroupReq
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid body")
+	}
+	if err := s.validator.Struct(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	member, err := s.queue.JoinGroup(c.Context(), req.InviteCode, user.ID, user.Name)
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": member})
+}
+
+func (s *Server) handleListGroupMembers(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+	members, err := s.queue.GroupMembers(c.Context(), id, user.ID, c.Query("status"))
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": members})
+}
+
+func (s *Server) handleApproveGroupMember(c *fiber.Ctx) error {
+	return s.reviewGroupMember(c, true)
+}
+
+func (s *Server) handleRejectGroupMember(c *fiber.Ctx) error {
+	return s.reviewGroupMember(c, false)
+}
+
+func (s *Server) reviewGroupMember(c *fiber.Ctx, approve bool) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+	userID, err := strconv.ParseInt(c.Params("userId"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid user id")
+	}
+	if err := s.queue.ReviewGroupMember(c.Context(), id, userID, user.ID, approve); err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": "ok"})
+}
+
+func (s *Server) handleRemoveGroupMember(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+	userID, err := strconv.ParseInt(c.Params("userId"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid user id")
+	}
+	if err := s.queue.RemoveGroupMember(c.Context(), id, userID, user.ID); err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": "ok"})
+}
+
+func (s *Server) handleRegenerateInvite(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+	invite, err := s.queue.RegenerateInvite(c.Context(), id, user.ID)
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": fiber.Map{"invite_code": invite}})
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	notificationv1 "github.com/s1lentmol/q-flow-backend/protos/gen/go/notification"
+	"github.com/s1lentmol/q-flow-backend/services/api-gateway/internal/middleware"
+)
+
+func (s *Server) handleListNotifications(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+
+	req := &notificationv1.ListNotificationsRequest{
+		UserId: user.ID,
+		Status: c.Query("status"),
+	}
+	var err error
+	if req.BeforeId, err = queryInt64(c, "before_id"); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid before_id")
+	}
+	limit, err := queryInt64(c, "limit")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid limit")
+	}
+	req.Limit = int32(limit)
+
+	resp, err := s.notif.Notifications(c.Context(), req)
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": resp})
+}
+
+func (s *Server) handleGetNotification(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+	notification, err := s.notif.Notification(c.Context(), id, user.ID)
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": notification})
+}
@@ -0,0 +1,124 @@
+package server
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/s1lentmol/q-flow-backend/services/api-gateway/internal/middleware"
+)
+
+type (
+	moveReq struct {
+		GroupCode string `json:"group_code"`
+		UserID    int64  `json:"user_id" validate:"required,gt=0"`
+		Position  int32  `json:"position" validate:"required,gt=0"`
+	}
+
+	swapReq struct {
+		GroupCode    string `json:"group_code"`
+		FirstUserID  int64  `json:"first_user_id" validate:"required,gt=0"`
+		SecondUserID int64  `json:"second_user_id" validate:"required,gt=0,nefield=FirstUserID"`
+	}
+
+	reorderReq struct {
+		GroupCode string  `json:"group_code"`
+		UserIDs   []int64 `json:"user_ids" validate:"required,unique,dive,gt=0"`
+	}
+
+	deferReq struct {
+		GroupCode string `json:"group_code"`
+		Places    int32  `json:"places" validate:"required,gte=1,lte=10"`
+	}
+)
+
+func (s *Server) handleMoveParticipant(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+	var req moveReq
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid body")
+	}
+	if err := s.validator.Struct(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	list, err := s.queue.Move(c.Context(), id, req.UserID, user.ID, req.Position, req.GroupCode)
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": list})
+}
+
+func (s *Server) handleSwapParticipants(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+	var req swapReq
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid body")
+	}
+	if err := s.validator.Struct(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	list, err := s.queue.Swap(c.Context(), id, req.FirstUserID, req.SecondUserID, user.ID, req.GroupCode)
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": list})
+}
+
+func (s *Server) handleReorderQueue(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+	var req reorderReq
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid body")
+	}
+	if err := s.validator.Struct(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	list, err := s.queue.Reorder(c.Context(), id, user.ID, req.UserIDs, req.GroupCode)
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": list})
+}
+
+func (s *Server) handleDeferTurn(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+	var req deferReq
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid body")
+	}
+	if err := s.validator.Struct(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	dto, err := s.queue.DeferTurn(c.Context(), id, user.ID, req.Places, req.GroupCode)
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": dto})
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	notificationv1 "github.com/s1lentmol/q-flow-backend/protos/gen/go/notification"
+	"github.com/s1lentmol/q-flow-backend/services/api-gateway/internal/middleware"
+)
+
+// preferencesReq replaces all settings; omitted fields get the defaults.
+type preferencesReq struct {
+	Locale        string   `json:"locale"`
+	DisabledKinds []string `json:"disabled_kinds"`
+	NotifyTop     int32    `json:"notify_top" validate:"gte=0,lte=50"`
+	QuietFrom     string   `json:"quiet_from"`
+	QuietTo       string   `json:"quiet_to"`
+	Timezone      string   `json:"timezone"`
+}
+
+func (s *Server) handleGetPreferences(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	prefs, err := s.notif.Preferences(c.Context(), user.ID)
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": prefs})
+}
+
+func (s *Server) handleUpdatePreferences(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	var req preferencesReq
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid body")
+	}
+	if err := s.validator.Struct(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	prefs, err := s.notif.UpdatePreferences(c.Context(), &notificationv1.UpdatePreferencesRequest{
+		UserId:        user.ID,
+		Locale:        req.Locale,
+		DisabledKinds: req.DisabledKinds,
+		NotifyTop:     req.NotifyTop,
+		QuietFrom:     req.QuietFrom,
+		QuietTo:       req.QuietTo,
+		Timezone:      req.Timezone,
+	})
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": prefs})
+}
+
+func (s *Server) handleMuteQueue(c *fiber.Ctx) error {
+	return s.setQueueMuted(c, true)
+}
+
+func (s *Server) handleUnmuteQueue(c *fiber.Ctx) error {
+	return s.setQueueMuted(c, false)
+}
+
+func (s *Server) setQueueMuted(c *fiber.Ctx, muted bool) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	queueID, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+
+	if muted {
+		err = s.notif.MuteQueue(c.Context(), user.ID, queueID)
+	} else {
+		err = s.notif.UnmuteQueue(c.Context(), user.ID, queueID)
+	}
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": "ok"})
+}
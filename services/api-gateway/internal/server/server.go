@@ -1,13 +1,15 @@
 package server
 
 import (
+	"bufio"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"strconv"
-	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
@@ -15,9 +17,11 @@ import (
 	"github.com/gofiber/fiber/v2/middleware/logger"
 	"github.com/gofiber/fiber/v2/middleware/recover"
 	queuev1 "github.com/s1lentmol/q-flow-backend/protos/gen/go/queue"
+	"github.com/s1lentmol/q-flow-backend/services/api-gateway/internal/bot"
 	authclient "github.com/s1lentmol/q-flow-backend/services/api-gateway/internal/clients/auth"
 	notifyclient "github.com/s1lentmol/q-flow-backend/services/api-gateway/internal/clients/notification"
 	queueclient "github.com/s1lentmol/q-flow-backend/services/api-gateway/internal/clients/queue"
+	"github.com/s1lentmol/q-flow-backend/services/api-gateway/internal/clients/telegram"
 	"github.com/s1lentmol/q-flow-backend/services/api-gateway/internal/middleware"
 	"google.golang.org/grpc/status"
 )
@@ -29,10 +33,12 @@ type Server struct {
 	notif     *notifyclient.Client
 	log       *slog.Logger
 	validator *validator.Validate
+	keys      *middleware.KeySet
+	bot       *bot.Bot
 	appID     int
 }
 
-func New(log *slog.Logger, auth *authclient.Client, queue *queueclient.Client, notif *notifyclient.Client, appSecret string, appID int) *Server {
+func New(log *slog.Logger, auth *authclient.Client, queue *queueclient.Client, notif *notifyclient.Client, keys *middleware.KeySet, bot *bot.Bot, appID int) *Server {
 	s := &Server{
 		app: fiber.New(fiber.Config{
 			AppName: "qflow-api-gateway",
@@ -42,6 +48,8 @@ func New(log *slog.Logger, auth *authclient.Client, queue *queueclient.Client, n
 		notif:     notif,
 		log:       log,
 		validator: validator.New(validator.WithRequiredStructEnabled()),
+		keys:      keys,
+		bot:       bot,
 		appID:     appID,
 	}
 
@@ -49,33 +57,88 @@ func New(log *slog.Logger, auth *authclient.Client, queue *queueclient.Client, n
 	s.app.Use(logger.New())
 	s.app.Use(cors.New())
 
-	s.routes(appSecret)
+	s.routes()
 
 	return s
 }
 
-func (s *Server) routes(appSecret string) {
+func (s *Server) routes() {
 	s.app.Post("/auth/register", s.handleRegister)
 	s.app.Post("/auth/login", s.handleLogin)
+	s.app.Post("/auth/refresh", s.handleRefresh)
 	s.app.Post("/telegram/webhook", s.handleTelegramWebhook)
+	s.app.Get("/.well-known/jwks.json", s.handleJWKS)
 
 	// Protected routes
-	authMW := middleware.Auth(appSecret)
+	authMW := middleware.Auth(s.keys, s.auth)
 
+	s.app.Post("/auth/logout", authMW, s.handleLogout)
+
+	s.app.Get("/profile/contact", authMW, s.handleGetContact)
 	s.app.Post("/profile/contact", authMW, s.handleSetContact)
+	s.app.Delete("/profile/contact", authMW, s.handleUnlinkContact)
 	s.app.Post("/profile/contact/link", authMW, s.handleCreateLinkToken)
+	s.app.Get("/profile/preferences", authMW, s.handleGetPreferences)
+	s.app.Put("/profile/preferences", authMW, s.handleUpdatePreferences)
+	s.app.Get("/profile/notifications", authMW, s.handleListNotifications)
+	s.app.Get("/profile/notifications/:id", authMW, s.handleGetNotification)
+	s.app.Get("/profile/channels", authMW, s.handleListChannels)
+	s.app.Get("/profile/channels/webpush/key", authMW, s.handleWebPushKey)
+	s.app.Put("/profile/channels/:channel", authMW, s.handleSetChannel)
+	s.app.Delete("/profile/channels/:channel", authMW, s.handleDeleteChannel)
 
 	s.app.Get("/queues", authMW, s.handleListQueues)
 	s.app.Post("/queues", authMW, s.handleCreateQueue)
 	s.app.Get("/queues/:id", authMW, s.handleGetQueue)
+	s.app.Get("/queues/:id/stream", authMW, s.handleWatchQueue)
+	s.app.Get("/queues/:id/events", authMW, s.handleListQueueEvents)
+	s.app.Get("/queues/:id/history", authMW, s.handleGetQueueHistory)
 	s.app.Put("/queues/:id", authMW, s.handleUpdateQueue)
 	s.app.Post("/queues/:id/join", authMW, s.handleJoinQueue)
 	s.app.Post("/queues/:id/add", authMW, s.handleAddParticipant)
 	s.app.Post("/queues/:id/leave", authMW, s.handleLeaveQueue)
 	s.app.Post("/queues/:id/advance", authMW, s.handleAdvanceQueue)
+	s.app.Post("/queues/:id/checkin", authMW, s.handleCheckIn)
+	s.app.Put("/queues/:id/calls", authMW, s.handleSetCallPolicy)
 	s.app.Post("/queues/:id/remove", authMW, s.handleRemoveParticipant)
+	s.app.Post("/queues/:id/move", authMW, s.handleMoveParticipant)
+	s.app.Post("/queues/:id/swap", authMW, s.handleSwapParticipants)
+	s.app.Put("/queues/:id/order", authMW, s.handleReorderQueue)
+	s.app.Post("/queues/:id/defer", authMW, s.handleDeferTurn)
+	s.app.Put("/queues/:id/lottery", authMW, s.handleSetLottery)
+	s.app.Post("/queues/:id/lottery/draw", authMW, s.handleDrawLottery)
+	s.app.Put("/queues/:id/participants/:userId/priority", authMW, s.handleSetPriority)
+	s.app.Put("/queues/:id/swap-policy", authMW, s.handleSetSwapPolicy)
+	s.app.Get("/queues/:id/swap-requests", authMW, s.handleListSwapRequests)
+	s.app.Post("/queues/:id/swap-requests", authMW, s.handleRequestSwap)
+	s.app.Post("/swap-requests/:id/accept", authMW, s.handleAcceptSwap)
+	s.app.Post("/swap-requests/:id/decline", authMW, s.handleDeclineSwap)
+	s.app.Delete("/swap-requests/:id", authMW, s.handleCancelSwap)
 	s.app.Post("/queues/:id/archive", authMW, s.handleArchiveQueue)
 	s.app.Delete("/queues/:id", authMW, s.handleDeleteQueue)
+	s.app.Get("/queues/:id/members", authMW, s.handleListMembers)
+	s.app.Put("/queues/:id/members/:userId", authMW, s.handleGrantRole)
+	s.app.Delete("/queues/:id/members/:userId", authMW, s.handleRevokeRole)
+	s.app.Get("/queues/:id/slots", authMW, s.handleListFreeSlots)
+	s.app.Put("/queues/:id/slots", authMW, s.handleSetSlotSchedule)
+	s.app.Post("/queues/:id/mute", authMW, s.handleMuteQueue)
+	s.app.Delete("/queues/:id/mute", authMW, s.handleUnmuteQueue)
+
+	s.app.Get("/groups", authMW, s.handleListGroups)
+	s.app.Post("/groups", authMW, s.handleCreateGroup)
+	s.app.Post("/groups/join", authMW, s.handleJoinGroup)
+	s.app.Get("/groups/:id", authMW, s.handleGetGroup)
+	s.app.Get("/groups/:id/members", authMW, s.handleListGroupMembers)
+	s.app.Post("/groups/:id/members/:userId/approve", authMW, s.handleApproveGroupMember)
+	s.app.Post("/groups/:id/members/:userId/reject", authMW, s.handleRejectGroupMember)
+	s.app.Delete("/groups/:id/members/:userId", authMW, s.handleRemoveGroupMember)
+	s.app.Post("/groups/:id/invite", authMW, s.handleRegenerateInvite)
+
+	s.app.Get("/templates", authMW, s.handleListTemplates)
+	s.app.Post("/templates", authMW, s.handleCreateTemplate)
+	s.app.Get("/templates/:id", authMW, s.handleGetTemplate)
+	s.app.Put("/templates/:id", authMW, s.handleUpdateTemplate)
+	s.app.Delete("/templates/:id", authMW, s.handleDeleteTemplate)
 }
 
 func (s *Server) Run(addr string) error {
@@ -98,6 +161,14 @@ type (
 		Password string `json:"password" validate:"required"`
 	}
 
+	refreshReq struct {
+		RefreshToken string `json:"refresh_token" validate:"required"`
+	}
+
+	logoutReq struct {
+		RefreshToken string `json:"refresh_token"`
+	}
+
 	contactReq struct {
 		TelegramUsername string `json:"telegram_username"`
 		ChatID           string `json:"chat_id"`
@@ -108,30 +179,45 @@ type (
 		Description string `json:"description"`
 		Mode        string `json:"mode" validate:"required"`
 		GroupCode   string `json:"group_code" validate:"required"`
+		OpensAt     int64  `json:"opens_at" validate:"gte=0"`
+		ClosesAt    int64  `json:"closes_at" validate:"gte=0"`
+		NotifyTop   int32  `json:"notify_top" validate:"gte=0,lte=50"`
+		LotteryAt   int64  `json:"lottery_at" validate:"gte=0"`
 	}
 
 	updateQueueReq struct {
 		Title       string `json:"title"`
 		Description string `json:"description"`
-		GroupCode   string `json:"group_code" validate:"required"`
+		GroupCode   string `json:"group_code"`
+		NotifyTop   int32  `json:"notify_top" validate:"gte=0,lte=50"`
 	}
 
 	groupReq struct {
-		GroupCode string `json:"group_code" validate:"required"`
+		GroupCode string `json:"group_code"`
+	}
+
+	advanceReq struct {
+		GroupCode string `json:"group_code"`
+		Skip      bool   `json:"skip"`
 	}
 
 	joinReq struct {
-		GroupCode string `json:"group_code" validate:"required"`
+		GroupCode string `json:"group_code"`
 		SlotTime  string `json:"slot_time"`
 	}
 
 	removeReq struct {
-		GroupCode string `json:"group_code" validate:"required"`
+		GroupCode string `json:"group_code"`
 		UserID    int64  `json:"user_id" validate:"required,gt=0"`
 	}
 
+	roleReq struct {
+		GroupCode string `json:"group_code"`
+		Role      string `json:"role" validate:"required,oneof=owner moderator viewer"`
+	}
+
 	addReq struct {
-		GroupCode string `json:"group_code" validate:"required"`
+		GroupCode string `json:"group_code"`
 		UserID    int64  `json:"user_id" validate:"required,gt=0"`
 		UserName  string `json:"user_name"`
 		SlotTime  string `json:"slot_time"`
@@ -140,18 +226,6 @@ type (
 	linkReq struct {
 		TelegramUsername string `json:"telegram_username"`
 	}
-
-	telegramUpdate struct {
-		Message *struct {
-			Text string `json:"text"`
-			Chat struct {
-				ID int64 `json:"id"`
-			} `json:"chat"`
-			From *struct {
-				Username string `json:"username"`
-			} `json:"from"`
-		} `json:"message"`
-	}
 )
 
 func (s *Server) handleRegister(c *fiber.Ctx) error {
@@ -179,11 +253,72 @@ func (s *Server) handleLogin(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 
-	token, err := s.auth.Login(c.Context(), req.Email, req.Password, int32(s.appID))
+	resp, err := s.auth.Login(c.Context(), req.Email, req.Password, int32(s.appID))
 	if err != nil {
 		return s.mapError(err)
 	}
-	return c.JSON(fiber.Map{"data": fiber.Map{"token": token}})
+	return c.JSON(fiber.Map{"data": fiber.Map{
+		"token":         resp.GetToken(),
+		"refresh_token": resp.GetRefreshToken(),
+		"expires_at":    resp.GetExpiresAt(),
+	}})
+}
+
+// handleJWKS publishes the token verification keys in the standard JWKS shape, not wrapped in "data".
+func (s *Server) handleJWKS(c *fiber.Ctx) error {
+	jwks, err := s.keys.JWKS(c.Context())
+	if err != nil {
+		return s.mapError(err)
+	}
+
+	keys := make([]fiber.Map, 0, len(jwks))
+	for _, k := range jwks {
+		keys = append(keys, fiber.Map{
+			"kty": k.GetKty(),
+			"crv": k.GetCrv(),
+			"x":   k.GetX(),
+			"kid": k.GetKid(),
+			"alg": k.GetAlg(),
+			"use": k.GetUse(),
+		})
+	}
+	c.Set(fiber.HeaderCacheControl, "public, max-age=300")
+	return c.JSON(fiber.Map{"keys": keys})
+}
+
+func (s *Server) handleRefresh(c *fiber.Ctx) error {
+	var req refreshReq
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid body")
+	}
+	if err := s.validator.Struct(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	resp, err := s.auth.Refresh(c.Context(), req.RefreshToken, int32(s.appID))
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": fiber.Map{
+		"token":         resp.GetToken(),
+		"refresh_token": resp.GetRefreshToken(),
+		"expires_at":    resp.GetExpiresAt(),
+	}})
+}
+
+func (s *Server) handleLogout(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	var req logoutReq
+	if err := c.BodyParser(&req); err != nil && !errors.Is(err, io.EOF) {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid body")
+	}
+	if err := s.auth.Logout(c.Context(), user.Token, req.RefreshToken); err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": "ok"})
 }
 
 func (s *Server) handleSetContact(c *fiber.Ctx) error {
@@ -210,42 +345,40 @@ func (s *Server) handleCreateLinkToken(c *fiber.Ctx) error {
 	if err := c.BodyParser(&req); err != nil && !errors.Is(err, io.EOF) {
 		return fiber.NewError(fiber.StatusBadRequest, "invalid body")
 	}
-	token, link, err := s.notif.CreateLinkToken(c.Context(), user.ID, req.TelegramUsername)
+	resp, err := s.notif.CreateLinkToken(c.Context(), user.ID, req.TelegramUsername)
 	if err != nil {
 		return s.mapError(err)
 	}
-	return c.JSON(fiber.Map{"data": fiber.Map{"token": token, "link": link}})
+	return c.JSON(fiber.Map{"data": fiber.Map{
+		"token":      resp.GetToken(),
+		"link":       resp.GetLink(),
+		"expires_at": resp.GetExpiresAt(),
+	}})
 }
 
 func (s *Server) handleTelegramWebhook(c *fiber.Ctx) error {
-	var upd telegramUpdate
+	if !s.bot.Authorized(c.Get(telegram.SecretHeader)) {
+		return fiber.NewError(fiber.StatusUnauthorized, "invalid secret token")
+	}
+	var upd telegram.Update
 	if err := c.BodyParser(&upd); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, "invalid update")
 	}
-	if upd.Message == nil {
-		return c.SendStatus(fiber.StatusOK)
-	}
-	token := extractStartToken(upd.Message.Text)
-	if token == "" {
-		return c.SendStatus(fiber.StatusOK)
-	}
-	chatID := fmt.Sprintf("%d", upd.Message.Chat.ID)
-	username := ""
-	if upd.Message.From != nil {
-		username = upd.Message.From.Username
-	}
-	if err := s.notif.BindByToken(c.Context(), token, chatID, username); err != nil {
-		s.log.Warn("failed to bind telegram token", slog.Any("err", err))
+	// Telegram retries non-2xx answers, so failures are only logged.
+	if err := s.bot.HandleUpdate(c.Context(), upd); err != nil {
+		s.log.Warn("failed to handle telegram update", slog.Any("err", err))
 	}
 	return c.SendStatus(fiber.StatusOK)
 }
 
 func (s *Server) handleListQueues(c *fiber.Ctx) error {
-	group := c.Query("group")
-	if group == "" {
-		return fiber.NewError(fiber.StatusBadRequest, "group is required")
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
 	}
-	queues, err := s.queue.List(c.Context(), group)
+	// The group is an optional hint; the queue service checks membership.
+	group := c.Query("group")
+	queues, err := s.queue.List(c.Context(), group, user.ID)
 	if err != nil {
 		return s.mapError(err)
 	}
@@ -272,6 +405,10 @@ func (s *Server) handleCreateQueue(c *fiber.Ctx) error {
 		Mode:        mode,
 		GroupCode:   req.GroupCode,
 		OwnerId:     user.ID,
+		OpensAt:     req.OpensAt,
+		ClosesAt:    req.ClosesAt,
+		NotifyTop:   req.NotifyTop,
+		LotteryAt:   req.LotteryAt,
 	})
 	if err != nil {
 		return s.mapError(err)
@@ -280,15 +417,181 @@ func (s *Server) handleCreateQueue(c *fiber.Ctx) error {
 }
 
 func (s *Server) handleGetQueue(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
 	group := c.Query("group")
-	if group == "" {
-		return fiber.NewError(fiber.StatusBadRequest, "group is required")
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+	resp, err := s.queue.Get(c.Context(), id, user.ID, group)
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": resp})
+}
+
+// handleWatchQueue streams queue snapshots as Server-Sent Events until the
+// client disconnects or the queue is deleted.
+func (s *Server) handleWatchQueue(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	group := c.Query("group")
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+
+	// The stream outlives the handler, so it can't be bound to the request context.
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := s.queue.Watch(ctx, id, user.ID, group)
+	if err != nil {
+		cancel()
+		return s.mapError(err)
+	}
+	// The first message is the current state; receiving it here surfaces
+	// not found / permission errors as a regular HTTP response.
+	first, err := stream.Recv()
+	if err != nil {
+		cancel()
+		return s.mapError(err)
+	}
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer cancel()
+
+		updates := make(chan *queuev1.QueueUpdate)
+		go func() {
+			defer close(updates)
+			for {
+				upd, err := stream.Recv()
+				if err != nil {
+					return
+				}
+				select {
+				case updates <- upd:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+
+		ticker := time.NewTicker(sseKeepAlive)
+		defer ticker.Stop()
+
+		upd := first
+		for {
+			if upd != nil {
+				if err := writeSSE(w, "snapshot", upd); err != nil {
+					return
+				}
+				if upd.GetDeleted() {
+					return
+				}
+			}
+			select {
+			case next, ok := <-updates:
+				if !ok {
+					return
+				}
+				upd = next
+			case <-ticker.C:
+				// A comment line keeps proxies from closing an idle connection
+				// and lets us notice a client that has gone away.
+				upd = nil
+				if _, err := w.WriteString(": ping\n\n"); err != nil {
+					return
+				}
+				if err := w.Flush(); err != nil {
+					return
+				}
+			}
+		}
+	})
+	return nil
+}
+
+func (s *Server) handleListQueueEvents(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	group := c.Query("group")
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+
+	req := &queuev1.ListQueueEventsRequest{
+		QueueId:     id,
+		GroupCode:   group,
+		RequesterId: user.ID,
+		Type:        c.Query("type"),
+	}
+	if req.ActorId, err = queryInt64(c, "actor_id"); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid actor_id")
 	}
+	if req.Since, err = queryInt64(c, "since"); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid since")
+	}
+	if req.Until, err = queryInt64(c, "until"); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid until")
+	}
+	if req.BeforeId, err = queryInt64(c, "before_id"); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid before_id")
+	}
+	limit, err := queryInt64(c, "limit")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid limit")
+	}
+	req.Limit = int32(limit)
+
+	resp, err := s.queue.Events(c.Context(), req)
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": resp})
+}
+
+func (s *Server) handleGetQueueHistory(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	group := c.Query("group")
 	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
 	}
-	resp, err := s.queue.Get(c.Context(), id, group)
+
+	req := &queuev1.GetQueueHistoryRequest{
+		QueueId:     id,
+		GroupCode:   group,
+		RequesterId: user.ID,
+		Outcome:     c.Query("outcome"),
+	}
+	if req.UserId, err = queryInt64(c, "user_id"); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid user_id")
+	}
+	if req.BeforeId, err = queryInt64(c, "before_id"); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid before_id")
+	}
+	limit, err := queryInt64(c, "limit")
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid limit")
+	}
+	req.Limit = int32(limit)
+
+	resp, err := s.queue.History(c.Context(), req)
 	if err != nil {
 		return s.mapError(err)
 	}
@@ -311,7 +614,7 @@ func (s *Server) handleUpdateQueue(c *fiber.Ctx) error {
 	if err := s.validator.Struct(req); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
-	dto, err := s.queue.Update(c.Context(), id, user.ID, req.GroupCode, req.Title, req.Description)
+	dto, err := s.queue.Update(c.Context(), id, user.ID, req.GroupCode, req.Title, req.Description, req.NotifyTop)
 	if err != nil {
 		return s.mapError(err)
 	}
@@ -395,18 +698,18 @@ func (s *Server) handleAdvanceQueue(c *fiber.Ctx) error {
 	if err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
 	}
-	var req groupReq
+	var req advanceReq
 	if err := c.BodyParser(&req); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, "invalid body")
 	}
 	if err := s.validator.Struct(req); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
-	removed, err := s.queue.Advance(c.Context(), id, user.ID, req.GroupCode)
+	resp, err := s.queue.Advance(c.Context(), id, user.ID, req.GroupCode, req.Skip)
 	if err != nil {
 		return s.mapError(err)
 	}
-	return c.JSON(fiber.Map{"data": removed})
+	return c.JSON(fiber.Map{"data": resp})
 }
 
 func (s *Server) handleRemoveParticipant(c *fiber.Ctx) error {
@@ -475,6 +778,76 @@ func (s *Server) handleDeleteQueue(c *fiber.Ctx) error {
 	return c.JSON(fiber.Map{"data": "ok"})
 }
 
+func (s *Server) handleListMembers(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	group := c.Query("group")
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+	members, err := s.queue.Members(c.Context(), id, user.ID, group)
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": members})
+}
+
+func (s *Server) handleGrantRole(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+	userID, err := strconv.ParseInt(c.Params("userId"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid user id")
+	}
+	var req roleReq
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid body")
+	}
+	if err := s.validator.Struct(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	member, err := s.queue.GrantRole(c.Context(), id, userID, user.ID, req.Role, req.GroupCode)
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": member})
+}
+
+func (s *Server) handleRevokeRole(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+	userID, err := strconv.ParseInt(c.Params("userId"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid user id")
+	}
+	var req groupReq
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid body")
+	}
+	if err := s.validator.Struct(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	if err := s.queue.RevokeRole(c.Context(), id, userID, user.ID, req.GroupCode); err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": "ok"})
+}
+
 func (s *Server) mapError(err error) error {
 	if st, ok := status.FromError(err); ok {
 		switch st.Code() {
@@ -497,6 +870,28 @@ func (s *Server) mapError(err error) error {
 	return fiber.NewError(fiber.StatusInternalServerError, "internal error")
 }
 
+const sseKeepAlive = 20 * time.Second
+
+func writeSSE(w *bufio.Writer, event string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// queryInt64 parses an optional integer query parameter, returning 0 when it is absent.
+func queryInt64(c *fiber.Ctx, name string) (int64, error) {
+	raw := c.Query(name)
+	if raw == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(raw, 10, 64)
+}
+
 func parseMode(mode string) queuev1.QueueMode {
 	switch mode {
 	case "live":
@@ -507,24 +902,9 @@ func parseMode(mode string) queuev1.QueueMode {
 		return queuev1.QueueMode_QUEUE_MODE_RANDOM
 	case "slots":
 		return queuev1.QueueMode_QUEUE_MODE_SLOTS
+	case "priority":
+		return queuev1.QueueMode_QUEUE_MODE_PRIORITY
 	default:
 		return queuev1.QueueMode_QUEUE_MODE_LIVE
 	}
 }
-
-func extractStartToken(text string) string {
-	if text == "" {
-		return ""
-	}
-	parts := strings.Fields(text)
-	if len(parts) == 0 {
-		return ""
-	}
-	if parts[0] != "/start" {
-		return ""
-	}
-	if len(parts) < 2 {
-		return ""
-	}
-	return parts[1]
-}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	queuev1 "github.com/s1lentmol/q-flow-backend/protos/gen/go/queue"
+	"github.com/s1lentmol/q-flow-backend/services/api-gateway/internal/middleware"
+)
+
+type (
+	slotBreakReq struct {
+		StartsAt string `json:"starts_at" validate:"required"`
+		EndsAt   string `json:"ends_at" validate:"required"`
+	}
+
+	slotScheduleReq struct {
+		GroupCode   string         `json:"group_code"`
+		StartsAt    string         `json:"starts_at" validate:"required"`
+		EndsAt      string         `json:"ends_at" validate:"required"`
+		SlotMinutes int32          `json:"slot_minutes" validate:"required,gt=0"`
+		Capacity    int32          `json:"capacity" validate:"required,gt=0"`
+		Breaks      []slotBreakReq `json:"breaks" validate:"dive"`
+	}
+)
+
+func (s *Server) handleSetSlotSchedule(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+	var req slotScheduleReq
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid body")
+	}
+	if err := s.validator.Struct(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	breaks := make([]*queuev1.SlotBreakDTO, 0, len(req.Breaks))
+	for _, b := range req.Breaks {
+		breaks = append(breaks, &queuev1.SlotBreakDTO{StartsAt: b.StartsAt, EndsAt: b.EndsAt})
+	}
+	schedule, err := s.queue.SetSlotSchedule(c.Context(), &queuev1.SetSlotScheduleRequest{
+		QueueId:     id,
+		GroupCode:   req.GroupCode,
+		ActorId:     user.ID,
+		StartsAt:    req.StartsAt,
+		EndsAt:      req.EndsAt,
+		SlotMinutes: req.SlotMinutes,
+		Capacity:    req.Capacity,
+		Breaks:      breaks,
+	})
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": schedule})
+}
+
+func (s *Server) handleListFreeSlots(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	group := c.Query("group")
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+	resp, err := s.queue.FreeSlots(c.Context(), id, user.ID, group)
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": resp})
+}
@@ -0,0 +1,129 @@
+package server
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/s1lentmol/q-flow-backend/services/api-gateway/internal/middleware"
+)
+
+type (
+	swapPolicyReq struct {
+		GroupCode string `json:"group_code"`
+		Allowed   *bool  `json:"allowed" validate:"required"`
+	}
+
+	swapRequestReq struct {
+		GroupCode    string `json:"group_code"`
+		TargetUserID int64  `json:"target_user_id" validate:"required,gt=0"`
+	}
+)
+
+func (s *Server) handleSetSwapPolicy(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+	var req swapPolicyReq
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid body")
+	}
+	if err := s.validator.Struct(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	dto, err := s.queue.SetSwapPolicy(c.Context(), id, user.ID, req.GroupCode, *req.Allowed)
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": dto})
+}
+
+func (s *Server) handleListSwapRequests(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+	list, err := s.queue.ListSwapRequests(c.Context(), id, user.ID, c.Query("group"))
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": list})
+}
+
+func (s *Server) handleRequestSwap(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+	var req swapRequestReq
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid body")
+	}
+	if err := s.validator.Struct(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	dto, err := s.queue.RequestSwap(c.Context(), id, user.ID, req.TargetUserID, req.GroupCode)
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{"data": dto})
+}
+
+func (s *Server) handleAcceptSwap(c *fiber.Ctx) error {
+	return s.answerSwap(c, true)
+}
+
+func (s *Server) handleDeclineSwap(c *fiber.Ctx) error {
+	return s.answerSwap(c, false)
+}
+
+func (s *Server) answerSwap(c *fiber.Ctx, accept bool) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+	var req groupReq
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid body")
+	}
+	if err := s.validator.Struct(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	resp, err := s.queue.AnswerSwap(c.Context(), id, user.ID, req.GroupCode, accept)
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": fiber.Map{"request": resp.GetRequest(), "participants": resp.GetParticipants()}})
+}
+
+func (s *Server) handleCancelSwap(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+	dto, err := s.queue.CancelSwap(c.Context(), id, user.ID)
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": dto})
+}
@@ -0,0 +1,130 @@
+package server
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	queuev1 "github.com/s1lentmol/q-flow-backend/protos/gen/go/queue"
+	"github.com/s1lentmol/q-flow-backend/services/api-gateway/internal/middleware"
+)
+
+type templateReq struct {
+	Title                string  `json:"title" validate:"required"`
+	Description          string  `json:"description"`
+	Mode                 string  `json:"mode" validate:"required,oneof=live managed random slots priority"`
+	GroupCode            string  `json:"group_code" validate:"required"`
+	Weekdays             []int32 `json:"weekdays" validate:"required,min=1,dive,gte=0,lte=6"`
+	StartTime            string  `json:"start_time" validate:"required"`
+	Timezone             string  `json:"timezone"`
+	PublishBeforeMinutes int32   `json:"publish_before_minutes" validate:"gte=0"`
+	DurationMinutes      int32   `json:"duration_minutes" validate:"gte=0"`
+	SlotMinutes          int32   `json:"slot_minutes" validate:"gte=0"`
+	SlotCapacity         int32   `json:"slot_capacity" validate:"gte=0"`
+	LotteryAfterMinutes  int32   `json:"lottery_after_minutes" validate:"gte=0"`
+	AutoArchive          bool    `json:"auto_archive"`
+	Paused               bool    `json:"paused"`
+}
+
+func (r templateReq) spec() *queuev1.QueueTemplateSpec {
+	return &queuev1.QueueTemplateSpec{
+		Title:                r.Title,
+		Description:          r.Description,
+		Mode:                 parseMode(r.Mode),
+		GroupCode:            r.GroupCode,
+		Weekdays:             r.Weekdays,
+		StartTime:            r.StartTime,
+		Timezone:             r.Timezone,
+		PublishBeforeMinutes: r.PublishBeforeMinutes,
+		DurationMinutes:      r.DurationMinutes,
+		SlotMinutes:          r.SlotMinutes,
+		SlotCapacity:         r.SlotCapacity,
+		LotteryAfterMinutes:  r.LotteryAfterMinutes,
+		AutoArchive:          r.AutoArchive,
+		Paused:               r.Paused,
+	}
+}
+
+func (s *Server) handleListTemplates(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	templates, err := s.queue.Templates(c.Context(), user.ID, c.Query("group"))
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": templates})
+}
+
+func (s *Server) handleCreateTemplate(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	var req templateReq
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid body")
+	}
+	if err := s.validator.Struct(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	template, err := s.queue.CreateTemplate(c.Context(), user.ID, req.spec())
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{"data": template})
+}
+
+func (s *Server) handleGetTemplate(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+	template, err := s.queue.Template(c.Context(), id, user.ID)
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": template})
+}
+
+func (s *Server) handleUpdateTemplate(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+	var req templateReq
+	if err := c.BodyParser(&req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid body")
+	}
+	if err := s.validator.Struct(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+	template, err := s.queue.UpdateTemplate(c.Context(), id, user.ID, req.spec())
+	if err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": template})
+}
+
+func (s *Server) handleDeleteTemplate(c *fiber.Ctx) error {
+	user := middleware.GetUser(c)
+	if user == nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "unauthorized")
+	}
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid id")
+	}
+	if err := s.queue.DeleteTemplate(c.Context(), id, user.ID); err != nil {
+		return s.mapError(err)
+	}
+	return c.JSON(fiber.Map{"data": "ok"})
+}
@@ -11,10 +11,12 @@ import (
 )
 
 type Config struct {
-	Env      string
-	TokenTTL time.Duration
-	DB       DBConfig
-	GRPC     GRPCConfig
+	Env             string
+	TokenTTL        time.Duration `mapstructure:"token_ttl"`
+	RefreshTokenTTL time.Duration `mapstructure:"refresh_token_ttl"`
+	KeyRotation     time.Duration `mapstructure:"key_rotation"`
+	DB              DBConfig
+	GRPC            GRPCConfig
 }
 
 type DBConfig struct {
@@ -72,6 +74,12 @@ func applyDefaults(cfg *Config) {
 	if cfg.TokenTTL == 0 {
 		cfg.TokenTTL = time.Hour
 	}
+	if cfg.RefreshTokenTTL == 0 {
+		cfg.RefreshTokenTTL = 30 * 24 * time.Hour
+	}
+	if cfg.KeyRotation == 0 {
+		cfg.KeyRotation = 7 * 24 * time.Hour
+	}
 
 	if cfg.GRPC.Port == 0 {
 		cfg.GRPC.Port = 44044
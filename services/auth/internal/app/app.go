@@ -3,6 +3,7 @@ package app
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	"github.com/s1lentmol/q-flow-backend/services/auth/config"
 	grpcapp "github.com/s1lentmol/q-flow-backend/services/auth/internal/app/grpc"
@@ -11,6 +12,8 @@ import (
 	migrator "github.com/s1lentmol/q-flow-backend/services/auth/migrations"
 )
 
+const maintenanceInterval = time.Hour
+
 type App struct {
 	GRPCSrv *grpcapp.App
 	storage *postgres.Storage
@@ -26,7 +29,14 @@ func New(ctx context.Context, log *slog.Logger, cfg *config.Config) (*App, error
 		return nil, err
 	}
 
-	authService := auth.New(log, store, store, store, cfg.TokenTTL)
+	authService := auth.New(log, store, store, store, store, store, cfg.TokenTTL, cfg.RefreshTokenTTL, cfg.KeyRotation)
+
+	if err := authService.RotateKeys(ctx); err != nil {
+		store.Close()
+		return nil, err
+	}
+
+	go maintainTokens(ctx, log, authService)
 
 	grpcApp := grpcapp.New(log, authService, cfg.GRPC.Port)
 
@@ -36,6 +46,26 @@ func New(ctx context.Context, log *slog.Logger, cfg *config.Config) (*App, error
 	}, nil
 }
 
+// maintainTokens periodically drops expired tokens and rotates signing keys until ctx is done.
+func maintainTokens(ctx context.Context, log *slog.Logger, authService *auth.Auth) {
+	ticker := time.NewTicker(maintenanceInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := authService.PurgeExpiredTokens(ctx); err != nil {
+				log.Warn("failed to purge expired tokens", slog.Any("err", err))
+			}
+			if err := authService.RotateKeys(ctx); err != nil {
+				log.Warn("failed to rotate signing keys", slog.Any("err", err))
+			}
+		}
+	}
+}
+
 func (a *App) Stop() {
 	a.GRPCSrv.Stop()
 	a.storage.Close()
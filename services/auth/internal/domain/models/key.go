@@ -0,0 +1,20 @@
+package models
+
+import (
+	"crypto/ed25519"
+	"time"
+)
+
+// SigningKey is an Ed25519 key pair used to sign access tokens.
+// It signs until NotAfter and is published for verification until ExpiresAt.
+type SigningKey struct {
+	ID         string // kid
+	PrivateKey ed25519.PrivateKey
+	CreatedAt  time.Time
+	NotAfter   time.Time
+	ExpiresAt  time.Time
+}
+
+func (k SigningKey) PublicKey() ed25519.PublicKey {
+	return k.PrivateKey.Public().(ed25519.PublicKey)
+}
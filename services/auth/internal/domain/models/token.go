@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresAt    time.Time // access token expiry
+}
+
+type RefreshToken struct {
+	ID        int64
+	UserID    int64
+	AppID     int
+	ExpiresAt time.Time
+}
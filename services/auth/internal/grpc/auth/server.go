@@ -9,6 +9,8 @@ import (
 
 	"github.com/go-playground/validator/v10"
 	authv1 "github.com/s1lentmol/q-flow-backend/protos/gen/go/auth"
+	"github.com/s1lentmol/q-flow-backend/services/auth/internal/domain/models"
+	"github.com/s1lentmol/q-flow-backend/services/auth/internal/lib/jwt"
 	authsvc "github.com/s1lentmol/q-flow-backend/services/auth/internal/services/auth"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -20,7 +22,7 @@ type Auth interface {
 		email string,
 		password string,
 		appID int,
-	) (token string, err error)
+	) (models.TokenPair, error)
 
 	RegisterNewUser(ctx context.Context,
 		email string,
@@ -31,6 +33,21 @@ type Auth interface {
 	IsAdmin(ctx context.Context,
 		userID int64,
 	) (bool, error)
+
+	Refresh(ctx context.Context,
+		refreshToken string,
+		appID int,
+	) (models.TokenPair, error)
+
+	Logout(ctx context.Context,
+		accessToken string,
+		refreshToken string,
+	) error
+
+	IsTokenRevoked(ctx context.Context,
+		tokenID string,
+	) (bool, error)
+	JWKS(ctx context.Context) ([]jwt.JWK, error)
 }
 
 type serverAPI struct {
@@ -59,13 +76,15 @@ func (s *serverAPI) Login(ctx context.Context, req *authv1.LoginRequest,
 		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
 	}
 
-	token, err := s.auth.Login(ctx, req.GetEmail(), req.GetPassword(), int(req.GetAppId()))
+	pair, err := s.auth.Login(ctx, req.GetEmail(), req.GetPassword(), int(req.GetAppId()))
 	if err != nil {
 		return nil, mapAuthErr(err, "failed to login user")
 	}
 
 	return &authv1.LoginResponse{
-		Token: token,
+		Token:        pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+		ExpiresAt:    pair.ExpiresAt.Unix(),
 	}, nil
 }
 
@@ -117,6 +136,94 @@ func (s *serverAPI) IsAdmin(ctx context.Context, req *authv1.IsAdminRequest,
 	}, nil
 }
 
+func (s *serverAPI) Refresh(ctx context.Context, req *authv1.RefreshRequest,
+) (*authv1.RefreshResponse, error) {
+	input := struct {
+		RefreshToken string `validate:"required" json:"refresh_token"`
+		AppID        int32  `validate:"required,gt=0" json:"app_id"`
+	}{
+		RefreshToken: req.GetRefreshToken(),
+		AppID:        req.GetAppId(),
+	}
+
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	pair, err := s.auth.Refresh(ctx, req.GetRefreshToken(), int(req.GetAppId()))
+	if err != nil {
+		return nil, mapAuthErr(err, "failed to refresh token")
+	}
+
+	return &authv1.RefreshResponse{
+		Token:        pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+		ExpiresAt:    pair.ExpiresAt.Unix(),
+	}, nil
+}
+
+func (s *serverAPI) Logout(ctx context.Context, req *authv1.LogoutRequest,
+) (*authv1.LogoutResponse, error) {
+	input := struct {
+		Token string `validate:"required" json:"token"`
+	}{
+		Token: req.GetToken(),
+	}
+
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	if err := s.auth.Logout(ctx, req.GetToken(), req.GetRefreshToken()); err != nil {
+		return nil, mapAuthErr(err, "failed to logout")
+	}
+
+	return &authv1.LogoutResponse{}, nil
+}
+
+func (s *serverAPI) IsTokenRevoked(ctx context.Context, req *authv1.IsTokenRevokedRequest,
+) (*authv1.IsTokenRevokedResponse, error) {
+	input := struct {
+		TokenID string `validate:"required" json:"token_id"`
+	}{
+		TokenID: req.GetTokenId(),
+	}
+
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	revoked, err := s.auth.IsTokenRevoked(ctx, req.GetTokenId())
+	if err != nil {
+		return nil, mapAuthErr(err, "failed to check token")
+	}
+
+	return &authv1.IsTokenRevokedResponse{
+		Revoked: revoked,
+	}, nil
+}
+
+func (s *serverAPI) GetJWKS(ctx context.Context, req *authv1.GetJWKSRequest,
+) (*authv1.GetJWKSResponse, error) {
+	keys, err := s.auth.JWKS(ctx)
+	if err != nil {
+		return nil, mapAuthErr(err, "failed to get keys")
+	}
+
+	resp := &authv1.GetJWKSResponse{Keys: make([]*authv1.JWK, 0, len(keys))}
+	for _, key := range keys {
+		resp.Keys = append(resp.Keys, &authv1.JWK{
+			Kty: key.Kty,
+			Crv: key.Crv,
+			X:   key.X,
+			Kid: key.Kid,
+			Alg: key.Alg,
+			Use: key.Use,
+		})
+	}
+	return resp, nil
+}
+
 var validate = func() *validator.Validate {
 	v := validator.New(validator.WithRequiredStructEnabled())
 	v.RegisterTagNameFunc(func(fld reflect.StructField) string {
@@ -145,6 +252,8 @@ func mapAuthErr(err error, fallback string) error {
 	switch {
 	case errors.Is(err, authsvc.ErrInvalidCredentials):
 		return status.Error(codes.Unauthenticated, "invalid credentials")
+	case errors.Is(err, authsvc.ErrInvalidToken):
+		return status.Error(codes.Unauthenticated, "invalid token")
 	case errors.Is(err, authsvc.ErrInvalidAppID):
 		return status.Error(codes.InvalidArgument, "invalid app id")
 	case errors.Is(err, authsvc.ErrUserExists):
@@ -1,26 +1,115 @@
 package jwt
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/s1lentmol/q-flow-backend/services/auth/internal/domain/models"
 )
 
-func NewToken(user models.User, app models.App, duration time.Duration) (string, error) {
-	token := jwt.New(jwt.SigningMethodHS256)
+var ErrInvalidToken = errors.New("invalid token")
+
+// Claims are the token fields the auth service itself relies on.
+type Claims struct {
+	UserID    int64
+	AppID     int
+	TokenID   string
+	ExpiresAt time.Time
+}
+
+// JWK is a public key in JSON Web Key format (RFC 8037 for Ed25519).
+type JWK struct {
+	Kty string
+	Crv string
+	X   string
+	Kid string
+	Alg string
+	Use string
+}
+
+// NewToken signs an EdDSA access token with key and puts the key id into the kid header.
+func NewToken(user models.User, app models.App, key models.SigningKey, expiresAt time.Time) (string, error) {
+	jti, err := newTokenID()
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.New(jwt.SigningMethodEdDSA)
+	token.Header["kid"] = key.ID
 
 	claims := token.Claims.(jwt.MapClaims)
 	claims["uid"] = user.ID
 	claims["email"] = user.Email
 	claims["name"] = user.FullName
-	claims["exp"] = time.Now().Add(duration).Unix()
+	claims["exp"] = expiresAt.Unix()
 	claims["app_id"] = app.ID
+	claims["jti"] = jti
 
-	tokenString, err := token.SignedString([]byte(app.Secret))
+	tokenString, err := token.SignedString(key.PrivateKey)
 	if err != nil {
 		return "", err
 	}
 
 	return tokenString, nil
 }
+
+// Parse verifies the token signature with the public key named by its kid header.
+func Parse(tokenString string, publicKey func(kid string) (ed25519.PublicKey, error)) (Claims, error) {
+	token, err := jwt.Parse(tokenString, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		if kid == "" {
+			return nil, ErrInvalidToken
+		}
+		return publicKey(kid)
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodEdDSA.Alg()}))
+	if err != nil || !token.Valid {
+		return Claims{}, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	claims := token.Claims.(jwt.MapClaims)
+	uid, _ := claims["uid"].(float64)
+	appID, _ := claims["app_id"].(float64)
+	jti, _ := claims["jti"].(string)
+	exp, err := claims.GetExpirationTime()
+	if err != nil || exp == nil {
+		return Claims{}, ErrInvalidToken
+	}
+
+	return Claims{
+		UserID:    int64(uid),
+		AppID:     int(appID),
+		TokenID:   jti,
+		ExpiresAt: exp.Time,
+	}, nil
+}
+
+func newTokenID() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// PublicJWK describes the public half of key for a JWKS document.
+func PublicJWK(key models.SigningKey) JWK {
+	return JWK{
+		Kty: "OKP",
+		Crv: "Ed25519",
+		X:   base64.RawURLEncoding.EncodeToString(key.PublicKey()),
+		Kid: key.ID,
+		Alg: jwt.SigningMethodEdDSA.Alg(),
+		Use: "sig",
+	}
+}
+
+// NewKeyID returns a random identifier for a signing key.
+func NewKeyID() (string, error) {
+	return newTokenID()
+}
@@ -2,6 +2,10 @@ package auth
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -14,11 +18,15 @@ import (
 )
 
 type Auth struct {
-	log         *slog.Logger
-	usrSaver    UserSaver
-	usrProvider UserProvider
-	appProvider AppProvider
-	tokenTTL    time.Duration
+	log          *slog.Logger
+	usrSaver     UserSaver
+	usrProvider  UserProvider
+	appProvider  AppProvider
+	tokenStorage TokenStorage
+	keyStorage   KeyStorage
+	keys         *keyRing
+	tokenTTL     time.Duration
+	refreshTTL   time.Duration
 }
 
 type UserSaver interface {
@@ -27,6 +35,7 @@ type UserSaver interface {
 
 type UserProvider interface {
 	User(ctx context.Context, email string) (models.User, error)
+	UserByID(ctx context.Context, userID int64) (models.User, error)
 	IsAdmin(ctx context.Context, userID int64) (bool, error)
 }
 
@@ -34,7 +43,17 @@ type AppProvider interface {
 	App(ctx context.Context, appID int) (models.App, error)
 }
 
+type TokenStorage interface {
+	SaveRefreshToken(ctx context.Context, userID int64, appID int, tokenHash []byte, expiresAt time.Time) error
+	RevokeRefreshToken(ctx context.Context, tokenHash []byte) (models.RefreshToken, error)
+	RevokeUserRefreshTokens(ctx context.Context, userID int64) error
+	RevokeAccessToken(ctx context.Context, tokenID string, expiresAt time.Time) error
+	IsAccessTokenRevoked(ctx context.Context, tokenID string) (bool, error)
+	DeleteExpiredTokens(ctx context.Context) error
+}
+
 var (
+	ErrInvalidToken       = errors.New("invalid token")
 	ErrInvalidCredentials = errors.New("invalid credentials")
 	ErrInvalidAppID       = errors.New("invalid app ID")
 	ErrUserExists         = errors.New("user already exists")
@@ -46,23 +65,31 @@ func New(
 	userSaver UserSaver,
 	userProvider UserProvider,
 	appProvider AppProvider,
+	tokenStorage TokenStorage,
+	keyStorage KeyStorage,
 	tokenTTL time.Duration,
+	refreshTTL time.Duration,
+	keyRotation time.Duration,
 ) *Auth {
 	return &Auth{
-		log:         log,
-		usrSaver:    userSaver,
-		usrProvider: userProvider,
-		appProvider: appProvider,
-		tokenTTL:    tokenTTL,
+		log:          log,
+		usrSaver:     userSaver,
+		usrProvider:  userProvider,
+		appProvider:  appProvider,
+		tokenStorage: tokenStorage,
+		keyStorage:   keyStorage,
+		keys:         newKeyRing(keyStorage, keyRotation, tokenTTL),
+		tokenTTL:     tokenTTL,
+		refreshTTL:   refreshTTL,
 	}
 }
 
-// Login logs in user and returns JWT token.
+// Login logs in user and returns a JWT access token with a refresh token.
 func (a *Auth) Login(ctx context.Context,
 	email string,
 	password string,
 	appID int,
-) (string, error) {
+) (models.TokenPair, error) {
 	const op = "Auth.Login"
 
 	log := a.log.With(
@@ -76,33 +103,184 @@ func (a *Auth) Login(ctx context.Context,
 	if err != nil {
 		if errors.Is(err, storage.ErrUserNotFound) {
 			a.log.Warn("user not found", slog.Any("err", err))
-			return "", fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+			return models.TokenPair{}, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
 		}
 		a.log.Error("failed to get user", slog.Any("err", err))
-		return "", fmt.Errorf("%s: %w", op, err)
+		return models.TokenPair{}, fmt.Errorf("%s: %w", op, err)
 	}
 
 	err = bcrypt.CompareHashAndPassword(user.PassHash, []byte(password))
 	if err != nil {
 		a.log.Error("invalid credentials", slog.Any("err", err))
-		return "", fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
+		return models.TokenPair{}, fmt.Errorf("%s: %w", op, ErrInvalidCredentials)
 	}
 
 	app, err := a.appProvider.App(ctx, appID)
 	if err != nil {
 		log.Error("failed to get app", slog.Any("err", err))
-		return "", fmt.Errorf("%s: %w", op, err)
+		return models.TokenPair{}, fmt.Errorf("%s: %w", op, err)
 	}
 
 	log.Info("user logged in successfully")
 
-	token, err := jwt.NewToken(user, app, a.tokenTTL)
+	pair, err := a.issueTokens(ctx, user, app)
+	if err != nil {
+		log.Error("failed to issue tokens", slog.Any("err", err))
+		return models.TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return pair, nil
+}
+
+// Refresh exchanges a refresh token for a new token pair. Every refresh token
+// works once: presenting an already used one means it leaked, so all sessions
+// of the user are revoked.
+func (a *Auth) Refresh(ctx context.Context, refreshToken string, appID int) (models.TokenPair, error) {
+	const op = "Auth.Refresh"
+
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	stored, err := a.tokenStorage.RevokeRefreshToken(ctx, hashToken(refreshToken))
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrTokenNotFound):
+			log.Warn("unknown refresh token")
+			return models.TokenPair{}, fmt.Errorf("%s: %w", op, ErrInvalidToken)
+		case errors.Is(err, storage.ErrTokenRevoked):
+			log.Warn("refresh token reuse detected, revoking all sessions", slog.Int64("user_id", stored.UserID))
+			if err := a.tokenStorage.RevokeUserRefreshTokens(ctx, stored.UserID); err != nil {
+				log.Error("failed to revoke user sessions", slog.Any("err", err))
+			}
+			return models.TokenPair{}, fmt.Errorf("%s: %w", op, ErrInvalidToken)
+		}
+		log.Error("failed to revoke refresh token", slog.Any("err", err))
+		return models.TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if stored.AppID != appID || time.Now().After(stored.ExpiresAt) {
+		return models.TokenPair{}, fmt.Errorf("%s: %w", op, ErrInvalidToken)
+	}
+
+	user, err := a.usrProvider.UserByID(ctx, stored.UserID)
+	if err != nil {
+		if errors.Is(err, storage.ErrUserNotFound) {
+			return models.TokenPair{}, fmt.Errorf("%s: %w", op, ErrInvalidToken)
+		}
+		log.Error("failed to get user", slog.Any("err", err))
+		return models.TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	app, err := a.appProvider.App(ctx, appID)
+	if err != nil {
+		log.Error("failed to get app", slog.Any("err", err))
+		return models.TokenPair{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	pair, err := a.issueTokens(ctx, user, app)
 	if err != nil {
-		log.Error("failed to generate JWT token", slog.Any("err", err))
-		return "", fmt.Errorf("%s: %w", op, err)
+		log.Error("failed to issue tokens", slog.Any("err", err))
+		return models.TokenPair{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return token, nil
+	return pair, nil
+}
+
+// Logout revokes the access token and, if given, the refresh token of the session.
+func (a *Auth) Logout(ctx context.Context, accessToken string, refreshToken string) error {
+	const op = "Auth.Logout"
+
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	claims, err := jwt.Parse(accessToken, func(kid string) (ed25519.PublicKey, error) {
+		return a.keys.publicKey(ctx, kid)
+	})
+	if err != nil {
+		log.Warn("invalid access token", slog.Any("err", err))
+		return fmt.Errorf("%s: %w", op, ErrInvalidToken)
+	}
+
+	if claims.TokenID != "" {
+		if err := a.tokenStorage.RevokeAccessToken(ctx, claims.TokenID, claims.ExpiresAt); err != nil {
+			log.Error("failed to revoke access token", slog.Any("err", err))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if refreshToken != "" {
+		_, err := a.tokenStorage.RevokeRefreshToken(ctx, hashToken(refreshToken))
+		if err != nil && !errors.Is(err, storage.ErrTokenNotFound) && !errors.Is(err, storage.ErrTokenRevoked) {
+			log.Error("failed to revoke refresh token", slog.Any("err", err))
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	log.Info("user logged out", slog.Int64("user_id", claims.UserID))
+
+	return nil
+}
+
+func (a *Auth) IsTokenRevoked(ctx context.Context, tokenID string) (bool, error) {
+	const op = "Auth.IsTokenRevoked"
+
+	revoked, err := a.tokenStorage.IsAccessTokenRevoked(ctx, tokenID)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return revoked, nil
+}
+
+// PurgeExpiredTokens removes refresh and revoked tokens past their expiry.
+func (a *Auth) PurgeExpiredTokens(ctx context.Context) error {
+	const op = "Auth.PurgeExpiredTokens"
+
+	if err := a.tokenStorage.DeleteExpiredTokens(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (a *Auth) issueTokens(ctx context.Context, user models.User, app models.App) (models.TokenPair, error) {
+	key, err := a.keys.signingKey(ctx)
+	if err != nil {
+		return models.TokenPair{}, fmt.Errorf("get signing key: %w", err)
+	}
+
+	expiresAt := time.Now().Add(a.tokenTTL)
+	access, err := jwt.NewToken(user, app, key, expiresAt)
+	if err != nil {
+		return models.TokenPair{}, fmt.Errorf("generate access token: %w", err)
+	}
+
+	refresh, err := newRefreshToken()
+	if err != nil {
+		return models.TokenPair{}, fmt.Errorf("generate refresh token: %w", err)
+	}
+	if err := a.tokenStorage.SaveRefreshToken(ctx, user.ID, app.ID, hashToken(refresh), time.Now().Add(a.refreshTTL)); err != nil {
+		return models.TokenPair{}, err
+	}
+
+	return models.TokenPair{
+		AccessToken:  access,
+		RefreshToken: refresh,
+		ExpiresAt:    expiresAt,
+	}, nil
+}
+
+func newRefreshToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// hashToken is enough for refresh tokens: they are random, so there is nothing to brute-force.
+func hashToken(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
 }
 
 func (a *Auth) RegisterNewUser(ctx context.Context,
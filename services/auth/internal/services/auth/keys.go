@@ -0,0 +1,192 @@
+package auth
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/s1lentmol/q-flow-backend/services/auth/internal/domain/models"
+	"github.com/s1lentmol/q-flow-backend/services/auth/internal/lib/jwt"
+)
+
+type KeyStorage interface {
+	SaveSigningKey(ctx context.Context, key models.SigningKey) error
+	SigningKeys(ctx context.Context) ([]models.SigningKey, error)
+	DeleteExpiredSigningKeys(ctx context.Context) error
+}
+
+var ErrKeyNotFound = errors.New("signing key not found")
+
+// keyRing caches the published signing keys. Keys live in the database so every
+// auth instance signs with the same set; a new key is generated once the newest
+// one stops signing, and the old one stays published until its tokens expire.
+type keyRing struct {
+	storage  KeyStorage
+	rotation time.Duration
+	tokenTTL time.Duration
+
+	mu   sync.Mutex
+	keys []models.SigningKey // newest first
+}
+
+func newKeyRing(storage KeyStorage, rotation time.Duration, tokenTTL time.Duration) *keyRing {
+	return &keyRing{
+		storage:  storage,
+		rotation: rotation,
+		tokenTTL: tokenTTL,
+	}
+}
+
+// signingKey returns the key new tokens are signed with, rotating if needed.
+func (r *keyRing) signingKey(ctx context.Context) (models.SigningKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if key, ok := r.current(); ok {
+		return key, nil
+	}
+
+	// Another instance may have rotated already.
+	if err := r.reload(ctx); err != nil {
+		return models.SigningKey{}, err
+	}
+	if key, ok := r.current(); ok {
+		return key, nil
+	}
+
+	key, err := r.generate(ctx)
+	if err != nil {
+		return models.SigningKey{}, err
+	}
+	r.keys = append([]models.SigningKey{key}, r.keys...)
+
+	return key, nil
+}
+
+func (r *keyRing) publicKey(ctx context.Context, kid string) (ed25519.PublicKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if key, ok := r.find(kid); ok {
+		return key.PublicKey(), nil
+	}
+	if err := r.reload(ctx); err != nil {
+		return nil, err
+	}
+	if key, ok := r.find(kid); ok {
+		return key.PublicKey(), nil
+	}
+	return nil, ErrKeyNotFound
+}
+
+// published returns all keys tokens may still be signed with.
+func (r *keyRing) published(ctx context.Context) ([]models.SigningKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.reload(ctx); err != nil {
+		return nil, err
+	}
+	return append([]models.SigningKey(nil), r.keys...), nil
+}
+
+func (r *keyRing) current() (models.SigningKey, bool) {
+	now := time.Now()
+	for _, key := range r.keys {
+		if now.Before(key.NotAfter) {
+			return key, true
+		}
+	}
+	return models.SigningKey{}, false
+}
+
+func (r *keyRing) find(kid string) (models.SigningKey, bool) {
+	now := time.Now()
+	for _, key := range r.keys {
+		if key.ID == kid && now.Before(key.ExpiresAt) {
+			return key, true
+		}
+	}
+	return models.SigningKey{}, false
+}
+
+func (r *keyRing) reload(ctx context.Context) error {
+	keys, err := r.storage.SigningKeys(ctx)
+	if err != nil {
+		return err
+	}
+	r.keys = keys
+	return nil
+}
+
+func (r *keyRing) generate(ctx context.Context) (models.SigningKey, error) {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return models.SigningKey{}, fmt.Errorf("generate signing key: %w", err)
+	}
+	kid, err := jwt.NewKeyID()
+	if err != nil {
+		return models.SigningKey{}, fmt.Errorf("generate key id: %w", err)
+	}
+
+	now := time.Now()
+	key := models.SigningKey{
+		ID:         kid,
+		PrivateKey: private,
+		CreatedAt:  now,
+		NotAfter:   now.Add(r.rotation),
+		// Tokens signed right before NotAfter must still verify.
+		ExpiresAt: now.Add(r.rotation + r.tokenTTL),
+	}
+	if err := r.storage.SaveSigningKey(ctx, key); err != nil {
+		return models.SigningKey{}, err
+	}
+	return key, nil
+}
+
+// JWKS returns public keys for verifying access tokens.
+func (a *Auth) JWKS(ctx context.Context) ([]jwt.JWK, error) {
+	const op = "Auth.JWKS"
+
+	// Make sure there is something to publish before the first login.
+	if _, err := a.keys.signingKey(ctx); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	keys, err := a.keys.published(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	jwks := make([]jwt.JWK, 0, len(keys))
+	for _, key := range keys {
+		jwks = append(jwks, jwt.PublicJWK(key))
+	}
+	return jwks, nil
+}
+
+// RotateKeys drops expired signing keys and generates a new one if the current key stopped signing.
+func (a *Auth) RotateKeys(ctx context.Context) error {
+	const op = "Auth.RotateKeys"
+
+	log := a.log.With(
+		slog.String("op", op),
+	)
+
+	if err := a.keyStorage.DeleteExpiredSigningKeys(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	key, err := a.keys.signingKey(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	log.Debug("signing key in use", slog.String("kid", key.ID), slog.Time("not_after", key.NotAfter))
+
+	return nil
+}
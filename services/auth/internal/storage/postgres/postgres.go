@@ -2,8 +2,10 @@ package postgres
 
 import (
 	"context"
+	"crypto/ed25519"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -90,3 +92,140 @@ func (s *Storage) App(ctx context.Context, appID int) (models.App, error) {
 
 	return app, nil
 }
+
+func (s *Storage) UserByID(ctx context.Context, userID int64) (models.User, error) {
+	const query = `SELECT id, email, full_name, pass_hash FROM users WHERE id = $1`
+
+	var user models.User
+	if err := s.pool.QueryRow(ctx, query, userID).Scan(&user.ID, &user.Email, &user.FullName, &user.PassHash); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.User{}, storage.ErrUserNotFound
+		}
+		return models.User{}, fmt.Errorf("postgres: get user by id: %w", err)
+	}
+
+	return user, nil
+}
+
+func (s *Storage) SaveRefreshToken(ctx context.Context, userID int64, appID int, tokenHash []byte, expiresAt time.Time) error {
+	const query = `INSERT INTO refresh_tokens (user_id, app_id, token_hash, expires_at) VALUES ($1, $2, $3, $4)`
+
+	if _, err := s.pool.Exec(ctx, query, userID, appID, tokenHash, expiresAt); err != nil {
+		return fmt.Errorf("postgres: save refresh token: %w", err)
+	}
+	return nil
+}
+
+// RevokeRefreshToken atomically marks the token as used, so a refresh token can be
+// exchanged only once. Returns storage.ErrTokenRevoked if it was already revoked.
+func (s *Storage) RevokeRefreshToken(ctx context.Context, tokenHash []byte) (models.RefreshToken, error) {
+	const query = `UPDATE refresh_tokens SET revoked_at = NOW()
+WHERE token_hash = $1 AND revoked_at IS NULL
+RETURNING id, user_id, app_id, expires_at`
+
+	var t models.RefreshToken
+	err := s.pool.QueryRow(ctx, query, tokenHash).Scan(&t.ID, &t.UserID, &t.AppID, &t.ExpiresAt)
+	if err == nil {
+		return t, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return models.RefreshToken{}, fmt.Errorf("postgres: revoke refresh token: %w", err)
+	}
+
+	if err := s.pool.QueryRow(ctx, `SELECT id, user_id, app_id, expires_at FROM refresh_tokens WHERE token_hash = $1`, tokenHash).
+		Scan(&t.ID, &t.UserID, &t.AppID, &t.ExpiresAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.RefreshToken{}, storage.ErrTokenNotFound
+		}
+		return models.RefreshToken{}, fmt.Errorf("postgres: get refresh token: %w", err)
+	}
+	return t, storage.ErrTokenRevoked
+}
+
+func (s *Storage) RevokeUserRefreshTokens(ctx context.Context, userID int64) error {
+	const query = `UPDATE refresh_tokens SET revoked_at = NOW() WHERE user_id = $1 AND revoked_at IS NULL`
+
+	if _, err := s.pool.Exec(ctx, query, userID); err != nil {
+		return fmt.Errorf("postgres: revoke user refresh tokens: %w", err)
+	}
+	return nil
+}
+
+func (s *Storage) RevokeAccessToken(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	const query = `INSERT INTO revoked_tokens (jti, expires_at) VALUES ($1, $2) ON CONFLICT (jti) DO NOTHING`
+
+	if _, err := s.pool.Exec(ctx, query, tokenID, expiresAt); err != nil {
+		return fmt.Errorf("postgres: revoke access token: %w", err)
+	}
+	return nil
+}
+
+func (s *Storage) IsAccessTokenRevoked(ctx context.Context, tokenID string) (bool, error) {
+	const query = `SELECT EXISTS(SELECT 1 FROM revoked_tokens WHERE jti = $1)`
+
+	var revoked bool
+	if err := s.pool.QueryRow(ctx, query, tokenID).Scan(&revoked); err != nil {
+		return false, fmt.Errorf("postgres: check revoked token: %w", err)
+	}
+	return revoked, nil
+}
+
+// DeleteExpiredTokens drops tokens that can no longer be used anyway.
+func (s *Storage) DeleteExpiredTokens(ctx context.Context) error {
+	if _, err := s.pool.Exec(ctx, `DELETE FROM revoked_tokens WHERE expires_at < NOW()`); err != nil {
+		return fmt.Errorf("postgres: delete expired revoked tokens: %w", err)
+	}
+	if _, err := s.pool.Exec(ctx, `DELETE FROM refresh_tokens WHERE expires_at < NOW()`); err != nil {
+		return fmt.Errorf("postgres: delete expired refresh tokens: %w", err)
+	}
+	return nil
+}
+
+func (s *Storage) SaveSigningKey(ctx context.Context, key models.SigningKey) error {
+	const query = `INSERT INTO signing_keys (kid, algorithm, private_key, created_at, not_after, expires_at)
+		VALUES ($1, 'EdDSA', $2, $3, $4, $5)`
+
+	if _, err := s.pool.Exec(ctx, query, key.ID, []byte(key.PrivateKey.Seed()), key.CreatedAt, key.NotAfter, key.ExpiresAt); err != nil {
+		return fmt.Errorf("postgres: save signing key: %w", err)
+	}
+	return nil
+}
+
+// SigningKeys returns keys that are still published, newest first.
+func (s *Storage) SigningKeys(ctx context.Context) ([]models.SigningKey, error) {
+	const query = `SELECT kid, private_key, created_at, not_after, expires_at
+		FROM signing_keys WHERE expires_at > NOW() ORDER BY created_at DESC`
+
+	rows, err := s.pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: list signing keys: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []models.SigningKey
+	for rows.Next() {
+		var (
+			key  models.SigningKey
+			seed []byte
+		)
+		if err := rows.Scan(&key.ID, &seed, &key.CreatedAt, &key.NotAfter, &key.ExpiresAt); err != nil {
+			return nil, fmt.Errorf("postgres: scan signing key: %w", err)
+		}
+		if len(seed) != ed25519.SeedSize {
+			return nil, fmt.Errorf("postgres: signing key %s: malformed private key", key.ID)
+		}
+		key.PrivateKey = ed25519.NewKeyFromSeed(seed)
+		keys = append(keys, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres: list signing keys: %w", err)
+	}
+	return keys, nil
+}
+
+func (s *Storage) DeleteExpiredSigningKeys(ctx context.Context) error {
+	if _, err := s.pool.Exec(ctx, `DELETE FROM signing_keys WHERE expires_at < NOW()`); err != nil {
+		return fmt.Errorf("postgres: delete expired signing keys: %w", err)
+	}
+	return nil
+}
@@ -5,7 +5,9 @@ import (
 )
 
 var (
-	ErrUserExists       = errors.New("user already exists")
-	ErrUserNotFound     = errors.New("user not found")
-	ErrAppNotFound      = errors.New("app not found")
-)
\ No newline at end of file
+	ErrUserExists    = errors.New("user already exists")
+	ErrUserNotFound  = errors.New("user not found")
+	ErrAppNotFound   = errors.New("app not found")
+	ErrTokenNotFound = errors.New("token not found")
+	ErrTokenRevoked  = errors.New("token revoked")
+)
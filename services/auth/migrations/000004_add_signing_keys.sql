@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Ed25519 keys for signing access tokens; private_key holds the 32-byte seed.
+-- A key signs new tokens until not_after and stays published in JWKS until expires_at, so tokens it signed can be verified.
+CREATE TABLE IF NOT EXISTS signing_keys (
+    kid TEXT PRIMARY KEY,
+    algorithm TEXT NOT NULL,
+    private_key BYTEA NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    not_after TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS signing_keys;
+-- +goose StatementEnd
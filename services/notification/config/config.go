@@ -15,6 +15,11 @@ type Config struct {
 	DB       DBConfig
 	GRPC     GRPCConfig
 	Telegram TelegramConfig
+	Delivery DeliveryConfig
+	SMTP     SMTPConfig
+	Webhook  WebhookConfig
+	WebPush  WebPushConfig `mapstructure:"webpush"`
+	Link     LinkConfig    `mapstructure:"link_token"`
 }
 
 type DBConfig struct {
@@ -35,6 +40,41 @@ type TelegramConfig struct {
 	Bot   string `yaml:"bot_name" mapstructure:"bot_name"`
 }
 
+type DeliveryConfig struct {
+	Workers      int           `mapstructure:"workers"`
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	MaxAttempts  int           `mapstructure:"max_attempts"`
+	BaseBackoff  time.Duration `mapstructure:"base_backoff"`
+	MaxBackoff   time.Duration `mapstructure:"max_backoff"`
+	Lease        time.Duration `mapstructure:"lease"`
+}
+
+// SMTPConfig enables email notifications when Host and From are set.
+type SMTPConfig struct {
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	From     string `mapstructure:"from"`
+}
+
+type WebhookConfig struct {
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// WebPushConfig enables web push when PrivateKey (VAPID, base64url) is set.
+type WebPushConfig struct {
+	PrivateKey string        `mapstructure:"private_key"`
+	Subject    string        `mapstructure:"subject"`
+	Timeout    time.Duration `mapstructure:"timeout"`
+}
+
+// LinkConfig controls Telegram link tokens issued by CreateLinkToken.
+type LinkConfig struct {
+	TTL           time.Duration `mapstructure:"ttl"`
+	PurgeInterval time.Duration `mapstructure:"purge_interval"`
+}
+
 //go:embed config.yaml
 var defaultYAML []byte
 
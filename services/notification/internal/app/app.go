@@ -3,9 +3,16 @@ package app
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	"github.com/s1lentmol/q-flow-backend/services/notification/config"
 	grpcapp "github.com/s1lentmol/q-flow-backend/services/notification/internal/app/grpc"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/channels"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/clients/telegram"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/domain"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/messages"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/services/cleanup"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/services/delivery"
 	"github.com/s1lentmol/q-flow-backend/services/notification/internal/services/notification"
 	"github.com/s1lentmol/q-flow-backend/services/notification/internal/storage/postgres"
 	migrator "github.com/s1lentmol/q-flow-backend/services/notification/migrations"
@@ -26,7 +33,44 @@ func New(ctx context.Context, log *slog.Logger, cfg *config.Config) (*App, error
 		return nil, err
 	}
 
-	notifService := notification.New(log, store, cfg.Telegram.Token, cfg.Telegram.Bot)
+	webPush, err := channels.NewWebPush(channels.WebPushConfig{
+		PrivateKey: cfg.WebPush.PrivateKey,
+		Subject:    cfg.WebPush.Subject,
+		Timeout:    cfg.WebPush.Timeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+	chans := map[domain.Channel]channels.Channel{
+		domain.ChannelTelegram: channels.NewTelegram(telegram.New(cfg.Telegram.Token, 5*time.Second)),
+		domain.ChannelEmail: channels.NewEmail(channels.SMTPConfig{
+			Host:     cfg.SMTP.Host,
+			Port:     cfg.SMTP.Port,
+			Username: cfg.SMTP.Username,
+			Password: cfg.SMTP.Password,
+			From:     cfg.SMTP.From,
+		}),
+		domain.ChannelWebhook: channels.NewWebhook(cfg.Webhook.Timeout),
+		domain.ChannelWebPush: webPush,
+	}
+
+	msgs, err := messages.New()
+	if err != nil {
+		return nil, err
+	}
+
+	notifService := notification.New(log, store, cfg.Telegram.Bot, chans, webPush.PublicKey(), cfg.Link.TTL, msgs)
+
+	worker := delivery.New(log, store, chans, delivery.Config{
+		Workers:      cfg.Delivery.Workers,
+		PollInterval: cfg.Delivery.PollInterval,
+		MaxAttempts:  cfg.Delivery.MaxAttempts,
+		BaseBackoff:  cfg.Delivery.BaseBackoff,
+		MaxBackoff:   cfg.Delivery.MaxBackoff,
+		Lease:        cfg.Delivery.Lease,
+	})
+	go worker.Run(ctx)
+	go cleanup.New(log, store, cfg.Link.PurgeInterval).Run(ctx)
 
 	grpcApp := grpcapp.New(log, notifService, cfg.GRPC.Port)
 
@@ -0,0 +1,56 @@
+package channels
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/domain"
+)
+
+// ErrInvalidEndpoint is returned by Validate for an address the channel cannot deliver to.
+var ErrInvalidEndpoint = errors.New("invalid notification channel endpoint")
+
+// Channel delivers notifications to endpoints of one kind.
+type Channel interface {
+	// Enabled tells whether the channel is configured; notifications to a
+	// disabled channel are only logged.
+	Enabled() bool
+	Validate(e domain.Endpoint) error
+	Send(ctx context.Context, to domain.Endpoint, n domain.Notification) error
+}
+
+// Error describes a failed send to the delivery worker.
+type Error struct {
+	Err error
+	// Permanent failures are not retried.
+	Permanent bool
+	// RetryAfter is the delay asked for by the remote side, if any.
+	RetryAfter time.Duration
+}
+
+func (e *Error) Error() string { return e.Err.Error() }
+func (e *Error) Unwrap() error { return e.Err }
+
+// Permanent marks err as not worth retrying.
+func Permanent(err error) error {
+	return &Error{Err: err, Permanent: true}
+}
+
+// httpError classifies an unsuccessful HTTP response: rate limits and server
+// errors are retried, other client errors are not.
+func httpError(resp *http.Response) error {
+	err := &Error{Err: fmt.Errorf("remote returned status %d", resp.StatusCode)}
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		if sec, convErr := strconv.Atoi(resp.Header.Get("Retry-After")); convErr == nil {
+			err.RetryAfter = time.Duration(sec) * time.Second
+		}
+	case resp.StatusCode < 500:
+		err.Permanent = true
+	}
+	return err
+}
@@ -0,0 +1,73 @@
+package channels
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/domain"
+)
+
+const emailSubject = "Q-Flow: уведомление"
+
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+// Email sends plain text letters through an SMTP relay.
+type Email struct {
+	cfg SMTPConfig
+}
+
+func NewEmail(cfg SMTPConfig) *Email {
+	return &Email{cfg: cfg}
+}
+
+func (e *Email) Enabled() bool {
+	return e.cfg.Host != "" && e.cfg.From != ""
+}
+
+func (e *Email) Validate(to domain.Endpoint) error {
+	if _, err := mail.ParseAddress(to.Address); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidEndpoint, err)
+	}
+	return nil
+}
+
+// Send ignores ctx: net/smtp has no way to cancel a conversation.
+func (e *Email) Send(_ context.Context, to domain.Endpoint, n domain.Notification) error {
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", e.cfg.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", to.Address)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", emailSubject))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	msg.WriteString("Content-Transfer-Encoding: 8bit\r\n\r\n")
+	msg.WriteString(n.Text)
+	msg.WriteString("\r\n")
+
+	var auth smtp.Auth
+	if e.cfg.Username != "" {
+		auth = smtp.PlainAuth("", e.cfg.Username, e.cfg.Password, e.cfg.Host)
+	}
+	addr := net.JoinHostPort(e.cfg.Host, strconv.Itoa(e.cfg.Port))
+
+	err := smtp.SendMail(addr, auth, e.cfg.From, []string{to.Address}, msg.Bytes())
+	// 5xx replies (unknown mailbox, rejected sender) will not change on retry.
+	var protoErr *textproto.Error
+	if errors.As(err, &protoErr) && protoErr.Code >= 500 {
+		return Permanent(err)
+	}
+	return err
+}
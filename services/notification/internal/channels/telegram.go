@@ -0,0 +1,42 @@
+package channels
+
+import (
+	"context"
+	"errors"
+
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/clients/telegram"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/domain"
+)
+
+// Telegram sends messages through the bot. The endpoint address is the chat id
+// or @username taken from the user's contact.
+type Telegram struct {
+	client *telegram.Client
+}
+
+func NewTelegram(client *telegram.Client) *Telegram {
+	return &Telegram{client: client}
+}
+
+func (t *Telegram) Enabled() bool {
+	return t.client.Enabled()
+}
+
+// Validate accepts any endpoint: the chat is bound separately through the bot.
+func (t *Telegram) Validate(domain.Endpoint) error {
+	return nil
+}
+
+func (t *Telegram) Send(ctx context.Context, to domain.Endpoint, n domain.Notification) error {
+	var err error
+	if n.Button != nil {
+		err = t.client.SendMessageWithButton(ctx, to.Address, n.Text, n.Button.Text, n.Button.Data)
+	} else {
+		err = t.client.SendMessage(ctx, to.Address, n.Text)
+	}
+	var apiErr *telegram.APIError
+	if errors.As(err, &apiErr) {
+		return &Error{Err: err, Permanent: !apiErr.Temporary(), RetryAfter: apiErr.RetryAfter}
+	}
+	return err
+}
@@ -0,0 +1,82 @@
+package channels
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/domain"
+)
+
+// Webhook posts notifications as JSON to a URL chosen by the user. With a
+// secret set, the body is signed with HMAC-SHA256 in X-QFlow-Signature.
+type Webhook struct {
+	httpClient *http.Client
+}
+
+func NewWebhook(timeout time.Duration) *Webhook {
+	return &Webhook{httpClient: &http.Client{Timeout: timeout}}
+}
+
+func (w *Webhook) Enabled() bool {
+	return true
+}
+
+func (w *Webhook) Validate(to domain.Endpoint) error {
+	u, err := url.Parse(to.Address)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: webhook needs an absolute http(s) URL", ErrInvalidEndpoint)
+	}
+	return nil
+}
+
+type webhookPayload struct {
+	ID        int64  `json:"id"`
+	UserID    int64  `json:"user_id"`
+	Kind      string `json:"kind"`
+	Text      string `json:"text"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+func (w *Webhook) Send(ctx context.Context, to domain.Endpoint, n domain.Notification) error {
+	body, err := json.Marshal(webhookPayload{
+		ID:        n.ID,
+		UserID:    n.UserID,
+		Kind:      string(n.Kind),
+		Text:      n.Text,
+		CreatedAt: n.CreatedAt.Unix(),
+	})
+	if err != nil {
+		return Permanent(fmt.Errorf("encode webhook payload: %w", err))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, to.Address, bytes.NewReader(body))
+	if err != nil {
+		return Permanent(fmt.Errorf("build request: %w", err))
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-QFlow-Event", string(n.Kind))
+	if to.Secret != "" {
+		mac := hmac.New(sha256.New, []byte(to.Secret))
+		mac.Write(body)
+		req.Header.Set("X-QFlow-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return httpError(resp)
+	}
+	return nil
+}
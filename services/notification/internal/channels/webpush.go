@@ -0,0 +1,233 @@
+package channels
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/domain"
+)
+
+const (
+	pushTTL        = 24 * time.Hour
+	vapidTokenTTL  = 12 * time.Hour
+	pushRecordSize = 4096
+	pushKeyLen     = 65
+	pushAuthLen    = 16
+)
+
+type WebPushConfig struct {
+	// PrivateKey is the VAPID P-256 private key, base64url without padding.
+	PrivateKey string
+	// Subject is a mailto: or https: contact for the push service.
+	Subject string
+	Timeout time.Duration
+}
+
+// WebPush sends encrypted messages (RFC 8291) to browser push subscriptions,
+// authenticating with VAPID (RFC 8292).
+type WebPush struct {
+	key        *ecdsa.PrivateKey
+	publicKey  string
+	subject    string
+	httpClient *http.Client
+}
+
+// NewWebPush parses the VAPID key. An empty key gives a disabled channel.
+func NewWebPush(cfg WebPushConfig) (*WebPush, error) {
+	w := &WebPush{subject: cfg.Subject, httpClient: &http.Client{Timeout: cfg.Timeout}}
+	if cfg.PrivateKey == "" {
+		return w, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(cfg.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("webpush: decode vapid key: %w", err)
+	}
+	key, err := ecdsa.ParseRawPrivateKey(elliptic.P256(), raw)
+	if err != nil {
+		return nil, fmt.Errorf("webpush: parse vapid key: %w", err)
+	}
+	pub, err := key.PublicKey.Bytes()
+	if err != nil {
+		return nil, fmt.Errorf("webpush: vapid public key: %w", err)
+	}
+	w.key = key
+	w.publicKey = base64.RawURLEncoding.EncodeToString(pub)
+	return w, nil
+}
+
+func (w *WebPush) Enabled() bool {
+	return w.key != nil
+}
+
+// PublicKey is the applicationServerKey browsers subscribe with.
+func (w *WebPush) PublicKey() string {
+	return w.publicKey
+}
+
+func (w *WebPush) Validate(to domain.Endpoint) error {
+	u, err := url.Parse(to.Address)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("%w: push endpoint must be an https URL", ErrInvalidEndpoint)
+	}
+	if key, err := base64.RawURLEncoding.DecodeString(to.P256dh); err != nil || len(key) != pushKeyLen {
+		return fmt.Errorf("%w: bad p256dh key", ErrInvalidEndpoint)
+	}
+	if auth, err := base64.RawURLEncoding.DecodeString(to.Auth); err != nil || len(auth) != pushAuthLen {
+		return fmt.Errorf("%w: bad auth secret", ErrInvalidEndpoint)
+	}
+	return nil
+}
+
+type pushPayload struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	Kind  string `json:"kind"`
+}
+
+func (w *WebPush) Send(ctx context.Context, to domain.Endpoint, n domain.Notification) error {
+	payload, err := json.Marshal(pushPayload{Title: "Q-Flow", Body: n.Text, Kind: string(n.Kind)})
+	if err != nil {
+		return Permanent(fmt.Errorf("encode push payload: %w", err))
+	}
+	body, err := encryptPush(to, payload)
+	if err != nil {
+		return Permanent(err)
+	}
+	token, err := w.vapidToken(to.Address)
+	if err != nil {
+		return Permanent(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, to.Address, bytes.NewReader(body))
+	if err != nil {
+		return Permanent(fmt.Errorf("build request: %w", err))
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("TTL", fmt.Sprint(int(pushTTL.Seconds())))
+	req.Header.Set("Authorization", fmt.Sprintf("vapid t=%s, k=%s", token, w.publicKey))
+
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("send push: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// 404 and 410 mean the subscription is gone and fall into permanent errors.
+	if resp.StatusCode >= 300 {
+		return httpError(resp)
+	}
+	return nil
+}
+
+// encryptPush encrypts payload for the subscription as a single aes128gcm record.
+func encryptPush(to domain.Endpoint, payload []byte) ([]byte, error) {
+	uaRaw, err := base64.RawURLEncoding.DecodeString(to.P256dh)
+	if err != nil {
+		return nil, fmt.Errorf("decode p256dh: %w", err)
+	}
+	authSecret, err := base64.RawURLEncoding.DecodeString(to.Auth)
+	if err != nil {
+		return nil, fmt.Errorf("decode auth: %w", err)
+	}
+	uaPublic, err := ecdh.P256().NewPublicKey(uaRaw)
+	if err != nil {
+		return nil, fmt.Errorf("parse p256dh: %w", err)
+	}
+
+	asPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generate key: %w", err)
+	}
+	shared, err := asPrivate.ECDH(uaPublic)
+	if err != nil {
+		return nil, fmt.Errorf("ecdh: %w", err)
+	}
+	asRaw := asPrivate.PublicKey().Bytes()
+
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("generate salt: %w", err)
+	}
+
+	keyInfo := "WebPush: info\x00" + string(uaRaw) + string(asRaw)
+	ikm, err := hkdf.Key(sha256.New, shared, authSecret, keyInfo, 32)
+	if err != nil {
+		return nil, fmt.Errorf("derive ikm: %w", err)
+	}
+	cek, err := hkdf.Key(sha256.New, ikm, salt, "Content-Encoding: aes128gcm\x00", 16)
+	if err != nil {
+		return nil, fmt.Errorf("derive key: %w", err)
+	}
+	nonce, err := hkdf.Key(sha256.New, ikm, salt, "Content-Encoding: nonce\x00", 12)
+	if err != nil {
+		return nil, fmt.Errorf("derive nonce: %w", err)
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, fmt.Errorf("cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("gcm: %w", err)
+	}
+
+	// Header: salt, record size, key id length and the sender's public key.
+	var body bytes.Buffer
+	body.Write(salt)
+	_ = binary.Write(&body, binary.BigEndian, uint32(pushRecordSize))
+	body.WriteByte(byte(len(asRaw)))
+	body.Write(asRaw)
+
+	// 0x02 marks the last (and only) record.
+	plain := append(append([]byte{}, payload...), 0x02)
+	body.Write(gcm.Seal(nil, nonce, plain, nil))
+	return body.Bytes(), nil
+}
+
+// vapidToken signs an ES256 JWT for the push service that owns endpoint.
+func (w *WebPush) vapidToken(endpoint string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("parse endpoint: %w", err)
+	}
+
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"typ":"JWT","alg":"ES256"}`))
+	claims, err := json.Marshal(map[string]any{
+		"aud": u.Scheme + "://" + u.Host,
+		"exp": time.Now().Add(vapidTokenTTL).Unix(),
+		"sub": w.subject,
+	})
+	if err != nil {
+		return "", fmt.Errorf("encode claims: %w", err)
+	}
+	signingInput := header + "." + base64.RawURLEncoding.EncodeToString(claims)
+
+	digest := sha256.Sum256([]byte(signingInput))
+	r, s, err := ecdsa.Sign(rand.Reader, w.key, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("sign vapid token: %w", err)
+	}
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
@@ -0,0 +1,106 @@
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const apiURL = "https://api.telegram.org"
+
+// Client talks to the Telegram Bot API.
+type Client struct {
+	token      string
+	httpClient *http.Client
+}
+
+func New(token string, timeout time.Duration) *Client {
+	return &Client{token: token, httpClient: &http.Client{Timeout: timeout}}
+}
+
+// Enabled tells whether a bot token is configured.
+func (c *Client) Enabled() bool {
+	return c.token != ""
+}
+
+// APIError is an error returned by the Bot API.
+type APIError struct {
+	StatusCode  int
+	Description string
+	// RetryAfter is set when Telegram rate-limits the bot (429).
+	RetryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("telegram API returned status %d: %s", e.StatusCode, e.Description)
+}
+
+// Temporary tells whether the request may succeed if repeated later: rate
+// limits and server errors are, a blocked bot or an unknown chat are not.
+func (e *APIError) Temporary() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+}
+
+type apiResponse struct {
+	OK          bool   `json:"ok"`
+	ErrorCode   int    `json:"error_code"`
+	Description string `json:"description"`
+	Parameters  struct {
+		RetryAfter int `json:"retry_after"`
+	} `json:"parameters"`
+}
+
+func (c *Client) SendMessage(ctx context.Context, chatID, text string) error {
+	values := url.Values{}
+	values.Set("chat_id", chatID)
+	values.Set("text", text)
+	return c.call(ctx, "sendMessage", values)
+}
+
+// SendMessageWithButton sends text with one inline button under it; pressing
+// the button sends data back to the bot as a callback query.
+func (c *Client) SendMessageWithButton(ctx context.Context, chatID, text, buttonText, data string) error {
+	markup, err := json.Marshal(map[string]any{
+		"inline_keyboard": [][]map[string]string{{{"text": buttonText, "callback_data": data}}},
+	})
+	if err != nil {
+		return fmt.Errorf("encode reply markup: %w", err)
+	}
+	values := url.Values{}
+	values.Set("chat_id", chatID)
+	values.Set("text", text)
+	values.Set("reply_markup", string(markup))
+	return c.call(ctx, "sendMessage", values)
+}
+
+func (c *Client) call(ctx context.Context, method string, values url.Values) error {
+	endpoint := fmt.Sprintf("%s/bot%s/%s", apiURL, c.token, method)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(values.Encode()))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("send telegram request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 300 {
+		return nil
+	}
+
+	apiErr := &APIError{StatusCode: resp.StatusCode}
+	var body apiResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err == nil {
+		apiErr.Description = body.Description
+		apiErr.RetryAfter = time.Duration(body.Parameters.RetryAfter) * time.Second
+	}
+	return apiErr
+}
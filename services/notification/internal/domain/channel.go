@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+type Channel string
+
+const (
+	ChannelTelegram Channel = "telegram"
+	ChannelEmail    Channel = "email"
+	ChannelWebhook  Channel = "webhook"
+	ChannelWebPush  Channel = "webpush"
+)
+
+// Endpoint is where a user receives notifications of one channel. Address is
+// an email, a webhook URL or a push service endpoint; Telegram takes its chat
+// from the contact, so its endpoint only switches the channel on and off.
+type Endpoint struct {
+	UserID  int64
+	Channel Channel
+	Address string
+	// Secret signs webhook requests.
+	Secret string
+	// P256dh and Auth are the keys of a web push subscription.
+	P256dh    string
+	Auth      string
+	Enabled   bool
+	UpdatedAt time.Time
+}
@@ -7,5 +7,6 @@ type LinkToken struct {
 	UserID   int64
 	Username string
 	Created  time.Time
+	Expires  time.Time
 	UsedAt   *time.Time
 }
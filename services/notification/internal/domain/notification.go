@@ -0,0 +1,62 @@
+package domain
+
+import "time"
+
+type NotificationKind string
+
+const (
+	KindPositionSoon  NotificationKind = "position_soon"
+	KindYourTurn      NotificationKind = "your_turn"
+	KindQueueOpened   NotificationKind = "queue_opened"
+	KindQueueArchived NotificationKind = "queue_archived"
+	KindRemoved       NotificationKind = "removed"
+	KindSwap          NotificationKind = "swap"
+	// KindCalled asks a called participant to check in. It cannot be switched
+	// off or muted: the queue skips whoever does not check in in time.
+	KindCalled NotificationKind = "called"
+)
+
+// Kinds lists every kind a user can switch off.
+var Kinds = []NotificationKind{KindPositionSoon, KindYourTurn, KindQueueOpened, KindQueueArchived, KindRemoved, KindSwap}
+
+type NotificationStatus string
+
+const (
+	StatusPending NotificationStatus = "pending"
+	StatusSent    NotificationStatus = "sent"
+	StatusFailed  NotificationStatus = "failed"
+)
+
+// Notification is a message to a user and the state of its delivery.
+// LastError keeps the reason of the latest failed attempt.
+type Notification struct {
+	ID            int64
+	UserID        int64
+	Channel       Channel
+	Kind          NotificationKind
+	Text          string
+	Button        *Button
+	Status        NotificationStatus
+	Attempts      int
+	LastError     string
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	SentAt        *time.Time
+}
+
+// Button is shown under the message by channels that support it. Data is
+// handed back to the bot when the button is pressed.
+type Button struct {
+	Text string
+	Data string
+}
+
+// NotificationFilter narrows ListNotifications. Zero values mean "no filter".
+// Notifications are returned newest first; BeforeID is the pagination cursor.
+type NotificationFilter struct {
+	UserID   int64
+	Status   NotificationStatus
+	BeforeID int64
+	Limit    int
+}
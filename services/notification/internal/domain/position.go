@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+// DefaultNotifyTop is the head size used when the queue does not send its own.
+const DefaultNotifyTop = 3
+
+// PositionUpdate is a participant's position as reported by the queue service.
+// ParticipantID identifies the queue entry: rejoining creates a new one.
+type PositionUpdate struct {
+	UserID        int64
+	QueueID       int64
+	ParticipantID int64
+	QueueTitle    string
+	Position      int32
+	NotifyTop     int32
+	// Added is set when a moderator has just put the user into the queue.
+	Added bool
+}
+
+// PositionNotice is the last position reported for a user in a queue.
+type PositionNotice struct {
+	UserID        int64
+	QueueID       int64
+	ParticipantID int64
+	Position      int32
+	UpdatedAt     time.Time
+}
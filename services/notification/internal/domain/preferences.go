@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+const DefaultTimezone = "Europe/Moscow"
+
+// Preferences are the user's notification settings. A user without a row
+// gets the defaults: every kind enabled, the queue's own head size, no quiet
+// hours.
+type Preferences struct {
+	UserID        int64
+	Locale        string
+	DisabledKinds []NotificationKind
+	// NotifyTop narrows the queue's head size when positive; it cannot widen
+	// it, positions beyond the queue's head are not reported.
+	NotifyTop   int32
+	Quiet       *QuietHours
+	Timezone    string
+	MutedQueues []int64
+	UpdatedAt   time.Time
+}
+
+func (p Preferences) KindEnabled(kind NotificationKind) bool {
+	return !slices.Contains(p.DisabledKinds, kind)
+}
+
+func (p Preferences) QueueMuted(queueID int64) bool {
+	return queueID != 0 && slices.Contains(p.MutedQueues, queueID)
+}
+
+// QuietHours is a daily window, in minutes after midnight, when nothing is
+// sent. From greater than To spans midnight, e.g. 23:00–07:00.
+type QuietHours struct {
+	From int
+	To   int
+}
+
+// Contains tells whether t, taken in loc, falls into the window.
+func (q QuietHours) Contains(t time.Time, loc *time.Location) bool {
+	t = t.In(loc)
+	m := t.Hour()*60 + t.Minute()
+	if q.From < q.To {
+		return m >= q.From && m < q.To
+	}
+	return m >= q.From || m < q.To
+}
+
+// End returns the first moment after t when the window is over.
+func (q QuietHours) End(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	end := time.Date(t.Year(), t.Month(), t.Day(), q.To/60, q.To%60, 0, 0, loc)
+	if !end.After(t) {
+		end = end.AddDate(0, 0, 1)
+	}
+	return end
+}
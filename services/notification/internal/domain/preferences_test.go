@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQuietHoursContains(t *testing.T) {
+	day := QuietHours{From: 13 * 60, To: 14 * 60}
+	night := QuietHours{From: 23 * 60, To: 7 * 60}
+	loc := time.FixedZone("UTC+3", 3*60*60)
+	at := func(hour, min int) time.Time { return time.Date(2026, 3, 2, hour, min, 0, 0, loc) }
+
+	tests := []struct {
+		name  string
+		quiet QuietHours
+		t     time.Time
+		want  bool
+	}{
+		{"day, before", day, at(12, 59), false},
+		{"day, from is inside", day, at(13, 0), true},
+		{"day, inside", day, at(13, 30), true},
+		{"day, to is outside", day, at(14, 0), false},
+		{"night, evening before", night, at(22, 59), false},
+		{"night, from is inside", night, at(23, 0), true},
+		{"night, before midnight", night, at(23, 59), true},
+		{"night, midnight", night, at(0, 0), true},
+		{"night, early morning", night, at(6, 59), true},
+		{"night, to is outside", night, at(7, 0), false},
+		{"night, midday", night, at(12, 0), false},
+		{"taken in loc", night, time.Date(2026, 3, 2, 20, 30, 0, 0, time.UTC), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.quiet.Contains(tt.t, loc); got != tt.want {
+				t.Errorf("Contains(%s) = %v, want %v", tt.t.In(loc).Format("15:04"), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuietHoursEnd(t *testing.T) {
+	night := QuietHours{From: 23 * 60, To: 7 * 60}
+	day := QuietHours{From: 13 * 60, To: 14*60 + 30}
+	loc := time.FixedZone("UTC+3", 3*60*60)
+	at := func(d, hour, min int) time.Time { return time.Date(2026, 3, d, hour, min, 0, 0, loc) }
+
+	tests := []struct {
+		name  string
+		quiet QuietHours
+		t     time.Time
+		want  time.Time
+	}{
+		{"night, before midnight ends next morning", night, at(2, 23, 30), at(3, 7, 0)},
+		{"night, after midnight ends this morning", night, at(3, 1, 15), at(3, 7, 0)},
+		{"night, at the end moves a day on", night, at(3, 7, 0), at(4, 7, 0)},
+		{"day, ends the same day", day, at(2, 13, 10), at(2, 14, 30)},
+		{"taken in loc", night, time.Date(2026, 3, 2, 22, 0, 0, 0, time.UTC), at(3, 7, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.quiet.End(tt.t, loc); !got.Equal(tt.want) {
+				t.Errorf("End = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	notificationv1 "github.com/s1lentmol/q-flow-backend/protos/gen/go/notification"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/channels"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/domain"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/services/notification"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverAPI) SetChannel(ctx context.Context, req *notificationv1.SetChannelRequest) (*notificationv1.SetChannelResponse, error) {
+	input := struct {
+		UserID  int64  `validate:"required,gt=0" json:"user_id"`
+		Channel string `validate:"required,oneof=telegram email webhook webpush" json:"channel"`
+		Address string `validate:"max=2048" json:"address"`
+	}{
+		UserID:  req.GetUserId(),
+		Channel: req.GetChannel(),
+		Address: req.GetAddress(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	e, err := s.notif.SetEndpoint(ctx, domain.Endpoint{
+		UserID:  req.GetUserId(),
+		Channel: domain.Channel(req.GetChannel()),
+		Address: req.GetAddress(),
+		Secret:  req.GetSecret(),
+		P256dh:  req.GetP256Dh(),
+		Auth:    req.GetAuth(),
+		Enabled: req.GetEnabled(),
+	})
+	if err != nil {
+		return nil, mapChannelErr(err, "failed to set channel")
+	}
+	return &notificationv1.SetChannelResponse{Channel: toChannelDTO(e)}, nil
+}
+
+func (s *serverAPI) ListChannels(ctx context.Context, req *notificationv1.ListChannelsRequest) (*notificationv1.ListChannelsResponse, error) {
+	input := struct {
+		UserID int64 `validate:"required,gt=0" json:"user_id"`
+	}{
+		UserID: req.GetUserId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	list, err := s.notif.ListEndpoints(ctx, req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list channels")
+	}
+	dtos := make([]*notificationv1.ChannelDTO, 0, len(list))
+	for _, e := range list {
+		dtos = append(dtos, toChannelDTO(e))
+	}
+	return &notificationv1.ListChannelsResponse{Channels: dtos}, nil
+}
+
+func (s *serverAPI) DeleteChannel(ctx context.Context, req *notificationv1.DeleteChannelRequest) (*notificationv1.DeleteChannelResponse, error) {
+	input := struct {
+		UserID  int64  `validate:"required,gt=0" json:"user_id"`
+		Channel string `validate:"required,oneof=telegram email webhook webpush" json:"channel"`
+	}{
+		UserID:  req.GetUserId(),
+		Channel: req.GetChannel(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	if err := s.notif.DeleteEndpoint(ctx, req.GetUserId(), domain.Channel(req.GetChannel())); err != nil {
+		return nil, mapChannelErr(err, "failed to delete channel")
+	}
+	return &notificationv1.DeleteChannelResponse{}, nil
+}
+
+func (s *serverAPI) GetWebPushKey(context.Context, *notificationv1.GetWebPushKeyRequest) (*notificationv1.GetWebPushKeyResponse, error) {
+	return &notificationv1.GetWebPushKeyResponse{PublicKey: s.notif.WebPushKey()}, nil
+}
+
+func mapChannelErr(err error, fallback string) error {
+	switch {
+	case errors.Is(err, channels.ErrInvalidEndpoint):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, notification.ErrUnknownChannel):
+		return status.Error(codes.InvalidArgument, "unknown channel")
+	case errors.Is(err, storage.ErrEndpointNotFound):
+		return status.Error(codes.NotFound, "channel not found")
+	default:
+		return status.Error(codes.Internal, fallback)
+	}
+}
+
+func toChannelDTO(e domain.Endpoint) *notificationv1.ChannelDTO {
+	return &notificationv1.ChannelDTO{
+		Channel:   string(e.Channel),
+		Address:   e.Address,
+		Enabled:   e.Enabled,
+		HasSecret: e.Secret != "",
+		UpdatedAt: e.UpdatedAt.Unix(),
+	}
+}
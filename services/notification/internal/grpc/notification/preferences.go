@@ -0,0 +1,137 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	notificationv1 "github.com/s1lentmol/q-flow-backend/protos/gen/go/notification"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/domain"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/services/notification"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *serverAPI) GetPreferences(ctx context.Context, req *notificationv1.GetPreferencesRequest) (*notificationv1.GetPreferencesResponse, error) {
+	input := struct {
+		UserID int64 `validate:"required,gt=0" json:"user_id"`
+	}{
+		UserID: req.GetUserId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	p, err := s.notif.GetPreferences(ctx, req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get preferences")
+	}
+	return &notificationv1.GetPreferencesResponse{Preferences: s.toPreferencesDTO(p)}, nil
+}
+
+func (s *serverAPI) UpdatePreferences(ctx context.Context, req *notificationv1.UpdatePreferencesRequest) (*notificationv1.UpdatePreferencesResponse, error) {
+	input := struct {
+		UserID    int64  `validate:"required,gt=0" json:"user_id"`
+		NotifyTop int32  `validate:"gte=0,lte=50" json:"notify_top"`
+		QuietFrom string `validate:"required_with=QuietTo,omitempty,datetime=15:04" json:"quiet_from"`
+		QuietTo   string `validate:"required_with=QuietFrom,omitempty,datetime=15:04" json:"quiet_to"`
+	}{
+		UserID:    req.GetUserId(),
+		NotifyTop: req.GetNotifyTop(),
+		QuietFrom: req.GetQuietFrom(),
+		QuietTo:   req.GetQuietTo(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	prefs := domain.Preferences{
+		UserID:    req.GetUserId(),
+		Locale:    req.GetLocale(),
+		NotifyTop: req.GetNotifyTop(),
+		Timezone:  req.GetTimezone(),
+	}
+	for _, k := range req.GetDisabledKinds() {
+		prefs.DisabledKinds = append(prefs.DisabledKinds, domain.NotificationKind(k))
+	}
+	if req.GetQuietFrom() != "" {
+		prefs.Quiet = &domain.QuietHours{From: parseClock(req.GetQuietFrom()), To: parseClock(req.GetQuietTo())}
+	}
+
+	p, err := s.notif.UpdatePreferences(ctx, prefs)
+	if err != nil {
+		if errors.Is(err, notification.ErrInvalidPreferences) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to update preferences")
+	}
+	return &notificationv1.UpdatePreferencesResponse{Preferences: s.toPreferencesDTO(p)}, nil
+}
+
+func (s *serverAPI) MuteQueue(ctx context.Context, req *notificationv1.MuteQueueRequest) (*notificationv1.MuteQueueResponse, error) {
+	if err := validateMute(req.GetUserId(), req.GetQueueId()); err != nil {
+		return nil, err
+	}
+	if err := s.notif.MuteQueue(ctx, req.GetUserId(), req.GetQueueId()); err != nil {
+		return nil, status.Error(codes.Internal, "failed to mute queue")
+	}
+	return &notificationv1.MuteQueueResponse{}, nil
+}
+
+func (s *serverAPI) UnmuteQueue(ctx context.Context, req *notificationv1.UnmuteQueueRequest) (*notificationv1.UnmuteQueueResponse, error) {
+	if err := validateMute(req.GetUserId(), req.GetQueueId()); err != nil {
+		return nil, err
+	}
+	if err := s.notif.UnmuteQueue(ctx, req.GetUserId(), req.GetQueueId()); err != nil {
+		return nil, status.Error(codes.Internal, "failed to unmute queue")
+	}
+	return &notificationv1.UnmuteQueueResponse{}, nil
+}
+
+func validateMute(userID, queueID int64) error {
+	input := struct {
+		UserID  int64 `validate:"required,gt=0" json:"user_id"`
+		QueueID int64 `validate:"required,gt=0" json:"queue_id"`
+	}{
+		UserID:  userID,
+		QueueID: queueID,
+	}
+	if err := validate.Struct(input); err != nil {
+		return status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+	return nil
+}
+
+func (s *serverAPI) toPreferencesDTO(p domain.Preferences) *notificationv1.PreferencesDTO {
+	dto := &notificationv1.PreferencesDTO{
+		Locale:           p.Locale,
+		AvailableLocales: s.notif.Locales(),
+		NotifyTop:        p.NotifyTop,
+		Timezone:         p.Timezone,
+		MutedQueueIds:    p.MutedQueues,
+	}
+	for _, k := range p.DisabledKinds {
+		dto.DisabledKinds = append(dto.DisabledKinds, string(k))
+	}
+	for _, k := range domain.Kinds {
+		dto.AvailableKinds = append(dto.AvailableKinds, string(k))
+	}
+	if p.Quiet != nil {
+		dto.QuietFrom, dto.QuietTo = formatClock(p.Quiet.From), formatClock(p.Quiet.To)
+	}
+	if !p.UpdatedAt.IsZero() {
+		dto.UpdatedAt = p.UpdatedAt.Unix()
+	}
+	return dto
+}
+
+// parseClock turns an already validated HH:MM into minutes after midnight.
+func parseClock(v string) int {
+	t, _ := time.Parse("15:04", v)
+	return t.Hour()*60 + t.Minute()
+}
+
+func formatClock(m int) string {
+	return fmt.Sprintf("%02d:%02d", m/60, m%60)
+}
@@ -6,9 +6,12 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	notificationv1 "github.com/s1lentmol/q-flow-backend/protos/gen/go/notification"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/domain"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/storage"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -16,9 +19,29 @@ import (
 
 type Notification interface {
 	SetContact(ctx context.Context, userID int64, username, chatID string) error
-	NotifyPositionSoon(ctx context.Context, userID int64, queueTitle string, position int32) error
-	CreateLinkToken(ctx context.Context, userID int64, username string) (token string, link string, err error)
+	NotifyPositionSoon(ctx context.Context, u domain.PositionUpdate) ([]int64, error)
+	NotifyQueueOpened(ctx context.Context, userIDs []int64, queueID int64, queueTitle string) ([]int64, error)
+	NotifyQueueArchived(ctx context.Context, userIDs []int64, queueID int64, queueTitle string) ([]int64, error)
+	NotifyRemoved(ctx context.Context, userID, queueID int64, queueTitle string) ([]int64, error)
+	NotifyCalled(ctx context.Context, userID, queueID int64, queueTitle string, timeout time.Duration) ([]int64, error)
+	NotifySwapRequested(ctx context.Context, userID, queueID int64, queueTitle string, requestID int64, fromName string, position int32, timeout time.Duration) ([]int64, error)
+	NotifySwapAnswered(ctx context.Context, userID, queueID int64, queueTitle string, accepted bool, position int32) ([]int64, error)
+	GetContact(ctx context.Context, userID int64) (domain.Contact, error)
+	UnlinkContact(ctx context.Context, userID int64) error
+	CreateLinkToken(ctx context.Context, userID int64, username string) (token string, link string, expires time.Time, err error)
 	BindByToken(ctx context.Context, token, chatID, username string) error
+	ResolveChat(ctx context.Context, chatID string) (int64, error)
+	GetDeliveryStatus(ctx context.Context, id, userID int64) (domain.Notification, error)
+	ListNotifications(ctx context.Context, filter domain.NotificationFilter) ([]domain.Notification, int64, error)
+	SetEndpoint(ctx context.Context, e domain.Endpoint) (domain.Endpoint, error)
+	ListEndpoints(ctx context.Context, userID int64) ([]domain.Endpoint, error)
+	DeleteEndpoint(ctx context.Context, userID int64, channel domain.Channel) error
+	WebPushKey() string
+	GetPreferences(ctx context.Context, userID int64) (domain.Preferences, error)
+	UpdatePreferences(ctx context.Context, p domain.Preferences) (domain.Preferences, error)
+	MuteQueue(ctx context.Context, userID, queueID int64) error
+	UnmuteQueue(ctx context.Context, userID, queueID int64) error
+	Locales() []string
 }
 
 type serverAPI struct {
@@ -35,20 +58,169 @@ func (s *serverAPI) NotifyPositionSoon(ctx context.Context, req *notificationv1.
 		UserID     int64  `validate:"required,gt=0" json:"user_id"`
 		QueueTitle string `validate:"required" json:"queue_title"`
 		Position   int32  `validate:"required,gt=0" json:"position"`
+		QueueID    int64  `validate:"required,gt=0" json:"queue_id"`
+		NotifyTop  int32  `validate:"gte=0" json:"notify_top"`
 	}{
 		UserID:     req.GetUserId(),
 		QueueTitle: req.GetQueueTitle(),
 		Position:   req.GetPosition(),
+		QueueID:    req.GetQueueId(),
+		NotifyTop:  req.GetNotifyTop(),
 	}
 	if err := validate.Struct(input); err != nil {
 		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
 	}
 
-	if err := s.notif.NotifyPositionSoon(ctx, req.GetUserId(), req.GetQueueTitle(), req.GetPosition()); err != nil {
+	ids, err := s.notif.NotifyPositionSoon(ctx, domain.PositionUpdate{
+		UserID:        req.GetUserId(),
+		QueueID:       req.GetQueueId(),
+		ParticipantID: req.GetParticipantId(),
+		QueueTitle:    req.GetQueueTitle(),
+		Position:      req.GetPosition(),
+		NotifyTop:     req.GetNotifyTop(),
+		Added:         req.GetAdded(),
+	})
+	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to send notification")
 	}
 
-	return &notificationv1.NotifyPositionSoonResponse{}, nil
+	return &notificationv1.NotifyPositionSoonResponse{NotificationIds: ids}, nil
+}
+
+func (s *serverAPI) NotifyQueueOpened(ctx context.Context, req *notificationv1.NotifyQueueOpenedRequest) (*notificationv1.NotifyQueueOpenedResponse, error) {
+	input := struct {
+		UserIDs    []int64 `validate:"required,dive,gt=0" json:"user_ids"`
+		QueueTitle string  `validate:"required" json:"queue_title"`
+	}{
+		UserIDs:    req.GetUserIds(),
+		QueueTitle: req.GetQueueTitle(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	ids, err := s.notif.NotifyQueueOpened(ctx, req.GetUserIds(), req.GetQueueId(), req.GetQueueTitle())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to send notification")
+	}
+
+	return &notificationv1.NotifyQueueOpenedResponse{NotificationIds: ids}, nil
+}
+
+func (s *serverAPI) NotifyQueueArchived(ctx context.Context, req *notificationv1.NotifyQueueArchivedRequest) (*notificationv1.NotifyQueueArchivedResponse, error) {
+	input := struct {
+		UserIDs    []int64 `validate:"required,dive,gt=0" json:"user_ids"`
+		QueueTitle string  `validate:"required" json:"queue_title"`
+	}{
+		UserIDs:    req.GetUserIds(),
+		QueueTitle: req.GetQueueTitle(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	ids, err := s.notif.NotifyQueueArchived(ctx, req.GetUserIds(), req.GetQueueId(), req.GetQueueTitle())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to send notification")
+	}
+
+	return &notificationv1.NotifyQueueArchivedResponse{NotificationIds: ids}, nil
+}
+
+func (s *serverAPI) NotifyParticipantRemoved(ctx context.Context, req *notificationv1.NotifyParticipantRemovedRequest) (*notificationv1.NotifyParticipantRemovedResponse, error) {
+	input := struct {
+		UserID     int64  `validate:"required,gt=0" json:"user_id"`
+		QueueTitle string `validate:"required" json:"queue_title"`
+	}{
+		UserID:     req.GetUserId(),
+		QueueTitle: req.GetQueueTitle(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	ids, err := s.notif.NotifyRemoved(ctx, req.GetUserId(), req.GetQueueId(), req.GetQueueTitle())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to send notification")
+	}
+
+	return &notificationv1.NotifyParticipantRemovedResponse{NotificationIds: ids}, nil
+}
+
+func (s *serverAPI) NotifyCalled(ctx context.Context, req *notificationv1.NotifyCalledRequest) (*notificationv1.NotifyCalledResponse, error) {
+	input := struct {
+		UserID         int64  `validate:"required,gt=0" json:"user_id"`
+		QueueID        int64  `validate:"required,gt=0" json:"queue_id"`
+		QueueTitle     string `validate:"required" json:"queue_title"`
+		TimeoutSeconds int32  `validate:"required,gt=0" json:"timeout_seconds"`
+	}{
+		UserID:         req.GetUserId(),
+		QueueID:        req.GetQueueId(),
+		QueueTitle:     req.GetQueueTitle(),
+		TimeoutSeconds: req.GetTimeoutSeconds(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	timeout := time.Duration(req.GetTimeoutSeconds()) * time.Second
+	ids, err := s.notif.NotifyCalled(ctx, req.GetUserId(), req.GetQueueId(), req.GetQueueTitle(), timeout)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to send notification")
+	}
+
+	return &notificationv1.NotifyCalledResponse{NotificationIds: ids}, nil
+}
+
+func (s *serverAPI) NotifySwapRequested(ctx context.Context, req *notificationv1.NotifySwapRequestedRequest) (*notificationv1.NotifySwapRequestedResponse, error) {
+	input := struct {
+		UserID         int64  `validate:"required,gt=0" json:"user_id"`
+		QueueID        int64  `validate:"required,gt=0" json:"queue_id"`
+		QueueTitle     string `validate:"required" json:"queue_title"`
+		RequestID      int64  `validate:"required,gt=0" json:"request_id"`
+		Position       int32  `validate:"required,gt=0" json:"position"`
+		TimeoutSeconds int32  `validate:"required,gt=0" json:"timeout_seconds"`
+	}{
+		UserID:         req.GetUserId(),
+		QueueID:        req.GetQueueId(),
+		QueueTitle:     req.GetQueueTitle(),
+		RequestID:      req.GetRequestId(),
+		Position:       req.GetPosition(),
+		TimeoutSeconds: req.GetTimeoutSeconds(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	timeout := time.Duration(req.GetTimeoutSeconds()) * time.Second
+	ids, err := s.notif.NotifySwapRequested(ctx, req.GetUserId(), req.GetQueueId(), req.GetQueueTitle(), req.GetRequestId(), req.GetFromName(), req.GetPosition(), timeout)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to send notification")
+	}
+
+	return &notificationv1.NotifySwapRequestedResponse{NotificationIds: ids}, nil
+}
+
+func (s *serverAPI) NotifySwapAnswered(ctx context.Context, req *notificationv1.NotifySwapAnsweredRequest) (*notificationv1.NotifySwapAnsweredResponse, error) {
+	input := struct {
+		UserID     int64  `validate:"required,gt=0" json:"user_id"`
+		QueueID    int64  `validate:"required,gt=0" json:"queue_id"`
+		QueueTitle string `validate:"required" json:"queue_title"`
+	}{
+		UserID:     req.GetUserId(),
+		QueueID:    req.GetQueueId(),
+		QueueTitle: req.GetQueueTitle(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	ids, err := s.notif.NotifySwapAnswered(ctx, req.GetUserId(), req.GetQueueId(), req.GetQueueTitle(), req.GetAccepted(), req.GetPosition())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to send notification")
+	}
+
+	return &notificationv1.NotifySwapAnsweredResponse{NotificationIds: ids}, nil
 }
 
 func (s *serverAPI) SetContact(ctx context.Context, req *notificationv1.SetContactRequest) (*notificationv1.SetContactResponse, error) {
@@ -70,6 +242,52 @@ func (s *serverAPI) SetContact(ctx context.Context, req *notificationv1.SetConta
 	return &notificationv1.SetContactResponse{}, nil
 }
 
+func (s *serverAPI) GetContact(ctx context.Context, req *notificationv1.GetContactRequest) (*notificationv1.GetContactResponse, error) {
+	input := struct {
+		UserID int64 `validate:"required,gt=0" json:"user_id"`
+	}{
+		UserID: req.GetUserId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	contact, err := s.notif.GetContact(ctx, req.GetUserId())
+	if err != nil {
+		if errors.Is(err, storage.ErrContactNotFound) {
+			return nil, status.Error(codes.NotFound, "telegram is not linked")
+		}
+		return nil, status.Error(codes.Internal, "failed to get contact")
+	}
+
+	return &notificationv1.GetContactResponse{Contact: &notificationv1.ContactDTO{
+		UserId:           contact.UserID,
+		TelegramUsername: contact.Username,
+		ChatLinked:       contact.ChatID != "",
+		UpdatedAt:        contact.Updated.Unix(),
+	}}, nil
+}
+
+func (s *serverAPI) UnlinkContact(ctx context.Context, req *notificationv1.UnlinkContactRequest) (*notificationv1.UnlinkContactResponse, error) {
+	input := struct {
+		UserID int64 `validate:"required,gt=0" json:"user_id"`
+	}{
+		UserID: req.GetUserId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	if err := s.notif.UnlinkContact(ctx, req.GetUserId()); err != nil {
+		if errors.Is(err, storage.ErrContactNotFound) {
+			return nil, status.Error(codes.NotFound, "telegram is not linked")
+		}
+		return nil, status.Error(codes.Internal, "failed to unlink contact")
+	}
+
+	return &notificationv1.UnlinkContactResponse{}, nil
+}
+
 func (s *serverAPI) CreateLinkToken(ctx context.Context, req *notificationv1.CreateLinkTokenRequest) (*notificationv1.CreateLinkTokenResponse, error) {
 	input := struct {
 		UserID   int64  `validate:"required,gt=0" json:"user_id"`
@@ -82,13 +300,14 @@ func (s *serverAPI) CreateLinkToken(ctx context.Context, req *notificationv1.Cre
 		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
 	}
 
-	token, link, err := s.notif.CreateLinkToken(ctx, req.GetUserId(), req.GetTelegramUsername())
+	token, link, expires, err := s.notif.CreateLinkToken(ctx, req.GetUserId(), req.GetTelegramUsername())
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to create link token")
 	}
 	return &notificationv1.CreateLinkTokenResponse{
-		Token: token,
-		Link:  link,
+		Token:     token,
+		Link:      link,
+		ExpiresAt: expires.Unix(),
 	}, nil
 }
 
@@ -107,12 +326,111 @@ func (s *serverAPI) BindByToken(ctx context.Context, req *notificationv1.BindByT
 	}
 
 	if err := s.notif.BindByToken(ctx, req.GetToken(), req.GetChatId(), req.GetTelegramUsername()); err != nil {
+		if errors.Is(err, storage.ErrLinkTokenInvalid) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to bind token")
 	}
 
 	return &notificationv1.BindByTokenResponse{}, nil
 }
 
+func (s *serverAPI) ResolveChat(ctx context.Context, req *notificationv1.ResolveChatRequest) (*notificationv1.ResolveChatResponse, error) {
+	input := struct {
+		ChatID string `validate:"required" json:"chat_id"`
+	}{
+		ChatID: req.GetChatId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	userID, err := s.notif.ResolveChat(ctx, req.GetChatId())
+	if err != nil {
+		if errors.Is(err, storage.ErrContactNotFound) {
+			return nil, status.Error(codes.NotFound, "chat is not linked")
+		}
+		return nil, status.Error(codes.Internal, "failed to resolve chat")
+	}
+	return &notificationv1.ResolveChatResponse{UserId: userID}, nil
+}
+
+func (s *serverAPI) GetDeliveryStatus(ctx context.Context, req *notificationv1.GetDeliveryStatusRequest) (*notificationv1.GetDeliveryStatusResponse, error) {
+	input := struct {
+		NotificationID int64 `validate:"required,gt=0" json:"notification_id"`
+		UserID         int64 `validate:"gte=0" json:"user_id"`
+	}{
+		NotificationID: req.GetNotificationId(),
+		UserID:         req.GetUserId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	n, err := s.notif.GetDeliveryStatus(ctx, req.GetNotificationId(), req.GetUserId())
+	if err != nil {
+		if errors.Is(err, storage.ErrNotificationNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to get notification")
+	}
+
+	return &notificationv1.GetDeliveryStatusResponse{Notification: toNotificationDTO(n)}, nil
+}
+
+func (s *serverAPI) ListNotifications(ctx context.Context, req *notificationv1.ListNotificationsRequest) (*notificationv1.ListNotificationsResponse, error) {
+	input := struct {
+		UserID   int64  `validate:"gte=0" json:"user_id"`
+		Status   string `validate:"omitempty,oneof=pending sent failed" json:"status"`
+		Limit    int32  `validate:"gte=0,lte=200" json:"limit"`
+		BeforeID int64  `validate:"gte=0" json:"before_id"`
+	}{
+		UserID:   req.GetUserId(),
+		Status:   req.GetStatus(),
+		Limit:    req.GetLimit(),
+		BeforeID: req.GetBeforeId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	list, next, err := s.notif.ListNotifications(ctx, domain.NotificationFilter{
+		UserID:   req.GetUserId(),
+		Status:   domain.NotificationStatus(req.GetStatus()),
+		BeforeID: req.GetBeforeId(),
+		Limit:    int(req.GetLimit()),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list notifications")
+	}
+
+	dtos := make([]*notificationv1.NotificationDTO, 0, len(list))
+	for _, n := range list {
+		dtos = append(dtos, toNotificationDTO(n))
+	}
+	return &notificationv1.ListNotificationsResponse{Notifications: dtos, NextBeforeId: next}, nil
+}
+
+func toNotificationDTO(n domain.Notification) *notificationv1.NotificationDTO {
+	dto := &notificationv1.NotificationDTO{
+		Id:            n.ID,
+		UserId:        n.UserID,
+		Channel:       string(n.Channel),
+		Kind:          string(n.Kind),
+		Text:          n.Text,
+		Status:        string(n.Status),
+		Attempts:      int32(n.Attempts),
+		LastError:     n.LastError,
+		NextAttemptAt: n.NextAttemptAt.Unix(),
+		CreatedAt:     n.CreatedAt.Unix(),
+		UpdatedAt:     n.UpdatedAt.Unix(),
+	}
+	if n.SentAt != nil {
+		dto.SentAt = n.SentAt.Unix()
+	}
+	return dto
+}
+
 var validate = func() *validator.Validate {
 	v := validator.New(validator.WithRequiredStructEnabled())
 	v.RegisterTagNameFunc(func(fld reflect.StructField) string {
@@ -0,0 +1,119 @@
+// Package messages renders notification texts from text/template files
+// embedded per locale: templates/<locale>.tmpl defines one template per Type.
+package messages
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+// DefaultLocale is used for users without a preference and for locales
+// that have no templates.
+const DefaultLocale = "ru"
+
+// Type is a kind of message; it names the template in every locale file.
+type Type string
+
+const (
+	PositionSoon    Type = "position_soon"
+	PositionNext    Type = "position_next"
+	YourTurn        Type = "your_turn"
+	Called          Type = "called"
+	CheckInButton   Type = "check_in_button"
+	SwapRequested   Type = "swap_requested"
+	SwapButton      Type = "swap_button"
+	SwapAccepted    Type = "swap_accepted"
+	SwapDeclined    Type = "swap_declined"
+	Added           Type = "added"
+	Removed         Type = "removed"
+	QueueArchived   Type = "queue_archived"
+	QueueOpened     Type = "queue_opened"
+	ContactLinked   Type = "contact_linked"
+	ContactUnlinked Type = "contact_unlinked"
+)
+
+var types = []Type{
+	PositionSoon, PositionNext, YourTurn, Called, CheckInButton, SwapRequested, SwapButton, SwapAccepted, SwapDeclined, Added, Removed,
+	QueueArchived, QueueOpened, ContactLinked, ContactUnlinked,
+}
+
+// Data is what templates can refer to; fields a message does not need stay zero.
+type Data struct {
+	QueueTitle string
+	Position   int32
+	// Minutes is the time left to check in after a call or to answer a swap request.
+	Minutes int
+	// FromName and RequestID describe a swap request.
+	FromName  string
+	RequestID int64
+}
+
+//go:embed templates/*.tmpl
+var files embed.FS
+
+type Renderer struct {
+	locales map[string]*template.Template
+}
+
+// New parses the embedded templates and makes sure every locale defines
+// every message type, so a missing translation fails at startup.
+func New() (*Renderer, error) {
+	names, err := files.ReadDir("templates")
+	if err != nil {
+		return nil, fmt.Errorf("messages: read templates: %w", err)
+	}
+
+	r := &Renderer{locales: make(map[string]*template.Template, len(names))}
+	for _, f := range names {
+		locale := strings.TrimSuffix(f.Name(), ".tmpl")
+		t, err := template.New(locale).Option("missingkey=error").ParseFS(files, path.Join("templates", f.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("messages: parse %s: %w", f.Name(), err)
+		}
+		for _, typ := range types {
+			if t.Lookup(string(typ)) == nil {
+				return nil, fmt.Errorf("messages: locale %s has no %q template", locale, typ)
+			}
+		}
+		r.locales[locale] = t
+	}
+	if _, ok := r.locales[DefaultLocale]; !ok {
+		return nil, fmt.Errorf("messages: no templates for default locale %s", DefaultLocale)
+	}
+	return r, nil
+}
+
+// Supported tells whether there are templates for the locale.
+func (r *Renderer) Supported(locale string) bool {
+	_, ok := r.locales[locale]
+	return ok
+}
+
+// Locales lists the available locales in alphabetical order.
+func (r *Renderer) Locales() []string {
+	list := make([]string, 0, len(r.locales))
+	for locale := range r.locales {
+		list = append(list, locale)
+	}
+	slices.Sort(list)
+	return list
+}
+
+// Render executes the message template of the locale, falling back to
+// DefaultLocale when the locale is unknown.
+func (r *Renderer) Render(locale string, typ Type, data Data) (string, error) {
+	t, ok := r.locales[locale]
+	if !ok {
+		t = r.locales[DefaultLocale]
+	}
+	var buf bytes.Buffer
+	if err := t.ExecuteTemplate(&buf, string(typ), data); err != nil {
+		return "", fmt.Errorf("messages: render %s: %w", typ, err)
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
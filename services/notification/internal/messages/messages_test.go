@@ -0,0 +1,93 @@
+package messages
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// sample has distinct values, so each one can be found in the text.
+var sample = Data{
+	QueueTitle: "Lab 3",
+	Position:   7,
+	Minutes:    13,
+	FromName:   "Ivan Petrov",
+	RequestID:  4242,
+}
+
+func TestRenderEveryType(t *testing.T) {
+	r, err := New()
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	locales := r.Locales()
+	for _, want := range []string{"en", "ru"} {
+		if !slices.Contains(locales, want) {
+			t.Fatalf("locale %s is missing, have %v", want, locales)
+		}
+	}
+
+	// What each message must show of sample; buttons and link confirmations
+	// take no data.
+	want := map[Type][]string{
+		PositionSoon:    {"Lab 3", "7"},
+		PositionNext:    {"Lab 3"},
+		YourTurn:        {"Lab 3"},
+		Called:          {"Lab 3", "13"},
+		CheckInButton:   nil,
+		SwapRequested:   {"Lab 3", "Ivan Petrov", "7", "13", "4242"},
+		SwapButton:      nil,
+		SwapAccepted:    {"Lab 3", "7"},
+		SwapDeclined:    {"Lab 3"},
+		Added:           {"Lab 3", "7"},
+		Removed:         {"Lab 3"},
+		QueueArchived:   {"Lab 3"},
+		QueueOpened:     {"Lab 3"},
+		ContactLinked:   nil,
+		ContactUnlinked: nil,
+	}
+	if len(want) != len(types) {
+		t.Fatalf("test covers %d types, package has %d", len(want), len(types))
+	}
+
+	for _, locale := range locales {
+		for _, typ := range types {
+			t.Run(locale+"/"+string(typ), func(t *testing.T) {
+				text, err := r.Render(locale, typ, sample)
+				if err != nil {
+					t.Fatalf("Render: %v", err)
+				}
+				if text == "" {
+					t.Fatal("empty text")
+				}
+				for _, s := range want[typ] {
+					if !strings.Contains(text, s) {
+						t.Errorf("text %q does not contain %q", text, s)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestRenderUnknownLocale(t *testing.T) {
+	r, err := New()
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	for _, typ := range types {
+		got, err := r.Render("xx", typ, sample)
+		if err != nil {
+			t.Fatalf("Render(xx, %s): %v", typ, err)
+		}
+		ru, err := r.Render("ru", typ, sample)
+		if err != nil {
+			t.Fatalf("Render(ru, %s): %v", typ, err)
+		}
+		if got != ru {
+			t.Errorf("%s: got %q, want the ru text %q", typ, got, ru)
+		}
+	}
+}
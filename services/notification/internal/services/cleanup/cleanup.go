@@ -0,0 +1,49 @@
+package cleanup
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+type Storage interface {
+	PurgeLinkTokens(ctx context.Context) (int64, error)
+}
+
+// Job periodically deletes expired Telegram link tokens.
+type Job struct {
+	log      *slog.Logger
+	storage  Storage
+	interval time.Duration
+}
+
+func New(log *slog.Logger, storage Storage, interval time.Duration) *Job {
+	return &Job{log: log, storage: storage, interval: interval}
+}
+
+// Run purges once at start and then every interval until ctx is done.
+func (j *Job) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *Job) purge(ctx context.Context) {
+	n, err := j.storage.PurgeLinkTokens(ctx)
+	if err != nil {
+		j.log.Warn("failed to purge link tokens", slog.Any("err", err))
+		return
+	}
+	if n > 0 {
+		j.log.Info("purged expired link tokens", slog.Int64("count", n))
+	}
+}
@@ -0,0 +1,198 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/channels"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/domain"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/storage"
+)
+
+type Storage interface {
+	ClaimNotifications(ctx context.Context, limit int, lease time.Duration) ([]domain.Notification, error)
+	MarkNotificationSent(ctx context.Context, id int64) error
+	RetryNotification(ctx context.Context, id int64, at time.Time, reason string) error
+	FailNotification(ctx context.Context, id int64, reason string) error
+	GetContact(ctx context.Context, userID int64) (domain.Contact, error)
+	GetEndpoint(ctx context.Context, userID int64, channel domain.Channel) (domain.Endpoint, error)
+}
+
+type Config struct {
+	Workers      int
+	PollInterval time.Duration
+	MaxAttempts  int
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+	// Lease is how long a claimed notification stays hidden from other workers.
+	Lease time.Duration
+}
+
+// Worker sends stored notifications through their channels with a pool of
+// goroutines. Temporary failures are retried with exponential backoff, honoring
+// the delay asked for by the remote side; after MaxAttempts the notification
+// is marked failed.
+type Worker struct {
+	log      *slog.Logger
+	storage  Storage
+	channels map[domain.Channel]channels.Channel
+	cfg      Config
+}
+
+func New(log *slog.Logger, storage Storage, chans map[domain.Channel]channels.Channel, cfg Config) *Worker {
+	return &Worker{log: log, storage: storage, channels: chans, cfg: cfg}
+}
+
+// Run polls for due notifications and hands them to the workers until ctx is done.
+func (w *Worker) Run(ctx context.Context) {
+	jobs := make(chan domain.Notification)
+
+	var wg sync.WaitGroup
+	for range w.cfg.Workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for n := range jobs {
+				w.process(ctx, n)
+			}
+		}()
+	}
+	defer func() {
+		close(jobs)
+		wg.Wait()
+	}()
+
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// Keep claiming while there is a backlog, one batch per pool size.
+		for {
+			list, err := w.storage.ClaimNotifications(ctx, w.cfg.Workers, w.cfg.Lease)
+			if err != nil {
+				w.log.Warn("failed to claim notifications", slog.Any("err", err))
+				break
+			}
+			for _, n := range list {
+				select {
+				case jobs <- n:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if len(list) < w.cfg.Workers {
+				break
+			}
+		}
+	}
+}
+
+func (w *Worker) process(ctx context.Context, n domain.Notification) {
+	err := w.send(ctx, n)
+	if err == nil {
+		if err := w.storage.MarkNotificationSent(ctx, n.ID); err != nil {
+			w.log.Warn("failed to mark notification sent", slog.Int64("id", n.ID), slog.Any("err", err))
+		}
+		return
+	}
+
+	log := w.log.With(slog.Int64("id", n.ID), slog.Int64("user_id", n.UserID), slog.String("channel", string(n.Channel)),
+		slog.Int("attempts", n.Attempts), slog.Any("err", err))
+
+	var chErr *channels.Error
+	isChErr := errors.As(err, &chErr)
+	if (isChErr && chErr.Permanent) || n.Attempts >= w.cfg.MaxAttempts {
+		log.Error("notification delivery failed")
+		if err := w.storage.FailNotification(ctx, n.ID, err.Error()); err != nil {
+			w.log.Warn("failed to mark notification failed", slog.Int64("id", n.ID), slog.Any("err", err))
+		}
+		return
+	}
+
+	delay := w.backoff(n.Attempts)
+	if isChErr && chErr.RetryAfter > delay {
+		delay = chErr.RetryAfter
+	}
+	log.Warn("notification delivery failed, will retry", slog.Duration("delay", delay))
+	if err := w.storage.RetryNotification(ctx, n.ID, time.Now().Add(delay), err.Error()); err != nil {
+		w.log.Warn("failed to reschedule notification", slog.Int64("id", n.ID), slog.Any("err", err))
+	}
+}
+
+func (w *Worker) send(ctx context.Context, n domain.Notification) error {
+	ch, ok := w.channels[n.Channel]
+	if !ok {
+		return channels.Permanent(fmt.Errorf("unknown channel %q", n.Channel))
+	}
+	to, err := w.endpoint(ctx, n)
+	if err != nil {
+		return err
+	}
+
+	if !ch.Enabled() {
+		w.log.Info("channel not configured, logging notification",
+			slog.Int64("user_id", n.UserID),
+			slog.String("channel", string(n.Channel)),
+			slog.String("text", n.Text),
+		)
+		return nil
+	}
+
+	if err := ch.Send(ctx, to, n); err != nil {
+		return fmt.Errorf("send %s: %w", n.Channel, err)
+	}
+	return nil
+}
+
+// endpoint resolves the recipient at delivery time, so a contact linked after
+// the notification was created is still used.
+func (w *Worker) endpoint(ctx context.Context, n domain.Notification) (domain.Endpoint, error) {
+	if n.Channel == domain.ChannelTelegram {
+		contact, err := w.storage.GetContact(ctx, n.UserID)
+		if err != nil {
+			if errors.Is(err, storage.ErrContactNotFound) {
+				return domain.Endpoint{}, channels.Permanent(errors.New("no telegram contact"))
+			}
+			return domain.Endpoint{}, err
+		}
+		chat := contact.ChatID
+		if chat == "" {
+			if contact.Username == "" {
+				return domain.Endpoint{}, channels.Permanent(errors.New("no chat or username"))
+			}
+			chat = "@" + contact.Username
+		}
+		return domain.Endpoint{UserID: n.UserID, Channel: n.Channel, Address: chat, Enabled: true}, nil
+	}
+
+	to, err := w.storage.GetEndpoint(ctx, n.UserID, n.Channel)
+	if err != nil {
+		if errors.Is(err, storage.ErrEndpointNotFound) {
+			return domain.Endpoint{}, channels.Permanent(errors.New("channel removed"))
+		}
+		return domain.Endpoint{}, err
+	}
+	if !to.Enabled {
+		return domain.Endpoint{}, channels.Permanent(errors.New("channel disabled"))
+	}
+	return to, nil
+}
+
+// backoff doubles the delay with every attempt, up to MaxBackoff.
+func (w *Worker) backoff(attempts int) time.Duration {
+	delay := w.cfg.BaseBackoff
+	for i := 1; i < attempts && delay < w.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, w.cfg.MaxBackoff)
+}
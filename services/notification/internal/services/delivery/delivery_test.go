@@ -0,0 +1,36 @@
+package delivery
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWorkerBackoff(t *testing.T) {
+	w := &Worker{cfg: Config{BaseBackoff: time.Second, MaxBackoff: time.Minute}}
+
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{0, time.Second},
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{3, 4 * time.Second},
+		{6, 32 * time.Second},
+		{7, time.Minute},
+		{8, time.Minute},
+		{1000, time.Minute},
+	}
+	for _, tt := range tests {
+		if got := w.backoff(tt.attempts); got != tt.want {
+			t.Errorf("backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestWorkerBackoffBaseAboveCap(t *testing.T) {
+	w := &Worker{cfg: Config{BaseBackoff: 2 * time.Minute, MaxBackoff: time.Minute}}
+	if got := w.backoff(1); got != time.Minute {
+		t.Errorf("backoff(1) = %v, want the cap %v", got, time.Minute)
+	}
+}
@@ -0,0 +1,75 @@
+package notification
+
+import (
+	"context"
+	"errors"
+
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/domain"
+)
+
+var ErrUnknownChannel = errors.New("unknown notification channel")
+
+type EndpointStorage interface {
+	UpsertEndpoint(ctx context.Context, e domain.Endpoint) (domain.Endpoint, error)
+	ListEndpoints(ctx context.Context, userID int64) ([]domain.Endpoint, error)
+	DeleteEndpoint(ctx context.Context, userID int64, channel domain.Channel) error
+}
+
+// SetEndpoint saves the user's settings of one channel.
+func (s *Service) SetEndpoint(ctx context.Context, e domain.Endpoint) (domain.Endpoint, error) {
+	ch, ok := s.channels[e.Channel]
+	if !ok {
+		return domain.Endpoint{}, ErrUnknownChannel
+	}
+	if e.Channel == domain.ChannelTelegram {
+		// The chat comes from the contact bound through the bot.
+		e.Address, e.Secret, e.P256dh, e.Auth = "", "", "", ""
+	}
+	if err := ch.Validate(e); err != nil {
+		return domain.Endpoint{}, err
+	}
+	return s.storage.UpsertEndpoint(ctx, e)
+}
+
+func (s *Service) ListEndpoints(ctx context.Context, userID int64) ([]domain.Endpoint, error) {
+	return s.storage.ListEndpoints(ctx, userID)
+}
+
+func (s *Service) DeleteEndpoint(ctx context.Context, userID int64, channel domain.Channel) error {
+	return s.storage.DeleteEndpoint(ctx, userID, channel)
+}
+
+// WebPushKey is the VAPID public key browsers subscribe with; empty if web push is off.
+func (s *Service) WebPushKey() string {
+	return s.webPushKey
+}
+
+// recipients returns the enabled channels of the user. A user who has not set
+// any up gets Telegram, as before channels existed.
+func (s *Service) recipients(ctx context.Context, userID int64) ([]domain.Channel, error) {
+	endpoints, err := s.storage.ListEndpoints(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(endpoints) == 0 {
+		return []domain.Channel{domain.ChannelTelegram}, nil
+	}
+
+	var list []domain.Channel
+	for _, e := range endpoints {
+		if e.Enabled {
+			list = append(list, e.Channel)
+		}
+	}
+	return list, nil
+}
+
+// fanOut copies n for every channel in list.
+func fanOut(n domain.Notification, list []domain.Channel) []domain.Notification {
+	out := make([]domain.Notification, 0, len(list))
+	for _, ch := range list {
+		n.Channel = ch
+		out = append(out, n)
+	}
+	return out
+}
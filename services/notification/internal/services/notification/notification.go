@@ -6,36 +6,57 @@ import (
 	"encoding/base64"
 	"fmt"
 	"log/slog"
-	"net/http"
-	"net/url"
 	"strings"
 	"time"
 
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/channels"
 	"github.com/s1lentmol/q-flow-backend/services/notification/internal/domain"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/messages"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/storage"
 )
 
 type ContactStorage interface {
 	UpsertContact(ctx context.Context, contact domain.Contact) error
 	GetContact(ctx context.Context, userID int64) (domain.Contact, error)
+	DeleteContact(ctx context.Context, userID int64) (domain.Contact, error)
+	UserByChat(ctx context.Context, chatID string) (int64, error)
 	CreateLinkToken(ctx context.Context, token domain.LinkToken) error
 	ConsumeLinkToken(ctx context.Context, token string) (domain.LinkToken, error)
 }
 
+type NotificationStorage interface {
+	CreateNotifications(ctx context.Context, list []domain.Notification) ([]int64, error)
+	RecordPosition(ctx context.Context, notice domain.PositionNotice, compose func(prev *domain.PositionNotice) ([]domain.Notification, error)) ([]int64, error)
+	GetNotification(ctx context.Context, id int64) (domain.Notification, error)
+	ListNotifications(ctx context.Context, filter domain.NotificationFilter) ([]domain.Notification, error)
+}
+
+type Storage interface {
+	ContactStorage
+	NotificationStorage
+	EndpointStorage
+	PreferencesStorage
+}
+
 type Service struct {
-	log           *slog.Logger
-	storage       ContactStorage
-	telegramToken string
-	httpClient    *http.Client
-	botName       string
+	log        *slog.Logger
+	storage    Storage
+	botName    string
+	channels   map[domain.Channel]channels.Channel
+	webPushKey string
+	linkTTL    time.Duration
+	messages   *messages.Renderer
 }
 
-func New(log *slog.Logger, storage ContactStorage, telegramToken string, botName string) *Service {
+func New(log *slog.Logger, storage Storage, botName string, chans map[domain.Channel]channels.Channel, webPushKey string, linkTTL time.Duration, msgs *messages.Renderer) *Service {
 	return &Service{
-		log:           log,
-		storage:       storage,
-		telegramToken: telegramToken,
-		httpClient:    &http.Client{Timeout: 5 * time.Second},
-		botName:       botName,
+		log:        log,
+		storage:    storage,
+		botName:    botName,
+		channels:   chans,
+		webPushKey: webPushKey,
+		linkTTL:    linkTTL,
+		messages:   msgs,
 	}
 }
 
@@ -48,77 +69,197 @@ func (s *Service) SetContact(ctx context.Context, userID int64, username, chatID
 	return s.storage.UpsertContact(ctx, contact)
 }
 
-func (s *Service) NotifyPositionSoon(ctx context.Context, userID int64, queueTitle string, position int32) error {
-	contact, err := s.storage.GetContact(ctx, userID)
+// NotifyQueueOpened stores a notification per user and channel and returns their ids.
+func (s *Service) NotifyQueueOpened(ctx context.Context, userIDs []int64, queueID int64, queueTitle string) ([]int64, error) {
+	return s.notifyAll(ctx, userIDs, queueID, domain.KindQueueOpened, messages.QueueOpened, messages.Data{QueueTitle: queueTitle})
+}
+
+// NotifyQueueArchived tells the users still in the queue that it was archived.
+func (s *Service) NotifyQueueArchived(ctx context.Context, userIDs []int64, queueID int64, queueTitle string) ([]int64, error) {
+	return s.notifyAll(ctx, userIDs, queueID, domain.KindQueueArchived, messages.QueueArchived, messages.Data{QueueTitle: queueTitle})
+}
+
+// NotifyRemoved tells the user that the owner took them out of the queue.
+func (s *Service) NotifyRemoved(ctx context.Context, userID, queueID int64, queueTitle string) ([]int64, error) {
+	return s.notifyAll(ctx, []int64{userID}, queueID, domain.KindRemoved, messages.Removed, messages.Data{QueueTitle: queueTitle})
+}
+
+// NotifyCalled asks the participant called to the head to check in within
+// timeout. The message carries a button that checks in through the bot.
+func (s *Service) NotifyCalled(ctx context.Context, userID, queueID int64, queueTitle string, timeout time.Duration) ([]int64, error) {
+	prefs, err := s.GetPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	at, ok := schedule(prefs, domain.KindCalled, queueID, time.Now())
+	if !ok {
+		return nil, nil
+	}
+	chans, err := s.recipients(ctx, userID)
 	if err != nil {
-		s.log.Warn("contact not found, skip notification", slog.Int64("user_id", userID), slog.Any("err", err))
-		return nil
+		return nil, err
 	}
 
-	if s.telegramToken == "" {
-		s.log.Info("telegram token not set, logging notification",
-			slog.Int64("user_id", userID),
-			slog.String("queue", queueTitle),
-			slog.Int("position", int(position)),
-		)
-		return nil
+	data := messages.Data{QueueTitle: queueTitle, Minutes: max(1, int((timeout+time.Minute-1)/time.Minute))}
+	text, err := s.messages.Render(prefs.Locale, messages.Called, data)
+	if err != nil {
+		return nil, err
+	}
+	label, err := s.messages.Render(prefs.Locale, messages.CheckInButton, data)
+	if err != nil {
+		return nil, err
+	}
+	n := domain.Notification{
+		UserID:        userID,
+		Kind:          domain.KindCalled,
+		Text:          text,
+		NextAttemptAt: at,
+		// The bot reads buttons as "<command>:<queue id>".
+		Button: &domain.Button{Text: label, Data: fmt.Sprintf("checkin:%d", queueID)},
 	}
+	return s.storage.CreateNotifications(ctx, fanOut(n, chans))
+}
 
-	chat := contact.ChatID
-	if chat == "" {
-		if contact.Username == "" {
-			s.log.Warn("no chat or username, skip telegram notification", slog.Int64("user_id", userID))
-			return nil
-		}
-		chat = "@" + contact.Username
+// NotifySwapRequested asks the user to answer another participant's swap
+// request. The message carries a button that accepts it through the bot.
+func (s *Service) NotifySwapRequested(ctx context.Context, userID, queueID int64, queueTitle string, requestID int64, fromName string, position int32, timeout time.Duration) ([]int64, error) {
+	prefs, err := s.GetPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	at, ok := schedule(prefs, domain.KindSwap, queueID, time.Now())
+	if !ok {
+		return nil, nil
+	}
+	chans, err := s.recipients(ctx, userID)
+	if err != nil {
+		return nil, err
 	}
 
-	text := fmt.Sprintf("Очередь по \"%s\": скоро ваша очередь. Текущее место: %d.", queueTitle, position)
-	return s.sendTelegramMessage(ctx, chat, text)
+	data := messages.Data{
+		QueueTitle: queueTitle,
+		Position:   position,
+		Minutes:    max(1, int(timeout/time.Minute)),
+		FromName:   fromName,
+		RequestID:  requestID,
+	}
+	text, err := s.messages.Render(prefs.Locale, messages.SwapRequested, data)
+	if err != nil {
+		return nil, err
+	}
+	label, err := s.messages.Render(prefs.Locale, messages.SwapButton, data)
+	if err != nil {
+		return nil, err
+	}
+	n := domain.Notification{
+		UserID:        userID,
+		Kind:          domain.KindSwap,
+		Text:          text,
+		NextAttemptAt: at,
+		// Unlike the other buttons, this one carries the request id.
+		Button: &domain.Button{Text: label, Data: fmt.Sprintf("swapaccept:%d", requestID)},
+	}
+	return s.storage.CreateNotifications(ctx, fanOut(n, chans))
 }
 
-func (s *Service) sendTelegramMessage(ctx context.Context, chatID, text string) error {
-	endpoint := fmt.Sprintf("https://api.telegram.org/bot%s/sendMessage", s.telegramToken)
-	values := url.Values{}
-	values.Set("chat_id", chatID)
-	values.Set("text", text)
+// NotifySwapAnswered tells the requester whether the swap was accepted.
+func (s *Service) NotifySwapAnswered(ctx context.Context, userID, queueID int64, queueTitle string, accepted bool, position int32) ([]int64, error) {
+	typ := messages.SwapDeclined
+	if accepted {
+		typ = messages.SwapAccepted
+	}
+	return s.notifyAll(ctx, []int64{userID}, queueID, domain.KindSwap, typ, messages.Data{QueueTitle: queueTitle, Position: position})
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(values.Encode()))
-	if err != nil {
-		return fmt.Errorf("build request: %w", err)
+// notifyAll renders the message in each user's locale and stores it for
+// every channel of the user, unless the user's preferences rule it out.
+func (s *Service) notifyAll(ctx context.Context, userIDs []int64, queueID int64, kind domain.NotificationKind, typ messages.Type, data messages.Data) ([]int64, error) {
+	now := time.Now()
+	list := make([]domain.Notification, 0, len(userIDs))
+	for _, userID := range userIDs {
+		prefs, err := s.GetPreferences(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		at, ok := schedule(prefs, kind, queueID, now)
+		if !ok {
+			continue
+		}
+		chans, err := s.recipients(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		text, err := s.messages.Render(prefs.Locale, typ, data)
+		if err != nil {
+			return nil, err
+		}
+		n := domain.Notification{UserID: userID, Kind: kind, Text: text, NextAttemptAt: at}
+		list = append(list, fanOut(n, chans)...)
 	}
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return s.storage.CreateNotifications(ctx, list)
+}
 
-	resp, err := s.httpClient.Do(req)
+// GetDeliveryStatus returns the notification; a non-zero userID must own it.
+func (s *Service) GetDeliveryStatus(ctx context.Context, id, userID int64) (domain.Notification, error) {
+	n, err := s.storage.GetNotification(ctx, id)
 	if err != nil {
-		return fmt.Errorf("send telegram request: %w", err)
+		return domain.Notification{}, err
+	}
+	if userID != 0 && n.UserID != userID {
+		return domain.Notification{}, storage.ErrNotificationNotFound
 	}
-	defer resp.Body.Close()
+	return n, nil
+}
+
+const (
+	defaultListLimit = 50
+	maxListLimit     = 200
+)
 
-	if resp.StatusCode >= 300 {
-		return fmt.Errorf("telegram API returned status %d", resp.StatusCode)
+// ListNotifications returns a page of notifications and the cursor of the next one (0 if none).
+func (s *Service) ListNotifications(ctx context.Context, filter domain.NotificationFilter) ([]domain.Notification, int64, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultListLimit
 	}
+	filter.Limit = min(filter.Limit, maxListLimit)
 
-	return nil
+	list, err := s.storage.ListNotifications(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	var next int64
+	if len(list) == filter.Limit {
+		next = list[len(list)-1].ID
+	}
+	return list, next, nil
+}
+
+// ResolveChat returns the user bound to the Telegram chat.
+func (s *Service) ResolveChat(ctx context.Context, chatID string) (int64, error) {
+	return s.storage.UserByChat(ctx, chatID)
 }
 
-func (s *Service) CreateLinkToken(ctx context.Context, userID int64, username string) (string, string, error) {
+// CreateLinkToken issues a one-time token for binding a Telegram chat; it
+// expires after the configured TTL.
+func (s *Service) CreateLinkToken(ctx context.Context, userID int64, username string) (string, string, time.Time, error) {
 	token, err := generateToken(32)
 	if err != nil {
-		return "", "", fmt.Errorf("generate token: %w", err)
+		return "", "", time.Time{}, fmt.Errorf("generate token: %w", err)
 	}
 	link := ""
 	if s.botName != "" {
 		link = fmt.Sprintf("https://t.me/%s?start=%s", s.botName, token)
 	}
+	expires := time.Now().Add(s.linkTTL)
 	if err := s.storage.CreateLinkToken(ctx, domain.LinkToken{
 		Token:    token,
 		UserID:   userID,
 		Username: username,
+		Expires:  expires,
 	}); err != nil {
-		return "", "", err
+		return "", "", time.Time{}, err
 	}
-	return token, link, nil
+	return token, link, expires, nil
 }
 
 func (s *Service) BindByToken(ctx context.Context, token, chatID, username string) error {
@@ -136,7 +277,48 @@ func (s *Service) BindByToken(ctx context.Context, token, chatID, username strin
 		Username: name,
 		ChatID:   chatID,
 	}
-	return s.storage.UpsertContact(ctx, contact)
+	if err := s.storage.UpsertContact(ctx, contact); err != nil {
+		return err
+	}
+	s.confirm(ctx, link.UserID, chatID, messages.ContactLinked)
+	return nil
+}
+
+func (s *Service) GetContact(ctx context.Context, userID int64) (domain.Contact, error) {
+	return s.storage.GetContact(ctx, userID)
+}
+
+// UnlinkContact forgets the user's Telegram chat and says goodbye to it.
+func (s *Service) UnlinkContact(ctx context.Context, userID int64) error {
+	contact, err := s.storage.DeleteContact(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if contact.ChatID != "" {
+		s.confirm(ctx, userID, contact.ChatID, messages.ContactUnlinked)
+	}
+	return nil
+}
+
+// confirm sends a message straight to the chat instead of storing a
+// notification for the delivery worker: after unlinking the chat can no
+// longer be resolved from the contact. It is best effort, the link itself
+// has already changed.
+func (s *Service) confirm(ctx context.Context, userID int64, chatID string, typ messages.Type) {
+	text, err := s.render(ctx, userID, typ, messages.Data{})
+	if err != nil {
+		s.log.Warn("failed to render telegram confirmation", slog.Any("err", err))
+		return
+	}
+	tg, ok := s.channels[domain.ChannelTelegram]
+	if !ok || !tg.Enabled() {
+		s.log.Info("telegram disabled, skipping confirmation", slog.String("chat_id", chatID), slog.String("text", text))
+		return
+	}
+	to := domain.Endpoint{Channel: domain.ChannelTelegram, Address: chatID, Enabled: true}
+	if err := tg.Send(ctx, to, domain.Notification{Channel: domain.ChannelTelegram, Text: text}); err != nil {
+		s.log.Warn("failed to send telegram confirmation", slog.String("chat_id", chatID), slog.Any("err", err))
+	}
 }
 
 func generateToken(n int) (string, error) {
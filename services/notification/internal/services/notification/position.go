@@ -0,0 +1,106 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/domain"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/messages"
+)
+
+// stage is how close a participant is to their turn. A message is sent only
+// when the stage grows, so repeated reports of the same position are silent.
+type stage int
+
+const (
+	stageFar stage = iota
+	stageTop
+	stageNext
+	stageTurn
+)
+
+func stageOf(position, notifyTop int32) stage {
+	switch {
+	case position == 1:
+		return stageTurn
+	case position == 2 && notifyTop >= 2:
+		return stageNext
+	case position <= notifyTop:
+		return stageTop
+	default:
+		return stageFar
+	}
+}
+
+// NotifyPositionSoon records the participant's position and stores
+// notifications to the user's channels if they entered the head of the queue,
+// became next or got their turn. Returns the ids, none if nothing is sent.
+func (s *Service) NotifyPositionSoon(ctx context.Context, u domain.PositionUpdate) ([]int64, error) {
+	if u.NotifyTop <= 0 {
+		u.NotifyTop = domain.DefaultNotifyTop
+	}
+	chans, err := s.recipients(ctx, u.UserID)
+	if err != nil {
+		return nil, err
+	}
+	prefs, err := s.GetPreferences(ctx, u.UserID)
+	if err != nil {
+		return nil, err
+	}
+	// The queue only reports positions within its own head, so a larger
+	// preference could never be met.
+	if prefs.NotifyTop > 0 {
+		u.NotifyTop = min(prefs.NotifyTop, u.NotifyTop)
+	}
+	notice := domain.PositionNotice{
+		UserID:        u.UserID,
+		QueueID:       u.QueueID,
+		ParticipantID: u.ParticipantID,
+		Position:      u.Position,
+	}
+
+	return s.storage.RecordPosition(ctx, notice, func(prev *domain.PositionNotice) ([]domain.Notification, error) {
+		// A new queue entry starts from scratch.
+		fresh := prev == nil || prev.ParticipantID != u.ParticipantID
+		before := stageFar
+		if !fresh {
+			before = stageOf(prev.Position, u.NotifyTop)
+		}
+		now := stageOf(u.Position, u.NotifyTop)
+
+		var kind domain.NotificationKind
+		var typ messages.Type
+		switch {
+		case now > before:
+			kind, typ = positionMessage(now)
+		case fresh && u.Added:
+			kind, typ = domain.KindPositionSoon, messages.Added
+		default:
+			return nil, nil
+		}
+
+		// The position is still recorded, so a muted or quiet update is not
+		// sent later either.
+		at, ok := schedule(prefs, kind, u.QueueID, time.Now())
+		if !ok {
+			return nil, nil
+		}
+		text, err := s.messages.Render(prefs.Locale, typ, messages.Data{QueueTitle: u.QueueTitle, Position: u.Position})
+		if err != nil {
+			return nil, err
+		}
+		n := domain.Notification{UserID: u.UserID, Kind: kind, Text: text, NextAttemptAt: at}
+		return fanOut(n, chans), nil
+	})
+}
+
+func positionMessage(st stage) (domain.NotificationKind, messages.Type) {
+	switch st {
+	case stageTurn:
+		return domain.KindYourTurn, messages.YourTurn
+	case stageNext:
+		return domain.KindPositionSoon, messages.PositionNext
+	default:
+		return domain.KindPositionSoon, messages.PositionSoon
+	}
+}
@@ -0,0 +1,88 @@
+package notification
+
+import (
+	"testing"
+
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/messages"
+)
+
+func TestStageOf(t *testing.T) {
+	tests := []struct {
+		position, notifyTop int32
+		want                stage
+	}{
+		{1, 3, stageTurn},
+		{2, 3, stageNext},
+		{3, 3, stageTop},
+		{4, 3, stageFar},
+		{1, 1, stageTurn},
+		{2, 1, stageFar},
+		{2, 2, stageNext},
+		{3, 2, stageFar},
+		{5, 10, stageTop},
+		{11, 10, stageFar},
+	}
+	for _, tt := range tests {
+		if got := stageOf(tt.position, tt.notifyTop); got != tt.want {
+			t.Errorf("stageOf(%d, %d) = %d, want %d", tt.position, tt.notifyTop, got, tt.want)
+		}
+	}
+}
+
+// TestStageTransitions walks a participant through the queue and checks that
+// a message goes out only when the stage grows.
+func TestStageTransitions(t *testing.T) {
+	tests := []struct {
+		name      string
+		notifyTop int32
+		positions []int32
+		want      []messages.Type
+	}{
+		{
+			name:      "straight to the head",
+			notifyTop: 3,
+			positions: []int32{5, 4, 3, 2, 1},
+			want:      []messages.Type{"", "", messages.PositionSoon, messages.PositionNext, messages.YourTurn},
+		},
+		{
+			name:      "same position is silent",
+			notifyTop: 3,
+			positions: []int32{3, 3, 2, 2},
+			want:      []messages.Type{messages.PositionSoon, "", messages.PositionNext, ""},
+		},
+		{
+			name:      "moving back is silent, coming back again is not",
+			notifyTop: 3,
+			positions: []int32{2, 5, 3, 2},
+			want:      []messages.Type{messages.PositionNext, "", messages.PositionSoon, messages.PositionNext},
+		},
+		{
+			name:      "skipping stages sends the latest",
+			notifyTop: 5,
+			positions: []int32{9, 1},
+			want:      []messages.Type{"", messages.YourTurn},
+		},
+		{
+			name:      "head of one has no next",
+			notifyTop: 1,
+			positions: []int32{3, 2, 1},
+			want:      []messages.Type{"", "", messages.YourTurn},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := stageFar
+			for i, pos := range tt.positions {
+				now := stageOf(pos, tt.notifyTop)
+				var got messages.Type
+				if now > before {
+					_, got = positionMessage(now)
+				}
+				if got != tt.want[i] {
+					t.Errorf("step %d, position %d: message %q, want %q", i, pos, got, tt.want[i])
+				}
+				before = now
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/domain"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/messages"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/storage"
+)
+
+var ErrInvalidPreferences = errors.New("invalid preferences")
+
+type PreferencesStorage interface {
+	GetPreferences(ctx context.Context, userID int64) (domain.Preferences, error)
+	UpsertPreferences(ctx context.Context, p domain.Preferences) (domain.Preferences, error)
+	MuteQueue(ctx context.Context, userID, queueID int64) error
+	UnmuteQueue(ctx context.Context, userID, queueID int64) error
+}
+
+// GetPreferences returns the user's settings, or the defaults if none are saved.
+func (s *Service) GetPreferences(ctx context.Context, userID int64) (domain.Preferences, error) {
+	p, err := s.storage.GetPreferences(ctx, userID)
+	if errors.Is(err, storage.ErrPreferencesNotFound) {
+		p.Locale, p.Timezone = messages.DefaultLocale, domain.DefaultTimezone
+		return p, nil
+	}
+	return p, err
+}
+
+// UpdatePreferences replaces all settings of the user; empty locale and
+// timezone fall back to the defaults.
+func (s *Service) UpdatePreferences(ctx context.Context, p domain.Preferences) (domain.Preferences, error) {
+	if p.Locale == "" {
+		p.Locale = messages.DefaultLocale
+	}
+	if !s.messages.Supported(p.Locale) {
+		return domain.Preferences{}, fmt.Errorf("%w: unsupported locale %q", ErrInvalidPreferences, p.Locale)
+	}
+	for _, k := range p.DisabledKinds {
+		if k == domain.KindCalled {
+			return domain.Preferences{}, fmt.Errorf("%w: %q cannot be disabled", ErrInvalidPreferences, k)
+		}
+		if !slices.Contains(domain.Kinds, k) {
+			return domain.Preferences{}, fmt.Errorf("%w: unknown kind %q", ErrInvalidPreferences, k)
+		}
+	}
+	if p.Timezone == "" {
+		p.Timezone = domain.DefaultTimezone
+	}
+	if _, err := time.LoadLocation(p.Timezone); err != nil {
+		return domain.Preferences{}, fmt.Errorf("%w: unknown timezone %q", ErrInvalidPreferences, p.Timezone)
+	}
+	if q := p.Quiet; q != nil && (q.From == q.To || !validMinute(q.From) || !validMinute(q.To)) {
+		return domain.Preferences{}, fmt.Errorf("%w: quiet hours must be two different times of day", ErrInvalidPreferences)
+	}
+	return s.storage.UpsertPreferences(ctx, p)
+}
+
+func (s *Service) MuteQueue(ctx context.Context, userID, queueID int64) error {
+	return s.storage.MuteQueue(ctx, userID, queueID)
+}
+
+func (s *Service) UnmuteQueue(ctx context.Context, userID, queueID int64) error {
+	return s.storage.UnmuteQueue(ctx, userID, queueID)
+}
+
+// Locales lists the languages notifications can be written in.
+func (s *Service) Locales() []string {
+	return s.messages.Locales()
+}
+
+// render writes the message in the user's language.
+func (s *Service) render(ctx context.Context, userID int64, typ messages.Type, data messages.Data) (string, error) {
+	p, err := s.GetPreferences(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	return s.messages.Render(p.Locale, typ, data)
+}
+
+// schedule applies the user's settings to a notification about to be stored.
+// It returns false if the user does not want it at all, otherwise when to
+// send it: zero for right away, or the end of quiet hours. Position updates
+// are dropped during quiet hours, they would be stale by the morning. A turn
+// is sent even then: the queue skips whoever does not come in time. A call
+// is always sent at once, whatever the settings.
+func schedule(p domain.Preferences, kind domain.NotificationKind, queueID int64, now time.Time) (time.Time, bool) {
+	if kind == domain.KindCalled {
+		return time.Time{}, true
+	}
+	if !p.KindEnabled(kind) || p.QueueMuted(queueID) {
+		return time.Time{}, false
+	}
+	if p.Quiet == nil || kind == domain.KindYourTurn {
+		return time.Time{}, true
+	}
+	loc, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	if !p.Quiet.Contains(now, loc) {
+		return time.Time{}, true
+	}
+	if kind == domain.KindPositionSoon {
+		return time.Time{}, false
+	}
+	return p.Quiet.End(now, loc), true
+}
+
+func validMinute(m int) bool {
+	return m >= 0 && m < 24*60
+}
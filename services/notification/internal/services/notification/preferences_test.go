@@ -0,0 +1,108 @@
+package notification
+
+import (
+	"testing"
+	"time"
+
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/domain"
+)
+
+func TestSchedule(t *testing.T) {
+	const queueID = 7
+	night := time.Date(2026, 3, 2, 23, 30, 0, 0, time.UTC)
+	noon := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	morning := time.Date(2026, 3, 3, 7, 0, 0, 0, time.UTC)
+	quiet := &domain.QuietHours{From: 23 * 60, To: 7 * 60}
+
+	type want struct {
+		at time.Time
+		ok bool
+	}
+	now, dropped := want{ok: true}, want{}
+	deferred := want{at: morning, ok: true}
+
+	kinds := append([]domain.NotificationKind{domain.KindCalled}, domain.Kinds...)
+	tests := []struct {
+		name  string
+		prefs func(kind domain.NotificationKind) domain.Preferences
+		now   time.Time
+		want  map[domain.NotificationKind]want
+	}{
+		{
+			name:  "defaults",
+			prefs: func(domain.NotificationKind) domain.Preferences { return domain.Preferences{} },
+			now:   night,
+			want:  map[domain.NotificationKind]want{},
+		},
+		{
+			name: "kind disabled",
+			prefs: func(kind domain.NotificationKind) domain.Preferences {
+				return domain.Preferences{DisabledKinds: []domain.NotificationKind{kind}}
+			},
+			now:  noon,
+			want: map[domain.NotificationKind]want{"": dropped, domain.KindCalled: now},
+		},
+		{
+			name: "queue muted",
+			prefs: func(domain.NotificationKind) domain.Preferences {
+				return domain.Preferences{MutedQueues: []int64{queueID}}
+			},
+			now:  noon,
+			want: map[domain.NotificationKind]want{"": dropped, domain.KindCalled: now},
+		},
+		{
+			name: "outside quiet hours",
+			prefs: func(domain.NotificationKind) domain.Preferences {
+				return domain.Preferences{Quiet: quiet, Timezone: "UTC"}
+			},
+			now:  noon,
+			want: map[domain.NotificationKind]want{},
+		},
+		{
+			name: "during quiet hours",
+			prefs: func(domain.NotificationKind) domain.Preferences {
+				return domain.Preferences{Quiet: quiet, Timezone: "UTC"}
+			},
+			now: night,
+			want: map[domain.NotificationKind]want{
+				"":                      deferred,
+				domain.KindPositionSoon: dropped,
+				domain.KindYourTurn:     now,
+				domain.KindCalled:       now,
+			},
+		},
+		{
+			name: "called ignores every setting",
+			prefs: func(domain.NotificationKind) domain.Preferences {
+				return domain.Preferences{
+					DisabledKinds: domain.Kinds,
+					MutedQueues:   []int64{queueID},
+					Quiet:         quiet,
+					Timezone:      "UTC",
+				}
+			},
+			now:  night,
+			want: map[domain.NotificationKind]want{"": dropped, domain.KindCalled: now},
+		},
+	}
+	for _, tt := range tests {
+		for _, kind := range kinds {
+			t.Run(tt.name+"/"+string(kind), func(t *testing.T) {
+				// The empty kind holds the expectation for kinds not listed;
+				// with no entry at all everything is sent at once.
+				w, ok := tt.want[kind]
+				if !ok {
+					w, ok = tt.want[""]
+				}
+				if !ok {
+					w = now
+				}
+
+				at, sent := schedule(tt.prefs(kind), kind, queueID, tt.now)
+				if sent != w.ok || !at.Equal(w.at) {
+					t.Errorf("schedule = (%v, %v), want (%v, %v)", at, sent, w.at, w.ok)
+				}
+			})
+		}
+	}
+}
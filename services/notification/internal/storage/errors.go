@@ -0,0 +1,11 @@
+package storage
+
+import "errors"
+
+var (
+	ErrContactNotFound      = errors.New("contact not found")
+	ErrNotificationNotFound = errors.New("notification not found")
+	ErrEndpointNotFound     = errors.New("notification channel not found")
+	ErrLinkTokenInvalid     = errors.New("link token not found, used or expired")
+	ErrPreferencesNotFound  = errors.New("preferences not found")
+)
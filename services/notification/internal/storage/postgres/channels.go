@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/domain"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/storage"
+)
+
+const endpointColumns = `user_id, channel, address, secret, p256dh, auth, enabled, updated_at`
+
+func scanEndpoint(row pgx.Row) (domain.Endpoint, error) {
+	var e domain.Endpoint
+	err := row.Scan(&e.UserID, &e.Channel, &e.Address, &e.Secret, &e.P256dh, &e.Auth, &e.Enabled, &e.UpdatedAt)
+	return e, err
+}
+
+func (s *Storage) UpsertEndpoint(ctx context.Context, e domain.Endpoint) (domain.Endpoint, error) {
+	query := `
+INSERT INTO notification_channels (user_id, channel, address, secret, p256dh, auth, enabled)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (user_id, channel) DO UPDATE SET address = EXCLUDED.address, secret = EXCLUDED.secret,
+    p256dh = EXCLUDED.p256dh, auth = EXCLUDED.auth, enabled = EXCLUDED.enabled, updated_at = NOW()
+RETURNING ` + endpointColumns
+
+	saved, err := scanEndpoint(s.pool.QueryRow(ctx, query, e.UserID, e.Channel, e.Address, e.Secret, e.P256dh, e.Auth, e.Enabled))
+	if err != nil {
+		return domain.Endpoint{}, fmt.Errorf("postgres: upsert channel: %w", err)
+	}
+	return saved, nil
+}
+
+func (s *Storage) GetEndpoint(ctx context.Context, userID int64, channel domain.Channel) (domain.Endpoint, error) {
+	query := `SELECT ` + endpointColumns + ` FROM notification_channels WHERE user_id = $1 AND channel = $2`
+	e, err := scanEndpoint(s.pool.QueryRow(ctx, query, userID, channel))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Endpoint{}, storage.ErrEndpointNotFound
+		}
+		return domain.Endpoint{}, fmt.Errorf("postgres: get channel: %w", err)
+	}
+	return e, nil
+}
+
+func (s *Storage) ListEndpoints(ctx context.Context, userID int64) ([]domain.Endpoint, error) {
+	query := `SELECT ` + endpointColumns + ` FROM notification_channels WHERE user_id = $1 ORDER BY channel`
+	rows, err := s.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: list channels: %w", err)
+	}
+	defer rows.Close()
+
+	var list []domain.Endpoint
+	for rows.Next() {
+		e, err := scanEndpoint(rows)
+		if err != nil {
+			return nil, fmt.Errorf("postgres: scan channel: %w", err)
+		}
+		list = append(list, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres: list channels: %w", err)
+	}
+	return list, nil
+}
+
+func (s *Storage) DeleteEndpoint(ctx context.Context, userID int64, channel domain.Channel) error {
+	cmd, err := s.pool.Exec(ctx, `DELETE FROM notification_channels WHERE user_id = $1 AND channel = $2`, userID, channel)
+	if err != nil {
+		return fmt.Errorf("postgres: delete channel: %w", err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return storage.ErrEndpointNotFound
+	}
+	return nil
+}
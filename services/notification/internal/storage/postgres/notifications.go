@@ -0,0 +1,229 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/domain"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/storage"
+)
+
+const notificationColumns = `id, user_id, channel, kind, text, button_text, button_data, status, attempts, last_error, next_attempt_at,
+created_at, updated_at, sent_at`
+
+func scanNotification(row pgx.Row) (domain.Notification, error) {
+	var n domain.Notification
+	var button domain.Button
+	err := row.Scan(&n.ID, &n.UserID, &n.Channel, &n.Kind, &n.Text, &button.Text, &button.Data, &n.Status, &n.Attempts, &n.LastError,
+		&n.NextAttemptAt, &n.CreatedAt, &n.UpdatedAt, &n.SentAt)
+	if button.Data != "" {
+		n.Button = &button
+	}
+	return n, err
+}
+
+// RecordPosition saves the user's new position in the queue. compose gets the
+// previously saved notice (nil if none) and returns the notifications to store,
+// none if the change is not worth one. Returns their ids.
+func (s *Storage) RecordPosition(ctx context.Context, notice domain.PositionNotice, compose func(prev *domain.PositionNotice) ([]domain.Notification, error)) ([]int64, error) {
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("postgres: begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var prev *domain.PositionNotice
+	var p domain.PositionNotice
+	err = tx.QueryRow(ctx, `SELECT user_id, queue_id, participant_id, position, updated_at FROM position_notices
+WHERE user_id = $1 AND queue_id = $2 FOR UPDATE`, notice.UserID, notice.QueueID).
+		Scan(&p.UserID, &p.QueueID, &p.ParticipantID, &p.Position, &p.UpdatedAt)
+	switch {
+	case err == nil:
+		prev = &p
+	case !errors.Is(err, pgx.ErrNoRows):
+		return nil, fmt.Errorf("postgres: get position notice: %w", err)
+	}
+
+	const upsert = `
+INSERT INTO position_notices (user_id, queue_id, participant_id, position, updated_at)
+VALUES ($1, $2, $3, $4, NOW())
+ON CONFLICT (user_id, queue_id) DO UPDATE SET participant_id = EXCLUDED.participant_id, position = EXCLUDED.position, updated_at = NOW()
+`
+	if _, err := tx.Exec(ctx, upsert, notice.UserID, notice.QueueID, notice.ParticipantID, notice.Position); err != nil {
+		return nil, fmt.Errorf("postgres: save position notice: %w", err)
+	}
+
+	list, err := compose(prev)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := insertNotifications(ctx, tx, list)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("postgres: commit: %w", err)
+	}
+	return ids, nil
+}
+
+// CreateNotifications stores pending notifications and returns their ids in the same order.
+func (s *Storage) CreateNotifications(ctx context.Context, list []domain.Notification) ([]int64, error) {
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("postgres: begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	ids, err := insertNotifications(ctx, tx, list)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("postgres: commit: %w", err)
+	}
+	return ids, nil
+}
+
+func insertNotifications(ctx context.Context, tx pgx.Tx, list []domain.Notification) ([]int64, error) {
+	// A zero NextAttemptAt means "send now"; quiet hours push it later.
+	const query = `INSERT INTO notifications (user_id, channel, kind, text, button_text, button_data, next_attempt_at)
+VALUES ($1, $2, $3, $4, $5, $6, COALESCE($7, NOW())) RETURNING id`
+	ids := make([]int64, 0, len(list))
+	for _, n := range list {
+		var at *time.Time
+		if !n.NextAttemptAt.IsZero() {
+			at = &n.NextAttemptAt
+		}
+		var button domain.Button
+		if n.Button != nil {
+			button = *n.Button
+		}
+		var id int64
+		if err := tx.QueryRow(ctx, query, n.UserID, n.Channel, n.Kind, n.Text, button.Text, button.Data, at).Scan(&id); err != nil {
+			return nil, fmt.Errorf("postgres: create notification: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// ClaimNotifications locks up to limit due notifications and moves their next
+// attempt forward by lease, so other workers skip them while they are being sent.
+// Attempts is incremented for each claimed row.
+func (s *Storage) ClaimNotifications(ctx context.Context, limit int, lease time.Duration) ([]domain.Notification, error) {
+	query := `
+UPDATE notifications
+SET next_attempt_at = NOW() + $2::interval, attempts = attempts + 1, updated_at = NOW()
+WHERE id IN (
+    SELECT id FROM notifications
+    WHERE status = 'pending' AND next_attempt_at <= NOW()
+    ORDER BY next_attempt_at
+    LIMIT $1
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING ` + notificationColumns
+
+	rows, err := s.pool.Query(ctx, query, limit, lease)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: claim notifications: %w", err)
+	}
+	defer rows.Close()
+
+	var list []domain.Notification
+	for rows.Next() {
+		n, err := scanNotification(rows)
+		if err != nil {
+			return nil, fmt.Errorf("postgres: scan notification: %w", err)
+		}
+		list = append(list, n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres: claim notifications: %w", err)
+	}
+	return list, nil
+}
+
+func (s *Storage) MarkNotificationSent(ctx context.Context, id int64) error {
+	const query = `
+UPDATE notifications
+SET status = 'sent', last_error = '', sent_at = NOW(), updated_at = NOW()
+WHERE id = $1
+`
+	if _, err := s.pool.Exec(ctx, query, id); err != nil {
+		return fmt.Errorf("postgres: mark notification sent: %w", err)
+	}
+	return nil
+}
+
+// RetryNotification keeps the notification pending until the given time.
+func (s *Storage) RetryNotification(ctx context.Context, id int64, at time.Time, reason string) error {
+	const query = `
+UPDATE notifications
+SET next_attempt_at = $2, last_error = $3, updated_at = NOW()
+WHERE id = $1
+`
+	if _, err := s.pool.Exec(ctx, query, id, at, reason); err != nil {
+		return fmt.Errorf("postgres: retry notification: %w", err)
+	}
+	return nil
+}
+
+func (s *Storage) FailNotification(ctx context.Context, id int64, reason string) error {
+	const query = `
+UPDATE notifications
+SET status = 'failed', last_error = $2, updated_at = NOW()
+WHERE id = $1
+`
+	if _, err := s.pool.Exec(ctx, query, id, reason); err != nil {
+		return fmt.Errorf("postgres: fail notification: %w", err)
+	}
+	return nil
+}
+
+func (s *Storage) GetNotification(ctx context.Context, id int64) (domain.Notification, error) {
+	query := `SELECT ` + notificationColumns + ` FROM notifications WHERE id = $1`
+	n, err := scanNotification(s.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Notification{}, storage.ErrNotificationNotFound
+		}
+		return domain.Notification{}, fmt.Errorf("postgres: get notification: %w", err)
+	}
+	return n, nil
+}
+
+func (s *Storage) ListNotifications(ctx context.Context, filter domain.NotificationFilter) ([]domain.Notification, error) {
+	query := `
+SELECT ` + notificationColumns + `
+FROM notifications
+WHERE ($1 = 0 OR user_id = $1)
+  AND ($2 = '' OR status = $2)
+  AND ($3 = 0 OR id < $3)
+ORDER BY id DESC
+LIMIT $4
+`
+	rows, err := s.pool.Query(ctx, query, filter.UserID, string(filter.Status), filter.BeforeID, filter.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: list notifications: %w", err)
+	}
+	defer rows.Close()
+
+	var list []domain.Notification
+	for rows.Next() {
+		n, err := scanNotification(rows)
+		if err != nil {
+			return nil, fmt.Errorf("postgres: scan notification: %w", err)
+		}
+		list = append(list, n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres: list notifications: %w", err)
+	}
+	return list, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/s1lentmol/q-flow-backend/services/notification/internal/domain"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/storage"
 )
 
 type Storage struct {
@@ -49,19 +50,46 @@ func (s *Storage) GetContact(ctx context.Context, userID int64) (domain.Contact,
 	var c domain.Contact
 	if err := s.pool.QueryRow(ctx, query, userID).Scan(&c.UserID, &c.Username, &c.ChatID, &c.Updated); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return domain.Contact{}, fmt.Errorf("contact not found")
+			return domain.Contact{}, storage.ErrContactNotFound
 		}
 		return domain.Contact{}, fmt.Errorf("postgres: get contact: %w", err)
 	}
 	return c, nil
 }
 
+// DeleteContact removes the user's contact and returns it as it was.
+func (s *Storage) DeleteContact(ctx context.Context, userID int64) (domain.Contact, error) {
+	const query = `DELETE FROM user_contacts WHERE user_id = $1 RETURNING user_id, COALESCE(telegram_username,''), COALESCE(chat_id,''), updated_at`
+	var c domain.Contact
+	if err := s.pool.QueryRow(ctx, query, userID).Scan(&c.UserID, &c.Username, &c.ChatID, &c.Updated); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Contact{}, storage.ErrContactNotFound
+		}
+		return domain.Contact{}, fmt.Errorf("postgres: delete contact: %w", err)
+	}
+	return c, nil
+}
+
+// UserByChat returns the user whose contact is bound to the Telegram chat.
+// If several users share the chat, the one who bound it last wins.
+func (s *Storage) UserByChat(ctx context.Context, chatID string) (int64, error) {
+	const query = `SELECT user_id FROM user_contacts WHERE chat_id = $1 ORDER BY updated_at DESC LIMIT 1`
+	var userID int64
+	if err := s.pool.QueryRow(ctx, query, chatID).Scan(&userID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, storage.ErrContactNotFound
+		}
+		return 0, fmt.Errorf("postgres: get user by chat: %w", err)
+	}
+	return userID, nil
+}
+
 func (s *Storage) CreateLinkToken(ctx context.Context, token domain.LinkToken) error {
 	const query = `
-INSERT INTO telegram_link_tokens (token, user_id, telegram_username)
-VALUES ($1, $2, $3)
+INSERT INTO telegram_link_tokens (token, user_id, telegram_username, expires_at)
+VALUES ($1, $2, $3, $4)
 `
-	_, err := s.pool.Exec(ctx, query, token.Token, token.UserID, token.Username)
+	_, err := s.pool.Exec(ctx, query, token.Token, token.UserID, token.Username, token.Expires)
 	if err != nil {
 		return fmt.Errorf("postgres: create link token: %w", err)
 	}
@@ -72,16 +100,26 @@ func (s *Storage) ConsumeLinkToken(ctx context.Context, token string) (domain.Li
 	const query = `
 UPDATE telegram_link_tokens
 SET used_at = NOW()
-WHERE token = $1 AND used_at IS NULL
-RETURNING token, user_id, telegram_username, created_at, used_at
+WHERE token = $1 AND used_at IS NULL AND expires_at > NOW()
+RETURNING token, user_id, COALESCE(telegram_username,''), created_at, expires_at, used_at
 `
 	var lt domain.LinkToken
 	if err := s.pool.QueryRow(ctx, query, token).
-		Scan(&lt.Token, &lt.UserID, &lt.Username, &lt.Created, &lt.UsedAt); err != nil {
+		Scan(&lt.Token, &lt.UserID, &lt.Username, &lt.Created, &lt.Expires, &lt.UsedAt); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return domain.LinkToken{}, fmt.Errorf("token not found or already used")
+			return domain.LinkToken{}, storage.ErrLinkTokenInvalid
 		}
 		return domain.LinkToken{}, fmt.Errorf("postgres: consume link token: %w", err)
 	}
 	return lt, nil
 }
+
+// PurgeLinkTokens deletes expired tokens, used or not, and returns how many were removed.
+func (s *Storage) PurgeLinkTokens(ctx context.Context) (int64, error) {
+	const query = `DELETE FROM telegram_link_tokens WHERE expires_at <= NOW()`
+	tag, err := s.pool.Exec(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("postgres: purge link tokens: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/domain"
+	"github.com/s1lentmol/q-flow-backend/services/notification/internal/storage"
+)
+
+const preferencesColumns = `user_id, locale, disabled_kinds, notify_top, quiet_from, quiet_to, timezone, updated_at`
+
+func scanPreferences(row pgx.Row) (domain.Preferences, error) {
+	var (
+		p        domain.Preferences
+		kinds    []string
+		from, to *int
+	)
+	if err := row.Scan(&p.UserID, &p.Locale, &kinds, &p.NotifyTop, &from, &to, &p.Timezone, &p.UpdatedAt); err != nil {
+		return domain.Preferences{}, err
+	}
+	for _, k := range kinds {
+		p.DisabledKinds = append(p.DisabledKinds, domain.NotificationKind(k))
+	}
+	if from != nil && to != nil {
+		p.Quiet = &domain.QuietHours{From: *from, To: *to}
+	}
+	return p, nil
+}
+
+// GetPreferences returns the saved settings together with the muted queues.
+func (s *Storage) GetPreferences(ctx context.Context, userID int64) (domain.Preferences, error) {
+	const query = `SELECT ` + preferencesColumns + ` FROM user_preferences WHERE user_id = $1`
+	p, err := scanPreferences(s.pool.QueryRow(ctx, query, userID))
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return domain.Preferences{}, fmt.Errorf("postgres: get preferences: %w", err)
+	}
+	found := err == nil
+
+	muted, err := s.mutedQueues(ctx, userID)
+	if err != nil {
+		return domain.Preferences{}, err
+	}
+	if !found {
+		// Mutes are kept apart, so they can exist without saved settings.
+		return domain.Preferences{UserID: userID, MutedQueues: muted}, storage.ErrPreferencesNotFound
+	}
+	p.MutedQueues = muted
+	return p, nil
+}
+
+// UpsertPreferences replaces the user's settings; mutes are left as they are.
+func (s *Storage) UpsertPreferences(ctx context.Context, p domain.Preferences) (domain.Preferences, error) {
+	const query = `
+INSERT INTO user_preferences (user_id, locale, disabled_kinds, notify_top, quiet_from, quiet_to, timezone, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, NOW())
+ON CONFLICT (user_id) DO UPDATE SET
+    locale = EXCLUDED.locale,
+    disabled_kinds = EXCLUDED.disabled_kinds,
+    notify_top = EXCLUDED.notify_top,
+    quiet_from = EXCLUDED.quiet_from,
+    quiet_to = EXCLUDED.quiet_to,
+    timezone = EXCLUDED.timezone,
+    updated_at = NOW()
+RETURNING ` + preferencesColumns
+
+	kinds := make([]string, 0, len(p.DisabledKinds))
+	for _, k := range p.DisabledKinds {
+		kinds = append(kinds, string(k))
+	}
+	var from, to *int
+	if p.Quiet != nil {
+		from, to = &p.Quiet.From, &p.Quiet.To
+	}
+
+	saved, err := scanPreferences(s.pool.QueryRow(ctx, query, p.UserID, p.Locale, kinds, p.NotifyTop, from, to, p.Timezone))
+	if err != nil {
+		return domain.Preferences{}, fmt.Errorf("postgres: upsert preferences: %w", err)
+	}
+	if saved.MutedQueues, err = s.mutedQueues(ctx, p.UserID); err != nil {
+		return domain.Preferences{}, err
+	}
+	return saved, nil
+}
+
+func (s *Storage) MuteQueue(ctx context.Context, userID, queueID int64) error {
+	const query = `INSERT INTO queue_mutes (user_id, queue_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+	if _, err := s.pool.Exec(ctx, query, userID, queueID); err != nil {
+		return fmt.Errorf("postgres: mute queue: %w", err)
+	}
+	return nil
+}
+
+func (s *Storage) UnmuteQueue(ctx context.Context, userID, queueID int64) error {
+	const query = `DELETE FROM queue_mutes WHERE user_id = $1 AND queue_id = $2`
+	if _, err := s.pool.Exec(ctx, query, userID, queueID); err != nil {
+		return fmt.Errorf("postgres: unmute queue: %w", err)
+	}
+	return nil
+}
+
+func (s *Storage) mutedQueues(ctx context.Context, userID int64) ([]int64, error) {
+	rows, err := s.pool.Query(ctx, `SELECT queue_id FROM queue_mutes WHERE user_id = $1 ORDER BY queue_id`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: list muted queues: %w", err)
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		return nil, fmt.Errorf("postgres: scan muted queues: %w", err)
+	}
+	return ids, nil
+}
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Last position reported for a user in a queue, used to notify only on transitions.
+CREATE TABLE IF NOT EXISTS position_notices (
+    user_id BIGINT NOT NULL,
+    queue_id BIGINT NOT NULL,
+    participant_id BIGINT NOT NULL,
+    position INT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, queue_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS position_notices;
+-- +goose StatementEnd
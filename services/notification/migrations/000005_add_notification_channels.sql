@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Channels a user receives notifications through besides the Telegram contact.
+-- A user without rows here gets Telegram only.
+CREATE TABLE IF NOT EXISTS notification_channels (
+    user_id BIGINT NOT NULL,
+    channel TEXT NOT NULL CHECK (channel IN ('telegram', 'email', 'webhook', 'webpush')),
+    address TEXT NOT NULL DEFAULT '',
+    secret TEXT NOT NULL DEFAULT '',
+    p256dh TEXT NOT NULL DEFAULT '',
+    auth TEXT NOT NULL DEFAULT '',
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, channel)
+);
+
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS channel TEXT NOT NULL DEFAULT 'telegram';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE notifications DROP COLUMN IF EXISTS channel;
+DROP TABLE IF EXISTS notification_channels;
+-- +goose StatementEnd
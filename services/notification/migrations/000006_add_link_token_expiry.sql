@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE telegram_link_tokens ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ;
+UPDATE telegram_link_tokens SET expires_at = created_at + INTERVAL '15 minutes' WHERE expires_at IS NULL;
+ALTER TABLE telegram_link_tokens ALTER COLUMN expires_at SET NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_link_tokens_expires ON telegram_link_tokens(expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_link_tokens_expires;
+ALTER TABLE telegram_link_tokens DROP COLUMN IF EXISTS expires_at;
+-- +goose StatementEnd
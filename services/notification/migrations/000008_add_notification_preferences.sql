@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Quiet hours are minutes after midnight in the user's timezone; NULL means off.
+ALTER TABLE user_preferences
+    ADD COLUMN IF NOT EXISTS disabled_kinds TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS notify_top INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS quiet_from INT,
+    ADD COLUMN IF NOT EXISTS quiet_to INT,
+    ADD COLUMN IF NOT EXISTS timezone TEXT NOT NULL DEFAULT 'Europe/Moscow';
+
+CREATE TABLE IF NOT EXISTS queue_mutes (
+    user_id BIGINT NOT NULL,
+    queue_id BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, queue_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS queue_mutes;
+ALTER TABLE user_preferences
+    DROP COLUMN IF EXISTS disabled_kinds,
+    DROP COLUMN IF EXISTS notify_top,
+    DROP COLUMN IF EXISTS quiet_from,
+    DROP COLUMN IF EXISTS quiet_to,
+    DROP COLUMN IF EXISTS timezone;
+-- +goose StatementEnd
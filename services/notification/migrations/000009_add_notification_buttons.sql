@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Optional button under the message; channels without buttons ignore it.
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS button_text TEXT NOT NULL DEFAULT '';
+ALTER TABLE notifications ADD COLUMN IF NOT EXISTS button_data TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE notifications DROP COLUMN IF EXISTS button_data;
+ALTER TABLE notifications DROP COLUMN IF EXISTS button_text;
+-- +goose StatementEnd
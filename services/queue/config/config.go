@@ -11,11 +11,16 @@ import (
 )
 
 type Config struct {
-	Env      string
-	TokenTTL time.Duration `mapstructure:"token_ttl"` // reserved for future use
-	DB       DBConfig
-	GRPC     GRPCConfig
-	Notify   NotifyConfig
+	Env       string
+	TokenTTL  time.Duration `mapstructure:"token_ttl"` // reserved for future use
+	DB        DBConfig
+	GRPC      GRPCConfig
+	Notify    NotifyConfig
+	Auth      AuthConfig
+	Scheduler SchedulerConfig
+	Calls     CallsConfig
+	Swaps     SwapsConfig
+	Outbox    OutboxConfig
 }
 
 type DBConfig struct {
@@ -35,6 +40,35 @@ type NotifyConfig struct {
 	Address string `yaml:"address"`
 }
 
+type AuthConfig struct {
+	Address string `yaml:"address"`
+}
+
+// SchedulerConfig sets how often scheduled queues are checked for opening and closing.
+type SchedulerConfig struct {
+	Interval time.Duration `yaml:"interval"`
+}
+
+// CallsConfig sets how often calls are checked for timing out.
+type CallsConfig struct {
+	Interval time.Duration `yaml:"interval"`
+}
+
+// SwapsConfig sets how often unanswered swap requests are checked for expiry.
+type SwapsConfig struct {
+	Interval time.Duration `yaml:"interval"`
+}
+
+// OutboxConfig tunes delivery of queued notifications to the notification service.
+type OutboxConfig struct {
+	Interval    time.Duration `mapstructure:"interval"`
+	BatchSize   int           `mapstructure:"batch_size"`
+	MaxAttempts int           `mapstructure:"max_attempts"`
+	BaseBackoff time.Duration `mapstructure:"base_backoff"`
+	MaxBackoff  time.Duration `mapstructure:"max_backoff"`
+	Lease       time.Duration `mapstructure:"lease"`
+}
+
 //go:embed config.yaml
 var defaultYAML []byte
 
@@ -3,10 +3,13 @@ package app
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	"github.com/s1lentmol/q-flow-backend/services/queue/config"
 	grpcapp "github.com/s1lentmol/q-flow-backend/services/queue/internal/app/grpc"
+	authclient "github.com/s1lentmol/q-flow-backend/services/queue/internal/clients/auth"
 	notifyclient "github.com/s1lentmol/q-flow-backend/services/queue/internal/clients/notification"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/services/outbox"
 	"github.com/s1lentmol/q-flow-backend/services/queue/internal/services/queue"
 	"github.com/s1lentmol/q-flow-backend/services/queue/internal/storage/postgres"
 	migrator "github.com/s1lentmol/q-flow-backend/services/queue/migrations"
@@ -18,6 +21,7 @@ type App struct {
 	GRPCSrv *grpcapp.App
 	storage *postgres.Storage
 	notif   *grpc.ClientConn
+	auth    *grpc.ClientConn
 }
 
 func New(ctx context.Context, log *slog.Logger, cfg *config.Config) (*App, error) {
@@ -37,7 +41,26 @@ func New(ctx context.Context, log *slog.Logger, cfg *config.Config) (*App, error
 
 	notif := notifyclient.New(conn)
 
-	queueService := queue.New(log, store, notif)
+	authConn, err := grpc.DialContext(ctx, cfg.Auth.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	queueService := queue.New(log, store, authclient.New(authConn))
+
+	dispatcher := outbox.New(log, store, notif, outbox.Config{
+		Interval:    cfg.Outbox.Interval,
+		BatchSize:   cfg.Outbox.BatchSize,
+		MaxAttempts: cfg.Outbox.MaxAttempts,
+		BaseBackoff: cfg.Outbox.BaseBackoff,
+		MaxBackoff:  cfg.Outbox.MaxBackoff,
+		Lease:       cfg.Outbox.Lease,
+	})
+	go dispatcher.Run(ctx)
+
+	go runScheduler(ctx, log, queueService, cfg.Scheduler.Interval)
+	go runCallExpiry(ctx, log, queueService, cfg.Calls.Interval)
+	go runSwapExpiry(ctx, log, queueService, cfg.Swaps.Interval)
 
 	grpcApp := grpcapp.New(log, queueService, cfg.GRPC.Port)
 
@@ -45,6 +68,7 @@ func New(ctx context.Context, log *slog.Logger, cfg *config.Config) (*App, error
 		GRPCSrv: grpcApp,
 		storage: store,
 		notif:   conn,
+		auth:    authConn,
 	}, nil
 }
 
@@ -54,4 +78,66 @@ func (a *App) Stop() {
 	if a.notif != nil {
 		_ = a.notif.Close()
 	}
+	if a.auth != nil {
+		_ = a.auth.Close()
+	}
+}
+
+// runScheduler publishes queues from templates, opens and closes scheduled
+// queues and draws random queues every interval until ctx is done.
+func runScheduler(ctx context.Context, log *slog.Logger, queueService *queue.Service, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := queueService.InstantiateTemplates(ctx); err != nil {
+				log.Warn("failed to instantiate queue templates", slog.Any("err", err))
+			}
+			if err := queueService.ApplySchedule(ctx); err != nil {
+				log.Warn("failed to apply queue schedule", slog.Any("err", err))
+			}
+			if err := queueService.DrawDueLotteries(ctx); err != nil {
+				log.Warn("failed to draw lotteries", slog.Any("err", err))
+			}
+		}
+	}
+}
+
+// runCallExpiry moves back or skips participants who did not check in on time
+// every interval until ctx is done.
+func runCallExpiry(ctx context.Context, log *slog.Logger, queueService *queue.Service, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := queueService.ExpireCalls(ctx); err != nil {
+				log.Warn("failed to expire calls", slog.Any("err", err))
+			}
+		}
+	}
+}
+
+// runSwapExpiry closes unanswered swap requests every interval until ctx is done.
+func runSwapExpiry(ctx context.Context, log *slog.Logger, queueService *queue.Service, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := queueService.ExpireSwapRequests(ctx); err != nil {
+				log.Warn("failed to expire swap requests", slog.Any("err", err))
+			}
+		}
+	}
 }
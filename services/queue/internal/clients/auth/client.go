@@ -0,0 +1,24 @@
+package auth
+
+import (
+	"context"
+
+	authv1 "github.com/s1lentmol/q-flow-backend/protos/gen/go/auth"
+	"google.golang.org/grpc"
+)
+
+type Client struct {
+	api authv1.AuthClient
+}
+
+func New(conn *grpc.ClientConn) *Client {
+	return &Client{api: authv1.NewAuthClient(conn)}
+}
+
+func (c *Client) IsAdmin(ctx context.Context, userID int64) (bool, error) {
+	resp, err := c.api.IsAdmin(ctx, &authv1.IsAdminRequest{UserId: userID})
+	if err != nil {
+		return false, err
+	}
+	return resp.GetIsAdmin(), nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	notificationv1 "github.com/s1lentmol/q-flow-backend/protos/gen/go/notification"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
 	"google.golang.org/grpc"
 )
 
@@ -15,11 +16,76 @@ func New(conn *grpc.ClientConn) *Client {
 	return &Client{api: notificationv1.NewNotificationClient(conn)}
 }
 
-func (c *Client) NotifyPositionSoon(ctx context.Context, userID int64, queueTitle string, position int32) error {
+func (c *Client) NotifyPositionSoon(ctx context.Context, p models.PositionSoonPayload) error {
 	_, err := c.api.NotifyPositionSoon(ctx, &notificationv1.NotifyPositionSoonRequest{
-		UserId:     userID,
-		QueueTitle: queueTitle,
-		Position:   position,
+		UserId:        p.UserID,
+		QueueTitle:    p.QueueTitle,
+		Position:      p.Position,
+		QueueId:       p.QueueID,
+		ParticipantId: p.ParticipantID,
+		NotifyTop:     p.NotifyTop,
+		Added:         p.Added,
+	})
+	return err
+}
+
+func (c *Client) NotifyQueueOpened(ctx context.Context, p models.QueueOpenedPayload) error {
+	_, err := c.api.NotifyQueueOpened(ctx, &notificationv1.NotifyQueueOpenedRequest{
+		UserIds:    p.UserIDs,
+		QueueId:    p.QueueID,
+		QueueTitle: p.QueueTitle,
+	})
+	return err
+}
+
+func (c *Client) NotifyQueueArchived(ctx context.Context, p models.QueueArchivedPayload) error {
+	_, err := c.api.NotifyQueueArchived(ctx, &notificationv1.NotifyQueueArchivedRequest{
+		UserIds:    p.UserIDs,
+		QueueId:    p.QueueID,
+		QueueTitle: p.QueueTitle,
+	})
+	return err
+}
+
+func (c *Client) NotifyParticipantRemoved(ctx context.Context, p models.ParticipantRemovedPayload) error {
+	_, err := c.api.NotifyParticipantRemoved(ctx, &notificationv1.NotifyParticipantRemovedRequest{
+		UserId:     p.UserID,
+		QueueId:    p.QueueID,
+		QueueTitle: p.QueueTitle,
+	})
+	return err
+}
+
+func (c *Client) NotifyCalled(ctx context.Context, p models.ParticipantCalledPayload) error {
+	_, err := c.api.NotifyCalled(ctx, &notificationv1.NotifyCalledRequest{
+		UserId:         p.UserID,
+		QueueId:        p.QueueID,
+		QueueTitle:     p.QueueTitle,
+		TimeoutSeconds: p.Timeout,
+	})
+	return err
+}
+
+func (c *Client) NotifySwapRequested(ctx context.Context, p models.SwapRequestedPayload) error {
+	_, err := c.api.NotifySwapRequested(ctx, &notificationv1.NotifySwapRequestedRequest{
+		UserId:         p.UserID,
+		QueueId:        p.QueueID,
+		QueueTitle:     p.QueueTitle,
+		RequestId:      p.RequestID,
+		FromName:       p.FromName,
+		Position:       p.Position,
+		TimeoutSeconds: p.Timeout,
+	})
+	return err
+}
+
+func (c *Client) NotifySwapAnswered(ctx context.Context, p models.SwapAnsweredPayload) error {
+	_, err := c.api.NotifySwapAnswered(ctx, &notificationv1.NotifySwapAnsweredRequest{
+		UserId:     p.UserID,
+		QueueId:    p.QueueID,
+		QueueTitle: p.QueueTitle,
+		Accepted:   p.Accepted,
+		Position:   p.Position,
 	})
 	return err
 }
@@ -0,0 +1,59 @@
+package models
+
+import "time"
+
+type EventType string
+
+const (
+	EventCreated  EventType = "created"
+	EventUpdated  EventType = "updated"
+	EventArchived EventType = "archived"
+	EventDeleted  EventType = "deleted"
+	EventOpened   EventType = "opened"
+	EventClosed   EventType = "closed"
+	EventJoined   EventType = "joined"
+	EventAdded    EventType = "added"
+	EventLeft     EventType = "left"
+	EventRemoved  EventType = "removed"
+	EventAdvanced EventType = "advanced"
+	EventSkipped  EventType = "skipped"
+
+	EventCalled     EventType = "called"
+	EventCheckedIn  EventType = "checked_in"
+	EventCallMissed EventType = "call_missed"
+
+	EventMoved     EventType = "moved"
+	EventReordered EventType = "reordered"
+	EventDeferred  EventType = "deferred"
+	EventDrawn     EventType = "drawn"
+
+	EventPriorityChanged EventType = "priority_changed"
+
+	EventRoleGranted EventType = "role_granted"
+	EventRoleRevoked EventType = "role_revoked"
+
+	EventSlotsUpdated EventType = "slots_updated"
+)
+
+// QueueEvent is a single audit record. UserID and Position describe the
+// affected participant and are zero for queue-level events.
+type QueueEvent struct {
+	ID        int64
+	QueueID   int64
+	Type      EventType
+	ActorID   int64
+	UserID    int64
+	Position  int32
+	CreatedAt time.Time
+}
+
+// EventFilter narrows ListQueueEvents. Zero values mean "no filter".
+// Events are returned newest first; BeforeID is the pagination cursor.
+type EventFilter struct {
+	ActorID  int64
+	Type     EventType
+	Since    time.Time
+	Until    time.Time
+	BeforeID int64
+	Limit    int
+}
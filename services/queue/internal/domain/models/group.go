@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+type GroupRole string
+
+const (
+	GroupRoleAdmin  GroupRole = "admin"
+	GroupRoleMember GroupRole = "member"
+)
+
+type MemberStatus string
+
+const (
+	MemberPending MemberStatus = "pending"
+	MemberActive  MemberStatus = "active"
+)
+
+// Group owns queues through Queue.GroupCode. Users join it with InviteCode;
+// with RequireApproval a group admin has to accept them first.
+type Group struct {
+	ID              int64
+	Code            string
+	Name            string
+	OwnerID         int64
+	InviteCode      string
+	RequireApproval bool
+	CreatedAt       time.Time
+}
+
+type GroupMember struct {
+	GroupID   int64
+	UserID    int64
+	FullName  string
+	Role      GroupRole
+	Status    MemberStatus
+	CreatedAt time.Time
+}
+
+// UserGroup is a group as seen by one of its members.
+type UserGroup struct {
+	Group
+	Role   GroupRole
+	Status MemberStatus
+}
@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// Outcome tells why a participant left the queue.
+type Outcome string
+
+const (
+	OutcomeServed  Outcome = "served"
+	OutcomeSkipped Outcome = "skipped"
+	OutcomeLeft    Outcome = "left"
+	OutcomeRemoved Outcome = "removed"
+)
+
+type HistoryEntry struct {
+	ID         int64
+	QueueID    int64
+	UserID     int64
+	FullName   string
+	Position   int32
+	SlotTime   *time.Time
+	Outcome    Outcome
+	ActorID    int64
+	JoinedAt   time.Time
+	FinishedAt time.Time
+}
+
+// HistoryFilter narrows GetQueueHistory. Zero values mean "no filter".
+// Entries are returned newest first; BeforeID is the pagination cursor.
+type HistoryFilter struct {
+	UserID   int64
+	Outcome  Outcome
+	BeforeID int64
+	Limit    int
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"strconv"
+)
+
+// LotteryKey is what a participant is ranked by in the draw: SHA-256 of
+// "<seed>:<user id>", with the seed as published (hex).
+func LotteryKey(seed string, userID int64) [sha256.Size]byte {
+	return sha256.Sum256([]byte(seed + ":" + strconv.FormatInt(userID, 10)))
+}
+
+// LotteryCommit is the hex SHA-256 of the seed, published before the draw so
+// that the seed revealed at the draw can be checked against it.
+func LotteryCommit(seed string) string {
+	sum := sha256.Sum256([]byte(seed))
+	return hex.EncodeToString(sum[:])
+}
+
+// DrawOrder orders the participants by their lottery keys, smallest first.
+// The result depends only on the seed and the set of users, so anyone who
+// knows both can repeat the draw.
+func DrawOrder(seed string, list []Participant) []Participant {
+	order := slices.Clone(list)
+	keys := make(map[int64][sha256.Size]byte, len(order))
+	for _, p := range order {
+		keys[p.UserID] = LotteryKey(seed, p.UserID)
+	}
+	slices.SortFunc(order, func(a, b Participant) int {
+		ka, kb := keys[a.UserID], keys[b.UserID]
+		return bytes.Compare(ka[:], kb[:])
+	})
+	return order
+}
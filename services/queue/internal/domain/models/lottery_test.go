@@ -0,0 +1,87 @@
+package models
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"testing"
+)
+
+func TestLotteryCommit(t *testing.T) {
+	tests := []struct {
+		seed string
+		want string
+	}{
+		{"abc", "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+		{"", "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+	}
+	for _, tt := range tests {
+		if got := LotteryCommit(tt.seed); got != tt.want {
+			t.Errorf("LotteryCommit(%q) = %s, want %s", tt.seed, got, tt.want)
+		}
+	}
+
+	seed := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	sum := sha256.Sum256([]byte(seed))
+	if got := LotteryCommit(seed); got != hex.EncodeToString(sum[:]) {
+		t.Errorf("LotteryCommit(%q) = %s, want the hex SHA-256 of the seed", seed, got)
+	}
+}
+
+func TestDrawOrder(t *testing.T) {
+	list := make([]Participant, 0, 20)
+	for i := int64(1); i <= 20; i++ {
+		list = append(list, Participant{ID: 100 + i, UserID: i})
+	}
+	users := func(order []Participant) []int64 {
+		ids := make([]int64, 0, len(order))
+		for _, p := range order {
+			ids = append(ids, p.UserID)
+		}
+		return ids
+	}
+
+	tests := []struct {
+		name string
+		seed string
+	}{
+		{"hex seed", "5d41402abc4b2a76b9719d911017c592"},
+		{"another seed", "7d793037a0760186574b0282f2f435e7"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := DrawOrder(tt.seed, list)
+			if len(order) != len(list) {
+				t.Fatalf("DrawOrder returned %d participants, want %d", len(order), len(list))
+			}
+
+			reversed := slices.Clone(list)
+			slices.Reverse(reversed)
+			if got, want := users(DrawOrder(tt.seed, reversed)), users(order); !slices.Equal(got, want) {
+				t.Errorf("order depends on the input order: %v, want %v", got, want)
+			}
+
+			for i := 1; i < len(order); i++ {
+				prev, cur := LotteryKey(tt.seed, order[i-1].UserID), LotteryKey(tt.seed, order[i].UserID)
+				if bytes.Compare(prev[:], cur[:]) > 0 {
+					t.Fatalf("participants %d and %d are not ordered by their keys", order[i-1].UserID, order[i].UserID)
+				}
+			}
+		})
+	}
+
+	if a, b := users(DrawOrder(tests[0].seed, list)), users(DrawOrder(tests[1].seed, list)); slices.Equal(a, b) {
+		t.Errorf("different seeds gave the same order %v", a)
+	}
+	if got := DrawOrder("seed", list); &got[0] == &list[0] {
+		t.Error("DrawOrder reuses its input")
+	}
+}
+
+func TestLotteryKey(t *testing.T) {
+	want := sha256.Sum256([]byte("abc:42"))
+	if got := LotteryKey("abc", 42); got != want {
+		t.Errorf("LotteryKey = %x, want SHA-256 of %q", got, "abc:42")
+	}
+}
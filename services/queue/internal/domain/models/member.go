@@ -0,0 +1,53 @@
+package models
+
+import "time"
+
+type Role string
+
+const (
+	RoleOwner     Role = "owner"
+	RoleModerator Role = "moderator"
+	RoleViewer    Role = "viewer"
+)
+
+type Permission int
+
+const (
+	// PermModerate allows advancing the queue and adding or removing participants.
+	PermModerate Permission = iota + 1
+	// PermEdit allows changing queue details and archiving it.
+	PermEdit
+	// PermManageMembers allows granting and revoking roles.
+	PermManageMembers
+	// PermDelete allows deleting the queue.
+	PermDelete
+)
+
+var rolePermissions = map[Role][]Permission{
+	RoleOwner:     {PermModerate, PermEdit, PermManageMembers, PermDelete},
+	RoleModerator: {PermModerate},
+	RoleViewer:    {},
+}
+
+// Can reports whether the role grants perm.
+func (r Role) Can(perm Permission) bool {
+	for _, p := range rolePermissions[r] {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
+
+func (r Role) Valid() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+type QueueMember struct {
+	QueueID   int64
+	UserID    int64
+	Role      Role
+	GrantedBy int64
+	CreatedAt time.Time
+}
@@ -0,0 +1,87 @@
+package models
+
+import "time"
+
+type OutboxKind string
+
+const (
+	OutboxPositionSoon       OutboxKind = "position_soon"
+	OutboxQueueOpened        OutboxKind = "queue_opened"
+	OutboxQueueArchived      OutboxKind = "queue_archived"
+	OutboxParticipantRemoved OutboxKind = "participant_removed"
+	OutboxParticipantCalled  OutboxKind = "participant_called"
+	OutboxSwapRequested      OutboxKind = "swap_requested"
+	OutboxSwapAnswered       OutboxKind = "swap_answered"
+)
+
+// OutboxMessage is a notification waiting to be delivered. Payload is the JSON
+// of the payload type matching Kind.
+type OutboxMessage struct {
+	ID        int64
+	Kind      OutboxKind
+	Payload   []byte
+	Attempts  int
+	CreatedAt time.Time
+}
+
+// PositionSoonPayload reports a participant's position. The notification
+// service decides whether it is worth a message, so the same position may be
+// reported many times.
+type PositionSoonPayload struct {
+	UserID        int64  `json:"user_id"`
+	QueueID       int64  `json:"queue_id"`
+	ParticipantID int64  `json:"participant_id"`
+	QueueTitle    string `json:"queue_title"`
+	Position      int32  `json:"position"`
+	NotifyTop     int32  `json:"notify_top"`
+	Added         bool   `json:"added,omitempty"`
+}
+
+type QueueOpenedPayload struct {
+	UserIDs    []int64 `json:"user_ids"`
+	QueueID    int64   `json:"queue_id"`
+	QueueTitle string  `json:"queue_title"`
+}
+
+// QueueArchivedPayload lists the users who were still in the queue.
+type QueueArchivedPayload struct {
+	UserIDs    []int64 `json:"user_ids"`
+	QueueID    int64   `json:"queue_id"`
+	QueueTitle string  `json:"queue_title"`
+}
+
+// ParticipantRemovedPayload is sent when someone else took the user out of the queue.
+type ParticipantRemovedPayload struct {
+	UserID     int64  `json:"user_id"`
+	QueueID    int64  `json:"queue_id"`
+	QueueTitle string `json:"queue_title"`
+}
+
+// ParticipantCalledPayload asks the user to come and check in within Timeout seconds.
+type ParticipantCalledPayload struct {
+	UserID     int64  `json:"user_id"`
+	QueueID    int64  `json:"queue_id"`
+	QueueTitle string `json:"queue_title"`
+	Timeout    int32  `json:"timeout_seconds"`
+}
+
+// SwapRequestedPayload asks the user to accept FromName's swap request within Timeout seconds.
+type SwapRequestedPayload struct {
+	UserID     int64  `json:"user_id"`
+	QueueID    int64  `json:"queue_id"`
+	QueueTitle string `json:"queue_title"`
+	RequestID  int64  `json:"request_id"`
+	FromName   string `json:"from_name"`
+	Position   int32  `json:"position"`
+	Timeout    int32  `json:"timeout_seconds"`
+}
+
+// SwapAnsweredPayload tells the requester how their swap request was answered;
+// Position is their place after an accepted swap.
+type SwapAnsweredPayload struct {
+	UserID     int64  `json:"user_id"`
+	QueueID    int64  `json:"queue_id"`
+	QueueTitle string `json:"queue_title"`
+	Accepted   bool   `json:"accepted"`
+	Position   int32  `json:"position,omitempty"`
+}
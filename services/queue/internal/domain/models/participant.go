@@ -10,4 +10,15 @@ type Participant struct {
 	SlotTime  *time.Time
 	FullName  string
 	CreatedAt time.Time
+	// CalledAt is set while the participant is called to the head of a queue
+	// with calls; CheckedInAt once they confirmed they are coming.
+	CalledAt    *time.Time
+	CheckedInAt *time.Time
+	// MissedCalls counts calls the participant let time out.
+	MissedCalls int32
+	// Deferrals counts how many times the participant let others go first.
+	Deferrals int32
+	// Priority is set by the owner of a priority queue; higher levels go
+	// first, zero is the default.
+	Priority int32
 }
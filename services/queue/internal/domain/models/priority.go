@@ -0,0 +1,30 @@
+package models
+
+import (
+	"cmp"
+	"slices"
+)
+
+// PriorityOrder orders the participants of a priority queue by
+// ComparePriority. A called head keeps its place until it is served.
+func PriorityOrder(list []Participant) []Participant {
+	order := slices.Clone(list)
+	rest := order
+	if len(rest) > 0 && rest[0].CalledAt != nil {
+		rest = rest[1:]
+	}
+	slices.SortFunc(rest, ComparePriority)
+	return order
+}
+
+// ComparePriority puts higher levels first and, within a level, whoever
+// joined earlier; the id breaks ties of the joining time. The result does
+// not depend on the current positions, so every change of a priority queue
+// ends in the same order.
+func ComparePriority(a, b Participant) int {
+	return cmp.Or(
+		cmp.Compare(b.Priority, a.Priority),
+		a.CreatedAt.Compare(b.CreatedAt),
+		cmp.Compare(a.ID, b.ID),
+	)
+}
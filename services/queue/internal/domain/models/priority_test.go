@@ -0,0 +1,106 @@
+package models
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestComparePriority(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		a, b Participant
+		want int
+	}{
+		{"higher level first", Participant{ID: 2, Priority: 1, CreatedAt: t0.Add(time.Hour)}, Participant{ID: 1, CreatedAt: t0}, -1},
+		{"lower level last", Participant{ID: 1, Priority: -1, CreatedAt: t0}, Participant{ID: 2, CreatedAt: t0.Add(time.Hour)}, 1},
+		{"earlier join first", Participant{ID: 2, CreatedAt: t0}, Participant{ID: 1, CreatedAt: t0.Add(time.Second)}, -1},
+		{"id breaks a tie", Participant{ID: 1, CreatedAt: t0}, Participant{ID: 2, CreatedAt: t0}, -1},
+		{"same participant", Participant{ID: 1, CreatedAt: t0}, Participant{ID: 1, CreatedAt: t0}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ComparePriority(tt.a, tt.b); got != tt.want {
+				t.Errorf("ComparePriority = %d, want %d", got, tt.want)
+			}
+			if got := ComparePriority(tt.b, tt.a); got != -tt.want {
+				t.Errorf("reversed ComparePriority = %d, want %d", got, -tt.want)
+			}
+		})
+	}
+}
+
+func TestPriorityOrder(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	at := func(min int) time.Time { return t0.Add(time.Duration(min) * time.Minute) }
+	called := at(30)
+
+	tests := []struct {
+		name string
+		list []Participant
+		want []int64
+	}{
+		{
+			name: "empty",
+			list: nil,
+			want: []int64{},
+		},
+		{
+			name: "level desc, then join time, then id",
+			list: []Participant{
+				{ID: 1, CreatedAt: at(0)},
+				{ID: 2, CreatedAt: at(5), Priority: 2},
+				{ID: 3, CreatedAt: at(1), Priority: -1},
+				{ID: 4, CreatedAt: at(2)},
+				{ID: 5, CreatedAt: at(5), Priority: 2},
+				{ID: 6, CreatedAt: at(4), Priority: 2},
+			},
+			want: []int64{6, 2, 5, 1, 4, 3},
+		},
+		{
+			name: "current order does not matter",
+			list: []Participant{
+				{ID: 4, CreatedAt: at(2)},
+				{ID: 1, CreatedAt: at(0)},
+				{ID: 3, CreatedAt: at(1)},
+			},
+			want: []int64{1, 3, 4},
+		},
+		{
+			name: "called head stays first",
+			list: []Participant{
+				{ID: 1, CreatedAt: at(3), CalledAt: &called},
+				{ID: 2, CreatedAt: at(0), Priority: 5},
+				{ID: 3, CreatedAt: at(1)},
+			},
+			want: []int64{1, 2, 3},
+		},
+		{
+			name: "a called participant elsewhere is not pinned",
+			list: []Participant{
+				{ID: 1, CreatedAt: at(0)},
+				{ID: 2, CreatedAt: at(1), CalledAt: &called},
+				{ID: 3, CreatedAt: at(2), Priority: 1},
+			},
+			want: []int64{3, 1, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := slices.Clone(tt.list)
+			order := PriorityOrder(tt.list)
+
+			got := make([]int64, 0, len(order))
+			for _, p := range order {
+				got = append(got, p.ID)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("PriorityOrder = %v, want %v", got, tt.want)
+			}
+			if !slices.EqualFunc(tt.list, input, func(a, b Participant) bool { return a.ID == b.ID }) {
+				t.Error("PriorityOrder changed its input")
+			}
+		})
+	}
+}
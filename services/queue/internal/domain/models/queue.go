@@ -4,20 +4,31 @@ import "time"
 
 type QueueMode string
 
+// A priority queue orders participants by the priority the owner gave them,
+// then by the time they joined.
 const (
-	ModeLive    QueueMode = "live"
-	ModeManaged QueueMode = "managed"
-	ModeRandom  QueueMode = "random"
-	ModeSlots   QueueMode = "slots"
+	ModeLive     QueueMode = "live"
+	ModeManaged  QueueMode = "managed"
+	ModeRandom   QueueMode = "random"
+	ModeSlots    QueueMode = "slots"
+	ModePriority QueueMode = "priority"
 )
 
 type QueueStatus string
 
+// A scheduled queue opens at OpensAt and an active one closes at ClosesAt;
+// both moves are made by the scheduler. Nobody can join a closed queue, but
+// the participants already in it are still served.
 const (
-	StatusActive   QueueStatus = "active"
-	StatusArchived QueueStatus = "archived"
+	StatusScheduled QueueStatus = "scheduled"
+	StatusActive    QueueStatus = "active"
+	StatusClosed    QueueStatus = "closed"
+	StatusArchived  QueueStatus = "archived"
 )
 
+// DefaultNotifyTop is used when a queue is created without NotifyTop.
+const DefaultNotifyTop = 3
+
 type Queue struct {
 	ID          int64
 	Title       string
@@ -26,6 +37,36 @@ type Queue struct {
 	Status      QueueStatus
 	GroupCode   string
 	OwnerID     int64
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	OpensAt     *time.Time
+	ClosesAt    *time.Time
+	// NotifyTop is how many participants at the head are told that their turn is near.
+	NotifyTop int32
+	// CallTimeout is how long a called participant has to check in; zero
+	// turns calls off and advancing serves the head at once.
+	CallTimeout time.Duration
+	// CallMissShift is how many places a participant who missed the call is
+	// moved back; zero skips them.
+	CallMissShift int32
+	// SwapsAllowed lets participants ask each other to swap places.
+	SwapsAllowed bool
+	// LotteryAt is when a random queue draws its order; the owner may also
+	// draw it earlier. LotterySeed is chosen at creation and published once
+	// DrawnAt is set; until then only LotteryCommit, its SHA-256, is shown.
+	LotteryAt     *time.Time
+	LotterySeed   string
+	LotteryCommit string
+	DrawnAt       *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// AwaitsDraw tells whether the order of a random queue is not drawn yet, so
+// positions only reflect the order of registration.
+func (q Queue) AwaitsDraw() bool {
+	return q.Mode == ModeRandom && q.DrawnAt == nil
+}
+
+// CallsEnabled tells whether advancing calls participants and waits for them to check in.
+func (q Queue) CallsEnabled() bool {
+	return q.CallTimeout > 0
 }
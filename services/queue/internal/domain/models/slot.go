@@ -0,0 +1,66 @@
+package models
+
+import "time"
+
+// SlotSchedule describes the slots of a queue in slots mode: the time from
+// StartsAt to EndsAt is cut into slots of SlotDuration, each taking up to
+// Capacity participants. Slots that overlap a break are not offered.
+type SlotSchedule struct {
+	QueueID      int64
+	StartsAt     time.Time
+	EndsAt       time.Time
+	SlotDuration time.Duration
+	Capacity     int32
+	Breaks       []SlotBreak
+	UpdatedAt    time.Time
+}
+
+type SlotBreak struct {
+	StartsAt time.Time
+	EndsAt   time.Time
+}
+
+// Slot is a single slot of the schedule; Taken is the number of participants booked in it.
+type Slot struct {
+	StartsAt time.Time
+	EndsAt   time.Time
+	Capacity int32
+	Taken    int32
+}
+
+// Slots lists the schedule's slots in order. A tail shorter than SlotDuration is dropped.
+func (s SlotSchedule) Slots() []Slot {
+	if s.SlotDuration <= 0 {
+		return nil
+	}
+
+	var slots []Slot
+	for start := s.StartsAt; !start.Add(s.SlotDuration).After(s.EndsAt); start = start.Add(s.SlotDuration) {
+		end := start.Add(s.SlotDuration)
+		if s.inBreak(start, end) {
+			continue
+		}
+		slots = append(slots, Slot{StartsAt: start, EndsAt: end, Capacity: s.Capacity})
+	}
+	return slots
+}
+
+// Has tells whether t is the start of one of the schedule's slots.
+func (s SlotSchedule) Has(t time.Time) bool {
+	if s.SlotDuration <= 0 || t.Before(s.StartsAt) || t.Add(s.SlotDuration).After(s.EndsAt) {
+		return false
+	}
+	if t.Sub(s.StartsAt)%s.SlotDuration != 0 {
+		return false
+	}
+	return !s.inBreak(t, t.Add(s.SlotDuration))
+}
+
+func (s SlotSchedule) inBreak(start, end time.Time) bool {
+	for _, b := range s.Breaks {
+		if start.Before(b.EndsAt) && b.StartsAt.Before(end) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,8 @@
+package models
+
+// QueueSnapshot is the state of a queue pushed to watchers after a change.
+type QueueSnapshot struct {
+	Queue        Queue
+	Participants []Participant
+	Deleted      bool
+}
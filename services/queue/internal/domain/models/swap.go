@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+type SwapStatus string
+
+const (
+	SwapPending   SwapStatus = "pending"
+	SwapAccepted  SwapStatus = "accepted"
+	SwapDeclined  SwapStatus = "declined"
+	SwapCancelled SwapStatus = "cancelled"
+	SwapExpired   SwapStatus = "expired"
+)
+
+// SwapRequest is a participant's offer to another one to exchange places.
+type SwapRequest struct {
+	ID         int64
+	QueueID    int64
+	FromUserID int64
+	ToUserID   int64
+	Status     SwapStatus
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	AnsweredAt *time.Time
+}
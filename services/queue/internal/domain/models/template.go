@@ -0,0 +1,60 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// QueueTemplate recreates the same queue on a weekly rule. Each instance opens
+// at an occurrence of Recurrence, is published PublishBefore ahead of it and
+// closes after Duration (zero means it stays open until closed by hand).
+// In slots mode SlotDuration and SlotCapacity cut the open window into slots.
+// In random mode LotteryAfter is when the order is drawn, counted from the
+// opening; zero draws at closing.
+type QueueTemplate struct {
+	ID            int64
+	Title         string
+	Description   string
+	Mode          QueueMode
+	GroupCode     string
+	OwnerID       int64
+	Recurrence    Recurrence
+	PublishBefore time.Duration
+	Duration      time.Duration
+	SlotDuration  time.Duration
+	SlotCapacity  int32
+	LotteryAfter  time.Duration
+	AutoArchive   bool
+	Paused        bool
+	LastQueueID   int64
+	NextRunAt     time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// Recurrence is a weekly rule: every listed weekday at StartTime (offset from
+// midnight) in Timezone, an IANA name.
+type Recurrence struct {
+	Weekdays  []time.Weekday
+	StartTime time.Duration
+	Timezone  string
+}
+
+// Next returns the first occurrence strictly after t.
+func (r Recurrence) Next(t time.Time) (time.Time, error) {
+	loc, err := time.LoadLocation(r.Timezone)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("recurrence: %w", err)
+	}
+
+	local := t.In(loc)
+	hour, minute := int(r.StartTime/time.Hour), int(r.StartTime%time.Hour/time.Minute)
+	for i := 0; i <= 7; i++ {
+		day := time.Date(local.Year(), local.Month(), local.Day()+i, hour, minute, 0, 0, loc)
+		if slices.Contains(r.Weekdays, day.Weekday()) && day.After(t) {
+			return day, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("recurrence: no weekdays")
+}
@@ -0,0 +1,65 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	queuev1 "github.com/s1lentmol/q-flow-backend/protos/gen/go/queue"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Calls interface {
+	SetCallPolicy(ctx context.Context, queueID int64, actorID int64, group string, timeout time.Duration, missShift int32) (models.Queue, error)
+	CheckIn(ctx context.Context, queueID int64, userID int64, group string) (models.Participant, error)
+}
+
+func (s *serverAPI) SetCallPolicy(ctx context.Context, req *queuev1.SetCallPolicyRequest) (*queuev1.SetCallPolicyResponse, error) {
+	input := struct {
+		QueueID     int64  `validate:"required,gt=0" json:"queue_id"`
+		GroupCode   string `json:"group_code"`
+		ActorID     int64  `validate:"required,gt=0" json:"actor_id"`
+		CallTimeout int32  `validate:"gte=0,lte=3600" json:"call_timeout"`
+		MissShift   int32  `validate:"gte=0,lte=50" json:"miss_shift"`
+	}{
+		QueueID:     req.GetQueueId(),
+		GroupCode:   req.GetGroupCode(),
+		ActorID:     req.GetActorId(),
+		CallTimeout: req.GetCallTimeout(),
+		MissShift:   req.GetMissShift(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	timeout := time.Duration(req.GetCallTimeout()) * time.Second
+	q, err := s.queue.SetCallPolicy(ctx, req.GetQueueId(), req.GetActorId(), req.GetGroupCode(), timeout, req.GetMissShift())
+	if err != nil {
+		return nil, mapErr(err, "failed to set call policy")
+	}
+
+	return &queuev1.SetCallPolicyResponse{Queue: toQueueDTO(q)}, nil
+}
+
+func (s *serverAPI) CheckIn(ctx context.Context, req *queuev1.CheckInRequest) (*queuev1.CheckInResponse, error) {
+	input := struct {
+		QueueID   int64  `validate:"required,gt=0" json:"queue_id"`
+		UserID    int64  `validate:"required,gt=0" json:"user_id"`
+		GroupCode string `json:"group_code"`
+	}{
+		QueueID:   req.GetQueueId(),
+		UserID:    req.GetUserId(),
+		GroupCode: req.GetGroupCode(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	p, err := s.queue.CheckIn(ctx, req.GetQueueId(), req.GetUserId(), req.GetGroupCode())
+	if err != nil {
+		return nil, mapErr(err, "failed to check in")
+	}
+
+	return &queuev1.CheckInResponse{Participant: toParticipantDTO(p)}, nil
+}
@@ -0,0 +1,222 @@
+package grpc
+
+import (
+	"context"
+
+	queuev1 "github.com/s1lentmol/q-flow-backend/protos/gen/go/queue"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Groups interface {
+	CreateGroup(ctx context.Context, code, name string, requireApproval bool, ownerID int64, ownerName string) (models.Group, error)
+	ListGroups(ctx context.Context, userID int64) ([]models.UserGroup, error)
+	GetGroup(ctx context.Context, groupID int64, userID int64) (models.UserGroup, error)
+	JoinGroup(ctx context.Context, inviteCode string, userID int64, fullName string) (models.GroupMember, error)
+	ListGroupMembers(ctx context.Context, groupID int64, userID int64, status models.MemberStatus) ([]models.GroupMember, error)
+	ReviewGroupMember(ctx context.Context, groupID int64, userID int64, actorID int64, approve bool) error
+	RemoveGroupMember(ctx context.Context, groupID int64, userID int64, actorID int64) error
+	RegenerateInviteCode(ctx context.Context, groupID int64, actorID int64) (string, error)
+}
+
+func (s *serverAPI) CreateGroup(ctx context.Context, req *queuev1.CreateGroupRequest) (*queuev1.CreateGroupResponse, error) {
+	input := struct {
+		Code    string `validate:"required,max=64" json:"code"`
+		Name    string `validate:"required" json:"name"`
+		OwnerID int64  `validate:"required,gt=0" json:"owner_id"`
+	}{
+		Code:    req.GetCode(),
+		Name:    req.GetName(),
+		OwnerID: req.GetOwnerId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	group, err := s.queue.CreateGroup(ctx, req.GetCode(), req.GetName(), req.GetRequireApproval(), req.GetOwnerId(), req.GetOwnerName())
+	if err != nil {
+		return nil, mapErr(err, "failed to create group")
+	}
+
+	return &queuev1.CreateGroupResponse{Group: toGroupDTO(models.UserGroup{
+		Group:  group,
+		Role:   models.GroupRoleAdmin,
+		Status: models.MemberActive,
+	})}, nil
+}
+
+func (s *serverAPI) ListGroups(ctx context.Context, req *queuev1.ListGroupsRequest) (*queuev1.ListGroupsResponse, error) {
+	input := struct {
+		UserID int64 `validate:"required,gt=0" json:"user_id"`
+	}{
+		UserID: req.GetUserId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	groups, err := s.queue.ListGroups(ctx, req.GetUserId())
+	if err != nil {
+		return nil, mapErr(err, "failed to list groups")
+	}
+
+	resp := &queuev1.ListGroupsResponse{}
+	for _, g := range groups {
+		resp.Groups = append(resp.Groups, toGroupDTO(g))
+	}
+	return resp, nil
+}
+
+func (s *serverAPI) GetGroup(ctx context.Context, req *queuev1.GetGroupRequest) (*queuev1.GetGroupResponse, error) {
+	input := struct {
+		GroupID     int64 `validate:"required,gt=0" json:"group_id"`
+		RequesterID int64 `validate:"required,gt=0" json:"requester_id"`
+	}{
+		GroupID:     req.GetGroupId(),
+		RequesterID: req.GetRequesterId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	group, err := s.queue.GetGroup(ctx, req.GetGroupId(), req.GetRequesterId())
+	if err != nil {
+		return nil, mapErr(err, "failed to get group")
+	}
+
+	return &queuev1.GetGroupResponse{Group: toGroupDTO(group)}, nil
+}
+
+func (s *serverAPI) JoinGroup(ctx context.Context, req *queuev1.JoinGroupRequest) (*queuev1.JoinGroupResponse, error) {
+	input := struct {
+		InviteCode string `validate:"required" json:"invite_code"`
+		UserID     int64  `validate:"required,gt=0" json:"user_id"`
+	}{
+		InviteCode: req.GetInviteCode(),
+		UserID:     req.GetUserId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	member, err := s.queue.JoinGroup(ctx, req.GetInviteCode(), req.GetUserId(), req.GetUserName())
+	if err != nil {
+		return nil, mapErr(err, "failed to join group")
+	}
+
+	return &queuev1.JoinGroupResponse{Member: toGroupMemberDTO(member)}, nil
+}
+
+func (s *serverAPI) ListGroupMembers(ctx context.Context, req *queuev1.ListGroupMembersRequest) (*queuev1.ListGroupMembersResponse, error) {
+	input := struct {
+		GroupID     int64  `validate:"required,gt=0" json:"group_id"`
+		RequesterID int64  `validate:"required,gt=0" json:"requester_id"`
+		Status      string `validate:"omitempty,oneof=pending active" json:"status"`
+	}{
+		GroupID:     req.GetGroupId(),
+		RequesterID: req.GetRequesterId(),
+		Status:      req.GetStatus(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	members, err := s.queue.ListGroupMembers(ctx, req.GetGroupId(), req.GetRequesterId(), models.MemberStatus(req.GetStatus()))
+	if err != nil {
+		return nil, mapErr(err, "failed to list group members")
+	}
+
+	resp := &queuev1.ListGroupMembersResponse{}
+	for _, m := range members {
+		resp.Members = append(resp.Members, toGroupMemberDTO(m))
+	}
+	return resp, nil
+}
+
+func (s *serverAPI) ReviewGroupMember(ctx context.Context, req *queuev1.ReviewGroupMemberRequest) (*queuev1.ReviewGroupMemberResponse, error) {
+	input := struct {
+		GroupID int64 `validate:"required,gt=0" json:"group_id"`
+		UserID  int64 `validate:"required,gt=0" json:"user_id"`
+		ActorID int64 `validate:"required,gt=0" json:"actor_id"`
+	}{
+		GroupID: req.GetGroupId(),
+		UserID:  req.GetUserId(),
+		ActorID: req.GetActorId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	if err := s.queue.ReviewGroupMember(ctx, req.GetGroupId(), req.GetUserId(), req.GetActorId(), req.GetApprove()); err != nil {
+		return nil, mapErr(err, "failed to review group member")
+	}
+
+	return &queuev1.ReviewGroupMemberResponse{}, nil
+}
+
+func (s *serverAPI) RemoveGroupMember(ctx context.Context, req *queuev1.RemoveGroupMemberRequest) (*queuev1.RemoveGroupMemberResponse, error) {
+	input := struct {
+		GroupID int64 `validate:"required,gt=0" json:"group_id"`
+		UserID  int64 `validate:"required,gt=0" json:"user_id"`
+		ActorID int64 `validate:"required,gt=0" json:"actor_id"`
+	}{
+		GroupID: req.GetGroupId(),
+		UserID:  req.GetUserId(),
+		ActorID: req.GetActorId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	if err := s.queue.RemoveGroupMember(ctx, req.GetGroupId(), req.GetUserId(), req.GetActorId()); err != nil {
+		return nil, mapErr(err, "failed to remove group member")
+	}
+
+	return &queuev1.RemoveGroupMemberResponse{}, nil
+}
+
+func (s *serverAPI) RegenerateInviteCode(ctx context.Context, req *queuev1.RegenerateInviteCodeRequest) (*queuev1.RegenerateInviteCodeResponse, error) {
+	input := struct {
+		GroupID int64 `validate:"required,gt=0" json:"group_id"`
+		ActorID int64 `validate:"required,gt=0" json:"actor_id"`
+	}{
+		GroupID: req.GetGroupId(),
+		ActorID: req.GetActorId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	invite, err := s.queue.RegenerateInviteCode(ctx, req.GetGroupId(), req.GetActorId())
+	if err != nil {
+		return nil, mapErr(err, "failed to regenerate invite code")
+	}
+
+	return &queuev1.RegenerateInviteCodeResponse{InviteCode: invite}, nil
+}
+
+func toGroupDTO(g models.UserGroup) *queuev1.GroupDTO {
+	return &queuev1.GroupDTO{
+		Id:              g.ID,
+		Code:            g.Code,
+		Name:            g.Name,
+		OwnerId:         g.OwnerID,
+		InviteCode:      g.InviteCode,
+		RequireApproval: g.RequireApproval,
+		CreatedAt:       g.CreatedAt.Unix(),
+		Role:            string(g.Role),
+		Status:          string(g.Status),
+	}
+}
+
+func toGroupMemberDTO(m models.GroupMember) *queuev1.GroupMemberDTO {
+	return &queuev1.GroupMemberDTO{
+		GroupId:   m.GroupID,
+		UserId:    m.UserID,
+		FullName:  m.FullName,
+		Role:      string(m.Role),
+		Status:    string(m.Status),
+		CreatedAt: m.CreatedAt.Unix(),
+	}
+}
@@ -0,0 +1,62 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	queuev1 "github.com/s1lentmol/q-flow-backend/protos/gen/go/queue"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Lottery interface {
+	SetLottery(ctx context.Context, queueID int64, actorID int64, group string, lotteryAt *time.Time) (models.Queue, error)
+	DrawLottery(ctx context.Context, queueID int64, actorID int64, group string) (models.Queue, []models.Participant, error)
+}
+
+func (s *serverAPI) SetLottery(ctx context.Context, req *queuev1.SetLotteryRequest) (*queuev1.SetLotteryResponse, error) {
+	input := struct {
+		QueueID   int64  `validate:"required,gt=0" json:"queue_id"`
+		GroupCode string `json:"group_code"`
+		ActorID   int64  `validate:"required,gt=0" json:"actor_id"`
+		LotteryAt int64  `validate:"gte=0" json:"lottery_at"`
+	}{
+		QueueID:   req.GetQueueId(),
+		GroupCode: req.GetGroupCode(),
+		ActorID:   req.GetActorId(),
+		LotteryAt: req.GetLotteryAt(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	q, err := s.queue.SetLottery(ctx, req.GetQueueId(), req.GetActorId(), req.GetGroupCode(), fromUnix(req.GetLotteryAt()))
+	if err != nil {
+		return nil, mapErr(err, "failed to set lottery")
+	}
+
+	return &queuev1.SetLotteryResponse{Queue: toQueueDTO(q)}, nil
+}
+
+func (s *serverAPI) DrawLottery(ctx context.Context, req *queuev1.DrawLotteryRequest) (*queuev1.DrawLotteryResponse, error) {
+	input := struct {
+		QueueID   int64  `validate:"required,gt=0" json:"queue_id"`
+		GroupCode string `json:"group_code"`
+		ActorID   int64  `validate:"required,gt=0" json:"actor_id"`
+	}{
+		QueueID:   req.GetQueueId(),
+		GroupCode: req.GetGroupCode(),
+		ActorID:   req.GetActorId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	q, order, err := s.queue.DrawLottery(ctx, req.GetQueueId(), req.GetActorId(), req.GetGroupCode())
+	if err != nil {
+		return nil, mapErr(err, "failed to draw lottery")
+	}
+
+	return &queuev1.DrawLotteryResponse{Queue: toQueueDTO(q), Participants: toParticipantDTOs(order)}, nil
+}
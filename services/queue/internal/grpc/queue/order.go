@@ -0,0 +1,125 @@
+package grpc
+
+import (
+	"context"
+
+	queuev1 "github.com/s1lentmol/q-flow-backend/protos/gen/go/queue"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Order interface {
+	MoveParticipant(ctx context.Context, queueID int64, userID int64, position int32, actorID int64, group string) ([]models.Participant, error)
+	SwapParticipants(ctx context.Context, queueID int64, firstUserID, secondUserID int64, actorID int64, group string) ([]models.Participant, error)
+	ReorderQueue(ctx context.Context, queueID int64, userIDs []int64, actorID int64, group string) ([]models.Participant, error)
+	DeferTurn(ctx context.Context, queueID int64, userID int64, places int32, group string) (models.Participant, error)
+}
+
+func (s *serverAPI) MoveParticipant(ctx context.Context, req *queuev1.MoveParticipantRequest) (*queuev1.MoveParticipantResponse, error) {
+	input := struct {
+		QueueID   int64  `validate:"required,gt=0" json:"queue_id"`
+		GroupCode string `json:"group_code"`
+		ActorID   int64  `validate:"required,gt=0" json:"actor_id"`
+		UserID    int64  `validate:"required,gt=0" json:"user_id"`
+		Position  int32  `validate:"required,gt=0" json:"position"`
+	}{
+		QueueID:   req.GetQueueId(),
+		GroupCode: req.GetGroupCode(),
+		ActorID:   req.GetActorId(),
+		UserID:    req.GetUserId(),
+		Position:  req.GetPosition(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	list, err := s.queue.MoveParticipant(ctx, req.GetQueueId(), req.GetUserId(), req.GetPosition(), req.GetActorId(), req.GetGroupCode())
+	if err != nil {
+		return nil, mapErr(err, "failed to move participant")
+	}
+
+	return &queuev1.MoveParticipantResponse{Participants: toParticipantDTOs(list)}, nil
+}
+
+func (s *serverAPI) SwapParticipants(ctx context.Context, req *queuev1.SwapParticipantsRequest) (*queuev1.SwapParticipantsResponse, error) {
+	input := struct {
+		QueueID      int64  `validate:"required,gt=0" json:"queue_id"`
+		GroupCode    string `json:"group_code"`
+		ActorID      int64  `validate:"required,gt=0" json:"actor_id"`
+		FirstUserID  int64  `validate:"required,gt=0" json:"first_user_id"`
+		SecondUserID int64  `validate:"required,gt=0,nefield=FirstUserID" json:"second_user_id"`
+	}{
+		QueueID:      req.GetQueueId(),
+		GroupCode:    req.GetGroupCode(),
+		ActorID:      req.GetActorId(),
+		FirstUserID:  req.GetFirstUserId(),
+		SecondUserID: req.GetSecondUserId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	list, err := s.queue.SwapParticipants(ctx, req.GetQueueId(), req.GetFirstUserId(), req.GetSecondUserId(), req.GetActorId(), req.GetGroupCode())
+	if err != nil {
+		return nil, mapErr(err, "failed to swap participants")
+	}
+
+	return &queuev1.SwapParticipantsResponse{Participants: toParticipantDTOs(list)}, nil
+}
+
+func (s *serverAPI) ReorderQueue(ctx context.Context, req *queuev1.ReorderQueueRequest) (*queuev1.ReorderQueueResponse, error) {
+	input := struct {
+		QueueID   int64   `validate:"required,gt=0" json:"queue_id"`
+		GroupCode string  `json:"group_code"`
+		ActorID   int64   `validate:"required,gt=0" json:"actor_id"`
+		UserIDs   []int64 `validate:"required,unique,dive,gt=0" json:"user_ids"`
+	}{
+		QueueID:   req.GetQueueId(),
+		GroupCode: req.GetGroupCode(),
+		ActorID:   req.GetActorId(),
+		UserIDs:   req.GetUserIds(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	list, err := s.queue.ReorderQueue(ctx, req.GetQueueId(), req.GetUserIds(), req.GetActorId(), req.GetGroupCode())
+	if err != nil {
+		return nil, mapErr(err, "failed to reorder queue")
+	}
+
+	return &queuev1.ReorderQueueResponse{Participants: toParticipantDTOs(list)}, nil
+}
+
+func (s *serverAPI) DeferTurn(ctx context.Context, req *queuev1.DeferTurnRequest) (*queuev1.DeferTurnResponse, error) {
+	input := struct {
+		QueueID   int64  `validate:"required,gt=0" json:"queue_id"`
+		GroupCode string `json:"group_code"`
+		UserID    int64  `validate:"required,gt=0" json:"user_id"`
+		Places    int32  `validate:"required,gte=1,lte=10" json:"places"`
+	}{
+		QueueID:   req.GetQueueId(),
+		GroupCode: req.GetGroupCode(),
+		UserID:    req.GetUserId(),
+		Places:    req.GetPlaces(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	p, err := s.queue.DeferTurn(ctx, req.GetQueueId(), req.GetUserId(), req.GetPlaces(), req.GetGroupCode())
+	if err != nil {
+		return nil, mapErr(err, "failed to defer turn")
+	}
+
+	return &queuev1.DeferTurnResponse{Participant: toParticipantDTO(p)}, nil
+}
+
+func toParticipantDTOs(list []models.Participant) []*queuev1.ParticipantDTO {
+	dtos := make([]*queuev1.ParticipantDTO, 0, len(list))
+	for _, p := range list {
+		dtos = append(dtos, toParticipantDTO(p))
+	}
+	return dtos
+}
@@ -0,0 +1,40 @@
+package grpc
+
+import (
+	"context"
+
+	queuev1 "github.com/s1lentmol/q-flow-backend/protos/gen/go/queue"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Priority interface {
+	SetPriority(ctx context.Context, queueID int64, userID int64, priority int32, actorID int64, group string) ([]models.Participant, error)
+}
+
+func (s *serverAPI) SetParticipantPriority(ctx context.Context, req *queuev1.SetParticipantPriorityRequest) (*queuev1.SetParticipantPriorityResponse, error) {
+	input := struct {
+		QueueID   int64  `validate:"required,gt=0" json:"queue_id"`
+		GroupCode string `json:"group_code"`
+		ActorID   int64  `validate:"required,gt=0" json:"actor_id"`
+		UserID    int64  `validate:"required,gt=0" json:"user_id"`
+		Priority  int32  `validate:"gte=-10,lte=10" json:"priority"`
+	}{
+		QueueID:   req.GetQueueId(),
+		GroupCode: req.GetGroupCode(),
+		ActorID:   req.GetActorId(),
+		UserID:    req.GetUserId(),
+		Priority:  req.GetPriority(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	list, err := s.queue.SetPriority(ctx, req.GetQueueId(), req.GetUserId(), req.GetPriority(), req.GetActorId(), req.GetGroupCode())
+	if err != nil {
+		return nil, mapErr(err, "failed to set participant priority")
+	}
+
+	return &queuev1.SetParticipantPriorityResponse{Participants: toParticipantDTOs(list)}, nil
+}
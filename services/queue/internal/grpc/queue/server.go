@@ -19,17 +19,31 @@ import (
 )
 
 type Queue interface {
-	ListQueues(ctx context.Context, group string) ([]models.Queue, error)
-	CreateQueue(ctx context.Context, title, description, group string, mode models.QueueMode, ownerID int64) (models.Queue, error)
-	GetQueue(ctx context.Context, queueID int64, group string) (models.Queue, []models.Participant, error)
+	ListQueues(ctx context.Context, group string, userID int64) ([]models.Queue, error)
+	CreateQueue(ctx context.Context, title, description, group string, mode models.QueueMode, ownerID int64, opensAt, closesAt, lotteryAt *time.Time, notifyTop int32) (models.Queue, error)
+	GetQueue(ctx context.Context, queueID int64, userID int64, group string) (models.Queue, []models.Participant, error)
 	JoinQueue(ctx context.Context, queueID, userID int64, fullName string, group string, slotTime string) (int32, error)
 	LeaveQueue(ctx context.Context, queueID, userID int64, group string) error
-	AdvanceQueue(ctx context.Context, queueID int64, actorID int64, group string) (models.Participant, error)
+	AdvanceQueue(ctx context.Context, queueID int64, actorID int64, group string, skip bool) (models.Participant, models.Participant, error)
 	RemoveParticipant(ctx context.Context, queueID int64, userID int64, actorID int64, group string) error
 	ArchiveQueue(ctx context.Context, queueID int64, actorID int64, group string) error
 	DeleteQueue(ctx context.Context, queueID int64, actorID int64, group string) error
-	UpdateQueue(ctx context.Context, queueID int64, actorID int64, group string, title string, description string) (models.Queue, error)
+	UpdateQueue(ctx context.Context, queueID int64, actorID int64, group string, title string, description string, notifyTop int32) (models.Queue, error)
 	AddParticipant(ctx context.Context, queueID int64, userID int64, fullName string, actorID int64, group string, slotTime string) (int32, error)
+	WatchQueue(ctx context.Context, queueID int64, userID int64, group string) (<-chan models.QueueSnapshot, func(), error)
+	ListQueueEvents(ctx context.Context, queueID int64, userID int64, group string, filter models.EventFilter) ([]models.QueueEvent, int64, error)
+	GetQueueHistory(ctx context.Context, queueID int64, userID int64, group string, filter models.HistoryFilter) ([]models.HistoryEntry, int64, error)
+	ListMembers(ctx context.Context, queueID int64, userID int64, group string) ([]models.QueueMember, error)
+	GrantRole(ctx context.Context, queueID int64, userID int64, role models.Role, actorID int64, group string) (models.QueueMember, error)
+	RevokeRole(ctx context.Context, queueID int64, userID int64, actorID int64, group string) error
+	Groups
+	Slots
+	Templates
+	Calls
+	Order
+	Swaps
+	Lottery
+	Priority
 }
 
 type serverAPI struct {
@@ -43,17 +57,19 @@ func Register(gRPC *grpc.Server, q Queue) {
 
 func (s *serverAPI) ListQueues(ctx context.Context, req *queuev1.ListQueuesRequest) (*queuev1.ListQueuesResponse, error) {
 	input := struct {
-		GroupCode string `validate:"required" json:"group_code"`
+		GroupCode   string `json:"group_code"`
+		RequesterID int64  `validate:"required,gt=0" json:"requester_id"`
 	}{
-		GroupCode: req.GetGroupCode(),
+		GroupCode:   req.GetGroupCode(),
+		RequesterID: req.GetRequesterId(),
 	}
 	if err := validate.Struct(input); err != nil {
 		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
 	}
 
-	queues, err := s.queue.ListQueues(ctx, req.GetGroupCode())
+	queues, err := s.queue.ListQueues(ctx, req.GetGroupCode(), req.GetRequesterId())
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to list queues")
+		return nil, mapErr(err, "failed to list queues")
 	}
 
 	resp := &queuev1.ListQueuesResponse{}
@@ -70,20 +86,29 @@ func (s *serverAPI) CreateQueue(ctx context.Context, req *queuev1.CreateQueueReq
 		Mode        queuev1.QueueMode `validate:"required,gt=0" json:"mode"`
 		GroupCode   string            `validate:"required" json:"group_code"`
 		OwnerID     int64             `validate:"required,gt=0" json:"owner_id"`
+		OpensAt     int64             `validate:"gte=0" json:"opens_at"`
+		ClosesAt    int64             `validate:"gte=0" json:"closes_at"`
+		NotifyTop   int32             `validate:"gte=0,lte=50" json:"notify_top"`
+		LotteryAt   int64             `validate:"gte=0" json:"lottery_at"`
 	}{
 		Title:       req.GetTitle(),
 		Description: req.GetDescription(),
 		Mode:        req.GetMode(),
 		GroupCode:   req.GetGroupCode(),
 		OwnerID:     req.GetOwnerId(),
+		OpensAt:     req.GetOpensAt(),
+		ClosesAt:    req.GetClosesAt(),
+		NotifyTop:   req.GetNotifyTop(),
+		LotteryAt:   req.GetLotteryAt(),
 	}
 	if err := validate.Struct(input); err != nil {
 		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
 	}
 
-	queueModel, err := s.queue.CreateQueue(ctx, req.GetTitle(), req.GetDescription(), req.GetGroupCode(), toMode(req.GetMode()), req.GetOwnerId())
+	queueModel, err := s.queue.CreateQueue(ctx, req.GetTitle(), req.GetDescription(), req.GetGroupCode(), toMode(req.GetMode()), req.GetOwnerId(),
+		fromUnix(req.GetOpensAt()), fromUnix(req.GetClosesAt()), fromUnix(req.GetLotteryAt()), req.GetNotifyTop())
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to create queue")
+		return nil, mapErr(err, "failed to create queue")
 	}
 
 	return &queuev1.CreateQueueResponse{Queue: toQueueDTO(queueModel)}, nil
@@ -91,17 +116,19 @@ func (s *serverAPI) CreateQueue(ctx context.Context, req *queuev1.CreateQueueReq
 
 func (s *serverAPI) GetQueue(ctx context.Context, req *queuev1.GetQueueRequest) (*queuev1.GetQueueResponse, error) {
 	input := struct {
-		QueueID   int64  `validate:"required,gt=0" json:"queue_id"`
-		GroupCode string `validate:"required" json:"group_code"`
+		QueueID     int64  `validate:"required,gt=0" json:"queue_id"`
+		GroupCode   string `json:"group_code"`
+		RequesterID int64  `validate:"required,gt=0" json:"requester_id"`
 	}{
-		QueueID:   req.GetQueueId(),
-		GroupCode: req.GetGroupCode(),
+		QueueID:     req.GetQueueId(),
+		GroupCode:   req.GetGroupCode(),
+		RequesterID: req.GetRequesterId(),
 	}
 	if err := validate.Struct(input); err != nil {
 		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
 	}
 
-	q, participants, err := s.queue.GetQueue(ctx, req.GetQueueId(), req.GetGroupCode())
+	q, participants, err := s.queue.GetQueue(ctx, req.GetQueueId(), req.GetRequesterId(), req.GetGroupCode())
 	if err != nil {
 		return nil, mapErr(err, "failed to get queue")
 	}
@@ -117,7 +144,7 @@ func (s *serverAPI) JoinQueue(ctx context.Context, req *queuev1.JoinQueueRequest
 	input := struct {
 		QueueID   int64  `validate:"required,gt=0" json:"queue_id"`
 		UserID    int64  `validate:"required,gt=0" json:"user_id"`
-		GroupCode string `validate:"required" json:"group_code"`
+		GroupCode string `json:"group_code"`
 		UserName  string `validate:"required" json:"user_name"`
 	}{
 		QueueID:   req.GetQueueId(),
@@ -141,7 +168,7 @@ func (s *serverAPI) LeaveQueue(ctx context.Context, req *queuev1.LeaveQueueReque
 	input := struct {
 		QueueID   int64  `validate:"required,gt=0" json:"queue_id"`
 		UserID    int64  `validate:"required,gt=0" json:"user_id"`
-		GroupCode string `validate:"required" json:"group_code"`
+		GroupCode string `json:"group_code"`
 	}{
 		QueueID:   req.GetQueueId(),
 		UserID:    req.GetUserId(),
@@ -161,7 +188,7 @@ func (s *serverAPI) LeaveQueue(ctx context.Context, req *queuev1.LeaveQueueReque
 func (s *serverAPI) AdvanceQueue(ctx context.Context, req *queuev1.AdvanceQueueRequest) (*queuev1.AdvanceQueueResponse, error) {
 	input := struct {
 		QueueID   int64  `validate:"required,gt=0" json:"queue_id"`
-		GroupCode string `validate:"required" json:"group_code"`
+		GroupCode string `json:"group_code"`
 		ActorID   int64  `validate:"required,gt=0" json:"actor_id"`
 	}{
 		QueueID:   req.GetQueueId(),
@@ -172,12 +199,19 @@ func (s *serverAPI) AdvanceQueue(ctx context.Context, req *queuev1.AdvanceQueueR
 		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
 	}
 
-	removed, err := s.queue.AdvanceQueue(ctx, req.GetQueueId(), req.GetActorId(), req.GetGroupCode())
+	removed, called, err := s.queue.AdvanceQueue(ctx, req.GetQueueId(), req.GetActorId(), req.GetGroupCode(), req.GetSkip())
 	if err != nil {
 		return nil, mapErr(err, "failed to advance queue")
 	}
 
-	return &queuev1.AdvanceQueueResponse{Removed: toParticipantDTO(removed)}, nil
+	resp := &queuev1.AdvanceQueueResponse{}
+	if removed.ID != 0 {
+		resp.Removed = toParticipantDTO(removed)
+	}
+	if called.ID != 0 {
+		resp.Called = toParticipantDTO(called)
+	}
+	return resp, nil
 }
 
 func (s *serverAPI) RemoveParticipant(ctx context.Context, req *queuev1.RemoveParticipantRequest) (*queuev1.RemoveParticipantResponse, error) {
@@ -185,7 +219,7 @@ func (s *serverAPI) RemoveParticipant(ctx context.Context, req *queuev1.RemovePa
 		QueueID   int64  `validate:"required,gt=0" json:"queue_id"`
 		UserID    int64  `validate:"required,gt=0" json:"user_id"`
 		ActorID   int64  `validate:"required,gt=0" json:"actor_id"`
-		GroupCode string `validate:"required" json:"group_code"`
+		GroupCode string `json:"group_code"`
 	}{
 		QueueID:   req.GetQueueId(),
 		UserID:    req.GetUserId(),
@@ -206,7 +240,7 @@ func (s *serverAPI) RemoveParticipant(ctx context.Context, req *queuev1.RemovePa
 func (s *serverAPI) ArchiveQueue(ctx context.Context, req *queuev1.ArchiveQueueRequest) (*queuev1.ArchiveQueueResponse, error) {
 	input := struct {
 		QueueID   int64  `validate:"required,gt=0" json:"queue_id"`
-		GroupCode string `validate:"required" json:"group_code"`
+		GroupCode string `json:"group_code"`
 		ActorID   int64  `validate:"required,gt=0" json:"actor_id"`
 	}{
 		QueueID:   req.GetQueueId(),
@@ -227,7 +261,7 @@ func (s *serverAPI) ArchiveQueue(ctx context.Context, req *queuev1.ArchiveQueueR
 func (s *serverAPI) DeleteQueue(ctx context.Context, req *queuev1.DeleteQueueRequest) (*queuev1.DeleteQueueResponse, error) {
 	input := struct {
 		QueueID   int64  `validate:"required,gt=0" json:"queue_id"`
-		GroupCode string `validate:"required" json:"group_code"`
+		GroupCode string `json:"group_code"`
 		ActorID   int64  `validate:"required,gt=0" json:"actor_id"`
 	}{
 		QueueID:   req.GetQueueId(),
@@ -248,18 +282,20 @@ func (s *serverAPI) DeleteQueue(ctx context.Context, req *queuev1.DeleteQueueReq
 func (s *serverAPI) UpdateQueue(ctx context.Context, req *queuev1.UpdateQueueRequest) (*queuev1.UpdateQueueResponse, error) {
 	input := struct {
 		QueueID   int64  `validate:"required,gt=0" json:"queue_id"`
-		GroupCode string `validate:"required" json:"group_code"`
+		GroupCode string `json:"group_code"`
 		ActorID   int64  `validate:"required,gt=0" json:"actor_id"`
+		NotifyTop int32  `validate:"gte=0,lte=50" json:"notify_top"`
 	}{
 		QueueID:   req.GetQueueId(),
 		GroupCode: req.GetGroupCode(),
 		ActorID:   req.GetActorId(),
+		NotifyTop: req.GetNotifyTop(),
 	}
 	if err := validate.Struct(input); err != nil {
 		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
 	}
 
-	q, err := s.queue.UpdateQueue(ctx, req.GetQueueId(), req.GetActorId(), req.GetGroupCode(), req.GetTitle(), req.GetDescription())
+	q, err := s.queue.UpdateQueue(ctx, req.GetQueueId(), req.GetActorId(), req.GetGroupCode(), req.GetTitle(), req.GetDescription(), req.GetNotifyTop())
 	if err != nil {
 		return nil, mapErr(err, "failed to update queue")
 	}
@@ -272,7 +308,7 @@ func (s *serverAPI) AddParticipant(ctx context.Context, req *queuev1.AddParticip
 		QueueID   int64  `validate:"required,gt=0" json:"queue_id"`
 		UserID    int64  `validate:"required,gt=0" json:"user_id"`
 		ActorID   int64  `validate:"required,gt=0" json:"actor_id"`
-		GroupCode string `validate:"required" json:"group_code"`
+		GroupCode string `json:"group_code"`
 		UserName  string `json:"user_name"`
 	}{
 		QueueID:   req.GetQueueId(),
@@ -293,6 +329,130 @@ func (s *serverAPI) AddParticipant(ctx context.Context, req *queuev1.AddParticip
 	return &queuev1.AddParticipantResponse{Position: position}, nil
 }
 
+func (s *serverAPI) WatchQueue(req *queuev1.WatchQueueRequest, stream queuev1.Queue_WatchQueueServer) error {
+	input := struct {
+		QueueID     int64  `validate:"required,gt=0" json:"queue_id"`
+		GroupCode   string `json:"group_code"`
+		RequesterID int64  `validate:"required,gt=0" json:"requester_id"`
+	}{
+		QueueID:     req.GetQueueId(),
+		GroupCode:   req.GetGroupCode(),
+		RequesterID: req.GetRequesterId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	ctx := stream.Context()
+	updates, cancel, err := s.queue.WatchQueue(ctx, req.GetQueueId(), req.GetRequesterId(), req.GetGroupCode())
+	if err != nil {
+		return mapErr(err, "failed to watch queue")
+	}
+	defer cancel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case snap := <-updates:
+			if err := stream.Send(toQueueUpdate(snap)); err != nil {
+				return err
+			}
+			if snap.Deleted {
+				return nil
+			}
+		}
+	}
+}
+
+func (s *serverAPI) ListQueueEvents(ctx context.Context, req *queuev1.ListQueueEventsRequest) (*queuev1.ListQueueEventsResponse, error) {
+	input := struct {
+		QueueID     int64  `validate:"required,gt=0" json:"queue_id"`
+		GroupCode   string `json:"group_code"`
+		RequesterID int64  `validate:"required,gt=0" json:"requester_id"`
+		ActorID     int64  `validate:"gte=0" json:"actor_id"`
+		Since       int64  `validate:"gte=0" json:"since"`
+		Until       int64  `validate:"gte=0" json:"until"`
+		BeforeID    int64  `validate:"gte=0" json:"before_id"`
+		Limit       int32  `validate:"gte=0" json:"limit"`
+	}{
+		QueueID:     req.GetQueueId(),
+		GroupCode:   req.GetGroupCode(),
+		RequesterID: req.GetRequesterId(),
+		ActorID:     req.GetActorId(),
+		Since:       req.GetSince(),
+		Until:       req.GetUntil(),
+		BeforeID:    req.GetBeforeId(),
+		Limit:       req.GetLimit(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	filter := models.EventFilter{
+		ActorID:  req.GetActorId(),
+		Type:     models.EventType(req.GetType()),
+		BeforeID: req.GetBeforeId(),
+		Limit:    int(req.GetLimit()),
+	}
+	if req.GetSince() > 0 {
+		filter.Since = time.Unix(req.GetSince(), 0)
+	}
+	if req.GetUntil() > 0 {
+		filter.Until = time.Unix(req.GetUntil(), 0)
+	}
+
+	events, next, err := s.queue.ListQueueEvents(ctx, req.GetQueueId(), req.GetRequesterId(), req.GetGroupCode(), filter)
+	if err != nil {
+		return nil, mapErr(err, "failed to list queue events")
+	}
+
+	resp := &queuev1.ListQueueEventsResponse{NextBeforeId: next}
+	for _, ev := range events {
+		resp.Events = append(resp.Events, toEventDTO(ev))
+	}
+	return resp, nil
+}
+
+func (s *serverAPI) GetQueueHistory(ctx context.Context, req *queuev1.GetQueueHistoryRequest) (*queuev1.GetQueueHistoryResponse, error) {
+	input := struct {
+		QueueID     int64  `validate:"required,gt=0" json:"queue_id"`
+		GroupCode   string `json:"group_code"`
+		RequesterID int64  `validate:"required,gt=0" json:"requester_id"`
+		UserID      int64  `validate:"gte=0" json:"user_id"`
+		Outcome     string `validate:"omitempty,oneof=served skipped left removed" json:"outcome"`
+		BeforeID    int64  `validate:"gte=0" json:"before_id"`
+		Limit       int32  `validate:"gte=0" json:"limit"`
+	}{
+		QueueID:     req.GetQueueId(),
+		GroupCode:   req.GetGroupCode(),
+		RequesterID: req.GetRequesterId(),
+		UserID:      req.GetUserId(),
+		Outcome:     req.GetOutcome(),
+		BeforeID:    req.GetBeforeId(),
+		Limit:       req.GetLimit(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	entries, next, err := s.queue.GetQueueHistory(ctx, req.GetQueueId(), req.GetRequesterId(), req.GetGroupCode(), models.HistoryFilter{
+		UserID:   req.GetUserId(),
+		Outcome:  models.Outcome(req.GetOutcome()),
+		BeforeID: req.GetBeforeId(),
+		Limit:    int(req.GetLimit()),
+	})
+	if err != nil {
+		return nil, mapErr(err, "failed to get queue history")
+	}
+
+	resp := &queuev1.GetQueueHistoryResponse{NextBeforeId: next}
+	for _, h := range entries {
+		resp.Entries = append(resp.Entries, toHistoryDTO(h))
+	}
+	return resp, nil
+}
+
 var validate = func() *validator.Validate {
 	v := validator.New(validator.WithRequiredStructEnabled())
 	v.RegisterTagNameFunc(func(fld reflect.StructField) string {
@@ -318,30 +478,192 @@ func formatValidationError(err error) string {
 }
 
 func toQueueDTO(q models.Queue) *queuev1.QueueDTO {
+	// The seed stays secret until the draw, otherwise anyone could compute
+	// the order and pick when to join.
+	seed := q.LotterySeed
+	if q.DrawnAt == nil {
+		seed = ""
+	}
 	return &queuev1.QueueDTO{
-		Id:          q.ID,
-		Title:       q.Title,
-		Description: q.Description,
-		Mode:        toProtoMode(q.Mode),
-		Status:      toProtoStatus(q.Status),
-		GroupCode:   q.GroupCode,
-		OwnerId:     q.OwnerID,
-		CreatedAt:   q.CreatedAt.Unix(),
-		UpdatedAt:   q.UpdatedAt.Unix(),
+		Id:            q.ID,
+		Title:         q.Title,
+		Description:   q.Description,
+		Mode:          toProtoMode(q.Mode),
+		Status:        toProtoStatus(q.Status),
+		GroupCode:     q.GroupCode,
+		OwnerId:       q.OwnerID,
+		CreatedAt:     q.CreatedAt.Unix(),
+		UpdatedAt:     q.UpdatedAt.Unix(),
+		OpensAt:       toUnix(q.OpensAt),
+		ClosesAt:      toUnix(q.ClosesAt),
+		NotifyTop:     q.NotifyTop,
+		CallTimeout:   int32(q.CallTimeout / time.Second),
+		CallMissShift: q.CallMissShift,
+		SwapsAllowed:  q.SwapsAllowed,
+		LotteryAt:     toUnix(q.LotteryAt),
+		LotterySeed:   seed,
+		LotteryCommit: q.LotteryCommit,
+		DrawnAt:       toUnix(q.DrawnAt),
 	}
 }
 
+// fromUnix treats zero as "not set".
+func fromUnix(sec int64) *time.Time {
+	if sec == 0 {
+		return nil
+	}
+	t := time.Unix(sec, 0)
+	return &t
+}
+
+func toUnix(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.Unix()
+}
+
 func toParticipantDTO(p models.Participant) *queuev1.ParticipantDTO {
 	dto := &queuev1.ParticipantDTO{
-		Id:        p.ID,
-		QueueId:   p.QueueID,
-		UserId:    p.UserID,
-		Position:  p.Position,
-		FullName:  p.FullName,
-		CreatedAt: p.CreatedAt.Unix(),
+		Id:          p.ID,
+		QueueId:     p.QueueID,
+		UserId:      p.UserID,
+		Position:    p.Position,
+		FullName:    p.FullName,
+		CreatedAt:   p.CreatedAt.Unix(),
+		CalledAt:    toUnix(p.CalledAt),
+		CheckedInAt: toUnix(p.CheckedInAt),
+		Deferrals:   p.Deferrals,
+		Priority:    p.Priority,
 	}
 	if p.SlotTime != nil {
-		dto.SlotTime = p.SlotTime.UTC().Format(time.RFC3339)
+		dto.SlotTime = formatSlotTime(*p.SlotTime)
+	}
+	return dto
+}
+
+func toQueueUpdate(snap models.QueueSnapshot) *queuev1.QueueUpdate {
+	upd := &queuev1.QueueUpdate{
+		Queue:   toQueueDTO(snap.Queue),
+		Deleted: snap.Deleted,
+	}
+	for _, p := range snap.Participants {
+		upd.Participants = append(upd.Participants, toParticipantDTO(p))
+	}
+	return upd
+}
+
+func (s *serverAPI) ListMembers(ctx context.Context, req *queuev1.ListMembersRequest) (*queuev1.ListMembersResponse, error) {
+	input := struct {
+		QueueID     int64  `validate:"required,gt=0" json:"queue_id"`
+		GroupCode   string `json:"group_code"`
+		RequesterID int64  `validate:"required,gt=0" json:"requester_id"`
+	}{
+		QueueID:     req.GetQueueId(),
+		GroupCode:   req.GetGroupCode(),
+		RequesterID: req.GetRequesterId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	members, err := s.queue.ListMembers(ctx, req.GetQueueId(), req.GetRequesterId(), req.GetGroupCode())
+	if err != nil {
+		return nil, mapErr(err, "failed to list members")
+	}
+
+	resp := &queuev1.ListMembersResponse{}
+	for _, m := range members {
+		resp.Members = append(resp.Members, toMemberDTO(m))
+	}
+	return resp, nil
+}
+
+func (s *serverAPI) GrantRole(ctx context.Context, req *queuev1.GrantRoleRequest) (*queuev1.GrantRoleResponse, error) {
+	input := struct {
+		QueueID   int64  `validate:"required,gt=0" json:"queue_id"`
+		GroupCode string `json:"group_code"`
+		UserID    int64  `validate:"required,gt=0" json:"user_id"`
+		Role      string `validate:"required,oneof=owner moderator viewer" json:"role"`
+		ActorID   int64  `validate:"required,gt=0" json:"actor_id"`
+	}{
+		QueueID:   req.GetQueueId(),
+		GroupCode: req.GetGroupCode(),
+		UserID:    req.GetUserId(),
+		Role:      req.GetRole(),
+		ActorID:   req.GetActorId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	member, err := s.queue.GrantRole(ctx, req.GetQueueId(), req.GetUserId(), models.Role(req.GetRole()), req.GetActorId(), req.GetGroupCode())
+	if err != nil {
+		return nil, mapErr(err, "failed to grant role")
+	}
+
+	return &queuev1.GrantRoleResponse{Member: toMemberDTO(member)}, nil
+}
+
+func (s *serverAPI) RevokeRole(ctx context.Context, req *queuev1.RevokeRoleRequest) (*queuev1.RevokeRoleResponse, error) {
+	input := struct {
+		QueueID   int64  `validate:"required,gt=0" json:"queue_id"`
+		GroupCode string `json:"group_code"`
+		UserID    int64  `validate:"required,gt=0" json:"user_id"`
+		ActorID   int64  `validate:"required,gt=0" json:"actor_id"`
+	}{
+		QueueID:   req.GetQueueId(),
+		GroupCode: req.GetGroupCode(),
+		UserID:    req.GetUserId(),
+		ActorID:   req.GetActorId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	if err := s.queue.RevokeRole(ctx, req.GetQueueId(), req.GetUserId(), req.GetActorId(), req.GetGroupCode()); err != nil {
+		return nil, mapErr(err, "failed to revoke role")
+	}
+
+	return &queuev1.RevokeRoleResponse{}, nil
+}
+
+func toMemberDTO(m models.QueueMember) *queuev1.QueueMemberDTO {
+	return &queuev1.QueueMemberDTO{
+		QueueId:   m.QueueID,
+		UserId:    m.UserID,
+		Role:      string(m.Role),
+		GrantedBy: m.GrantedBy,
+		CreatedAt: m.CreatedAt.Unix(),
+	}
+}
+
+func toEventDTO(ev models.QueueEvent) *queuev1.QueueEventDTO {
+	return &queuev1.QueueEventDTO{
+		Id:        ev.ID,
+		QueueId:   ev.QueueID,
+		Type:      string(ev.Type),
+		ActorId:   ev.ActorID,
+		UserId:    ev.UserID,
+		Position:  ev.Position,
+		CreatedAt: ev.CreatedAt.Unix(),
+	}
+}
+
+func toHistoryDTO(h models.HistoryEntry) *queuev1.HistoryEntryDTO {
+	dto := &queuev1.HistoryEntryDTO{
+		Id:         h.ID,
+		QueueId:    h.QueueID,
+		UserId:     h.UserID,
+		FullName:   h.FullName,
+		Position:   h.Position,
+		Outcome:    string(h.Outcome),
+		ActorId:    h.ActorID,
+		JoinedAt:   h.JoinedAt.Unix(),
+		FinishedAt: h.FinishedAt.Unix(),
+	}
+	if h.SlotTime != nil {
+		dto.SlotTime = formatSlotTime(*h.SlotTime)
 	}
 	return dto
 }
@@ -356,6 +678,8 @@ func toProtoMode(mode models.QueueMode) queuev1.QueueMode {
 		return queuev1.QueueMode_QUEUE_MODE_RANDOM
 	case models.ModeSlots:
 		return queuev1.QueueMode_QUEUE_MODE_SLOTS
+	case models.ModePriority:
+		return queuev1.QueueMode_QUEUE_MODE_PRIORITY
 	default:
 		return queuev1.QueueMode_QUEUE_MODE_UNSPECIFIED
 	}
@@ -371,6 +695,8 @@ func toMode(mode queuev1.QueueMode) models.QueueMode {
 		return models.ModeRandom
 	case queuev1.QueueMode_QUEUE_MODE_SLOTS:
 		return models.ModeSlots
+	case queuev1.QueueMode_QUEUE_MODE_PRIORITY:
+		return models.ModePriority
 	default:
 		return models.ModeLive
 	}
@@ -378,8 +704,12 @@ func toMode(mode queuev1.QueueMode) models.QueueMode {
 
 func toProtoStatus(status models.QueueStatus) queuev1.QueueStatus {
 	switch status {
+	case models.StatusScheduled:
+		return queuev1.QueueStatus_QUEUE_STATUS_SCHEDULED
 	case models.StatusActive:
 		return queuev1.QueueStatus_QUEUE_STATUS_ACTIVE
+	case models.StatusClosed:
+		return queuev1.QueueStatus_QUEUE_STATUS_CLOSED
 	case models.StatusArchived:
 		return queuev1.QueueStatus_QUEUE_STATUS_ARCHIVED
 	default:
@@ -403,6 +733,88 @@ func mapErr(err error, fallback string) error {
 		return status.Error(codes.InvalidArgument, "slot_time is required for slots")
 	case errors.Is(err, queue.ErrQueueInactive):
 		return status.Error(codes.FailedPrecondition, "queue is not active")
+	case errors.Is(err, queue.ErrQueueNotOpen):
+		return status.Error(codes.FailedPrecondition, "queue is not open yet")
+	case errors.Is(err, queue.ErrQueueClosed):
+		return status.Error(codes.FailedPrecondition, "queue is closed")
+	case errors.Is(err, queue.ErrInvalidSchedule):
+		return status.Error(codes.InvalidArgument, "closes_at must be in the future and after opens_at")
+	case errors.Is(err, queue.ErrNotSlotsMode):
+		return status.Error(codes.FailedPrecondition, "queue is not in slots mode")
+	case errors.Is(err, queue.ErrInvalidSlotSchedule):
+		return status.Error(codes.InvalidArgument, "invalid slot schedule")
+	case errors.Is(err, queue.ErrSlotPassed):
+		return status.Error(codes.InvalidArgument, "slot has already started")
+	case errors.Is(err, storage.ErrNoSlotSchedule):
+		return status.Error(codes.FailedPrecondition, "queue has no slot schedule")
+	case errors.Is(err, storage.ErrSlotUnavailable):
+		return status.Error(codes.InvalidArgument, "slot_time is not a slot of the schedule")
+	case errors.Is(err, storage.ErrTemplateNotFound):
+		return status.Error(codes.NotFound, "template not found")
+	case errors.Is(err, queue.ErrInvalidTemplate):
+		return status.Error(codes.InvalidArgument, "invalid template: check weekdays, timezone, slot and lottery settings")
+	case errors.Is(err, storage.ErrSlotFull):
+		return status.Error(codes.FailedPrecondition, "slot is full")
+	case errors.Is(err, queue.ErrInvalidCallPolicy):
+		return status.Error(codes.InvalidArgument, "call_timeout must be 0 or 30..3600 seconds, miss_shift 0..50")
+	case errors.Is(err, storage.ErrNotCalled):
+		return status.Error(codes.FailedPrecondition, "participant is not called")
+	case errors.Is(err, queue.ErrFixedOrder):
+		return status.Error(codes.FailedPrecondition, "order of slots, random and priority queues cannot be changed by hand")
+	case errors.Is(err, storage.ErrInvalidPosition):
+		return status.Error(codes.InvalidArgument, "position is out of the queue")
+	case errors.Is(err, storage.ErrOrderMismatch):
+		return status.Error(codes.InvalidArgument, "user_ids must list every participant exactly once")
+	case errors.Is(err, queue.ErrNotRandomMode):
+		return status.Error(codes.FailedPrecondition, "queue is not in random mode")
+	case errors.Is(err, queue.ErrInvalidLottery):
+		return status.Error(codes.InvalidArgument, "lottery_at must be in the future and not before opens_at")
+	case errors.Is(err, queue.ErrLotteryRequired):
+		return status.Error(codes.InvalidArgument, "random queue needs lottery_at or closes_at")
+	case errors.Is(err, queue.ErrLotteryPending):
+		return status.Error(codes.FailedPrecondition, "queue order is not drawn yet")
+	case errors.Is(err, storage.ErrAlreadyDrawn):
+		return status.Error(codes.FailedPrecondition, "queue order is already drawn")
+	case errors.Is(err, queue.ErrNotPriorityMode):
+		return status.Error(codes.FailedPrecondition, "queue is not in priority mode")
+	case errors.Is(err, queue.ErrInvalidPriority):
+		return status.Error(codes.InvalidArgument, "priority must be -10..10")
+	case errors.Is(err, queue.ErrInvalidDefer):
+		return status.Error(codes.InvalidArgument, "places must be 1..10")
+	case errors.Is(err, storage.ErrDeferLimit):
+		return status.Error(codes.FailedPrecondition, "participant has deferred too many times")
+	case errors.Is(err, storage.ErrNobodyBehind):
+		return status.Error(codes.FailedPrecondition, "nobody stands behind the participant")
+	case errors.Is(err, queue.ErrSwapWithSelf):
+		return status.Error(codes.InvalidArgument, "cannot swap with yourself")
+	case errors.Is(err, queue.ErrSwapsForbidden):
+		return status.Error(codes.FailedPrecondition, "swaps are forbidden in this queue")
+	case errors.Is(err, storage.ErrSwapRequestExists):
+		return status.Error(codes.AlreadyExists, "swap request already pending")
+	case errors.Is(err, storage.ErrSwapNotFound):
+		return status.Error(codes.NotFound, "swap request not found")
+	case errors.Is(err, storage.ErrSwapNotPending):
+		return status.Error(codes.FailedPrecondition, "swap request is no longer pending")
+	case errors.Is(err, storage.ErrGroupNotFound):
+		return status.Error(codes.NotFound, "group not found")
+	case errors.Is(err, storage.ErrGroupExists):
+		return status.Error(codes.AlreadyExists, "group code is taken")
+	case errors.Is(err, storage.ErrGroupMemberExists):
+		return status.Error(codes.AlreadyExists, "already a group member")
+	case errors.Is(err, storage.ErrGroupMemberMissing):
+		return status.Error(codes.NotFound, "group member not found")
+	case errors.Is(err, queue.ErrInvalidInvite):
+		return status.Error(codes.NotFound, "invalid invite code")
+	case errors.Is(err, queue.ErrNotGroupAdmin):
+		return status.Error(codes.PermissionDenied, "not a group admin")
+	case errors.Is(err, queue.ErrGroupOwner):
+		return status.Error(codes.FailedPrecondition, "group owner cannot be removed")
+	case errors.Is(err, storage.ErrMemberNotFound):
+		return status.Error(codes.NotFound, "member not found")
+	case errors.Is(err, queue.ErrInvalidRole):
+		return status.Error(codes.InvalidArgument, "invalid role")
+	case errors.Is(err, queue.ErrCreatorRole):
+		return status.Error(codes.FailedPrecondition, "queue creator must stay owner")
 	default:
 		return status.Error(codes.Internal, fallback)
 	}
@@ -0,0 +1,124 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	queuev1 "github.com/s1lentmol/q-flow-backend/protos/gen/go/queue"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Slots interface {
+	SetSlotSchedule(ctx context.Context, queueID int64, actorID int64, group string, schedule models.SlotSchedule) (models.SlotSchedule, error)
+	ListFreeSlots(ctx context.Context, queueID int64, userID int64, group string) (models.SlotSchedule, []models.Slot, error)
+}
+
+func (s *serverAPI) SetSlotSchedule(ctx context.Context, req *queuev1.SetSlotScheduleRequest) (*queuev1.SetSlotScheduleResponse, error) {
+	input := struct {
+		QueueID     int64  `validate:"required,gt=0" json:"queue_id"`
+		GroupCode   string `json:"group_code"`
+		ActorID     int64  `validate:"required,gt=0" json:"actor_id"`
+		StartsAt    string `validate:"required" json:"starts_at"`
+		EndsAt      string `validate:"required" json:"ends_at"`
+		SlotMinutes int32  `validate:"required,gt=0" json:"slot_minutes"`
+		Capacity    int32  `validate:"required,gt=0" json:"capacity"`
+	}{
+		QueueID:     req.GetQueueId(),
+		GroupCode:   req.GetGroupCode(),
+		ActorID:     req.GetActorId(),
+		StartsAt:    req.GetStartsAt(),
+		EndsAt:      req.GetEndsAt(),
+		SlotMinutes: req.GetSlotMinutes(),
+		Capacity:    req.GetCapacity(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	schedule := models.SlotSchedule{
+		SlotDuration: time.Duration(req.GetSlotMinutes()) * time.Minute,
+		Capacity:     req.GetCapacity(),
+	}
+	var err error
+	if schedule.StartsAt, err = time.Parse(time.RFC3339, req.GetStartsAt()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "starts_at: invalid RFC3339 time")
+	}
+	if schedule.EndsAt, err = time.Parse(time.RFC3339, req.GetEndsAt()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "ends_at: invalid RFC3339 time")
+	}
+	for _, b := range req.GetBreaks() {
+		var br models.SlotBreak
+		if br.StartsAt, err = time.Parse(time.RFC3339, b.GetStartsAt()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "breaks.starts_at: invalid RFC3339 time")
+		}
+		if br.EndsAt, err = time.Parse(time.RFC3339, b.GetEndsAt()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "breaks.ends_at: invalid RFC3339 time")
+		}
+		schedule.Breaks = append(schedule.Breaks, br)
+	}
+
+	saved, err := s.queue.SetSlotSchedule(ctx, req.GetQueueId(), req.GetActorId(), req.GetGroupCode(), schedule)
+	if err != nil {
+		return nil, mapErr(err, "failed to set slot schedule")
+	}
+
+	return &queuev1.SetSlotScheduleResponse{Schedule: toSlotScheduleDTO(saved)}, nil
+}
+
+func (s *serverAPI) ListFreeSlots(ctx context.Context, req *queuev1.ListFreeSlotsRequest) (*queuev1.ListFreeSlotsResponse, error) {
+	input := struct {
+		QueueID     int64  `validate:"required,gt=0" json:"queue_id"`
+		GroupCode   string `json:"group_code"`
+		RequesterID int64  `validate:"required,gt=0" json:"requester_id"`
+	}{
+		QueueID:     req.GetQueueId(),
+		GroupCode:   req.GetGroupCode(),
+		RequesterID: req.GetRequesterId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	schedule, slots, err := s.queue.ListFreeSlots(ctx, req.GetQueueId(), req.GetRequesterId(), req.GetGroupCode())
+	if err != nil {
+		return nil, mapErr(err, "failed to list free slots")
+	}
+
+	resp := &queuev1.ListFreeSlotsResponse{
+		Schedule: toSlotScheduleDTO(schedule),
+		Slots:    make([]*queuev1.SlotDTO, 0, len(slots)),
+	}
+	for _, slot := range slots {
+		resp.Slots = append(resp.Slots, &queuev1.SlotDTO{
+			StartsAt: formatSlotTime(slot.StartsAt),
+			EndsAt:   formatSlotTime(slot.EndsAt),
+			Capacity: slot.Capacity,
+			Taken:    slot.Taken,
+		})
+	}
+	return resp, nil
+}
+
+func toSlotScheduleDTO(schedule models.SlotSchedule) *queuev1.SlotScheduleDTO {
+	dto := &queuev1.SlotScheduleDTO{
+		QueueId:     schedule.QueueID,
+		StartsAt:    formatSlotTime(schedule.StartsAt),
+		EndsAt:      formatSlotTime(schedule.EndsAt),
+		SlotMinutes: int32(schedule.SlotDuration / time.Minute),
+		Capacity:    schedule.Capacity,
+		UpdatedAt:   schedule.UpdatedAt.Unix(),
+	}
+	for _, b := range schedule.Breaks {
+		dto.Breaks = append(dto.Breaks, &queuev1.SlotBreakDTO{
+			StartsAt: formatSlotTime(b.StartsAt),
+			EndsAt:   formatSlotTime(b.EndsAt),
+		})
+	}
+	return dto
+}
+
+func formatSlotTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
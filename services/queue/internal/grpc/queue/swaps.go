@@ -0,0 +1,162 @@
+package grpc
+
+import (
+	"context"
+
+	queuev1 "github.com/s1lentmol/q-flow-backend/protos/gen/go/queue"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Swaps interface {
+	SetSwapsAllowed(ctx context.Context, queueID int64, actorID int64, group string, allowed bool) (models.Queue, error)
+	RequestSwap(ctx context.Context, queueID int64, userID, targetUserID int64, group string) (models.SwapRequest, error)
+	AnswerSwap(ctx context.Context, requestID int64, userID int64, group string, accept bool) (models.SwapRequest, []models.Participant, error)
+	CancelSwap(ctx context.Context, requestID int64, userID int64) (models.SwapRequest, error)
+	ListSwapRequests(ctx context.Context, queueID int64, userID int64, group string) ([]models.SwapRequest, error)
+}
+
+func (s *serverAPI) SetSwapPolicy(ctx context.Context, req *queuev1.SetSwapPolicyRequest) (*queuev1.SetSwapPolicyResponse, error) {
+	input := struct {
+		QueueID   int64  `validate:"required,gt=0" json:"queue_id"`
+		GroupCode string `json:"group_code"`
+		ActorID   int64  `validate:"required,gt=0" json:"actor_id"`
+	}{
+		QueueID:   req.GetQueueId(),
+		GroupCode: req.GetGroupCode(),
+		ActorID:   req.GetActorId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	q, err := s.queue.SetSwapsAllowed(ctx, req.GetQueueId(), req.GetActorId(), req.GetGroupCode(), req.GetAllowed())
+	if err != nil {
+		return nil, mapErr(err, "failed to set swap policy")
+	}
+
+	return &queuev1.SetSwapPolicyResponse{Queue: toQueueDTO(q)}, nil
+}
+
+func (s *serverAPI) RequestSwap(ctx context.Context, req *queuev1.RequestSwapRequest) (*queuev1.RequestSwapResponse, error) {
+	input := struct {
+		QueueID      int64  `validate:"required,gt=0" json:"queue_id"`
+		GroupCode    string `json:"group_code"`
+		UserID       int64  `validate:"required,gt=0" json:"user_id"`
+		TargetUserID int64  `validate:"required,gt=0,nefield=UserID" json:"target_user_id"`
+	}{
+		QueueID:      req.GetQueueId(),
+		GroupCode:    req.GetGroupCode(),
+		UserID:       req.GetUserId(),
+		TargetUserID: req.GetTargetUserId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	r, err := s.queue.RequestSwap(ctx, req.GetQueueId(), req.GetUserId(), req.GetTargetUserId(), req.GetGroupCode())
+	if err != nil {
+		return nil, mapErr(err, "failed to request swap")
+	}
+
+	return &queuev1.RequestSwapResponse{Request: toSwapRequestDTO(r)}, nil
+}
+
+func (s *serverAPI) AnswerSwap(ctx context.Context, req *queuev1.AnswerSwapRequest) (*queuev1.AnswerSwapResponse, error) {
+	input := struct {
+		RequestID int64  `validate:"required,gt=0" json:"request_id"`
+		GroupCode string `json:"group_code"`
+		UserID    int64  `validate:"required,gt=0" json:"user_id"`
+	}{
+		RequestID: req.GetRequestId(),
+		GroupCode: req.GetGroupCode(),
+		UserID:    req.GetUserId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	r, list, err := s.queue.AnswerSwap(ctx, req.GetRequestId(), req.GetUserId(), req.GetGroupCode(), req.GetAccept())
+	if err != nil {
+		return nil, mapErr(err, "failed to answer swap request")
+	}
+
+	return &queuev1.AnswerSwapResponse{Request: toSwapRequestDTO(r), Participants: toParticipantDTOs(list)}, nil
+}
+
+func (s *serverAPI) CancelSwap(ctx context.Context, req *queuev1.CancelSwapRequest) (*queuev1.CancelSwapResponse, error) {
+	input := struct {
+		RequestID int64 `validate:"required,gt=0" json:"request_id"`
+		UserID    int64 `validate:"required,gt=0" json:"user_id"`
+	}{
+		RequestID: req.GetRequestId(),
+		UserID:    req.GetUserId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	r, err := s.queue.CancelSwap(ctx, req.GetRequestId(), req.GetUserId())
+	if err != nil {
+		return nil, mapErr(err, "failed to cancel swap request")
+	}
+
+	return &queuev1.CancelSwapResponse{Request: toSwapRequestDTO(r)}, nil
+}
+
+func (s *serverAPI) ListSwapRequests(ctx context.Context, req *queuev1.ListSwapRequestsRequest) (*queuev1.ListSwapRequestsResponse, error) {
+	input := struct {
+		QueueID   int64  `validate:"required,gt=0" json:"queue_id"`
+		GroupCode string `json:"group_code"`
+		UserID    int64  `validate:"required,gt=0" json:"user_id"`
+	}{
+		QueueID:   req.GetQueueId(),
+		GroupCode: req.GetGroupCode(),
+		UserID:    req.GetUserId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	list, err := s.queue.ListSwapRequests(ctx, req.GetQueueId(), req.GetUserId(), req.GetGroupCode())
+	if err != nil {
+		return nil, mapErr(err, "failed to list swap requests")
+	}
+
+	resp := &queuev1.ListSwapRequestsResponse{Requests: make([]*queuev1.SwapRequestDTO, 0, len(list))}
+	for _, r := range list {
+		resp.Requests = append(resp.Requests, toSwapRequestDTO(r))
+	}
+	return resp, nil
+}
+
+func toSwapRequestDTO(r models.SwapRequest) *queuev1.SwapRequestDTO {
+	return &queuev1.SwapRequestDTO{
+		Id:         r.ID,
+		QueueId:    r.QueueID,
+		FromUserId: r.FromUserID,
+		ToUserId:   r.ToUserID,
+		Status:     toProtoSwapStatus(r.Status),
+		CreatedAt:  r.CreatedAt.Unix(),
+		ExpiresAt:  r.ExpiresAt.Unix(),
+		AnsweredAt: toUnix(r.AnsweredAt),
+	}
+}
+
+func toProtoSwapStatus(st models.SwapStatus) queuev1.SwapStatus {
+	switch st {
+	case models.SwapPending:
+		return queuev1.SwapStatus_SWAP_STATUS_PENDING
+	case models.SwapAccepted:
+		return queuev1.SwapStatus_SWAP_STATUS_ACCEPTED
+	case models.SwapDeclined:
+		return queuev1.SwapStatus_SWAP_STATUS_DECLINED
+	case models.SwapCancelled:
+		return queuev1.SwapStatus_SWAP_STATUS_CANCELLED
+	case models.SwapExpired:
+		return queuev1.SwapStatus_SWAP_STATUS_EXPIRED
+	default:
+		return queuev1.SwapStatus_SWAP_STATUS_UNSPECIFIED
+	}
+}
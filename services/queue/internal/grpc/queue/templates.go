@@ -0,0 +1,226 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	queuev1 "github.com/s1lentmol/q-flow-backend/protos/gen/go/queue"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Templates interface {
+	CreateTemplate(ctx context.Context, t models.QueueTemplate) (models.QueueTemplate, error)
+	GetTemplate(ctx context.Context, templateID int64, userID int64) (models.QueueTemplate, error)
+	ListTemplates(ctx context.Context, group string, userID int64) ([]models.QueueTemplate, error)
+	UpdateTemplate(ctx context.Context, t models.QueueTemplate, actorID int64) (models.QueueTemplate, error)
+	DeleteTemplate(ctx context.Context, templateID int64, actorID int64) error
+}
+
+type templateSpecInput struct {
+	Title                string            `validate:"required" json:"title"`
+	Mode                 queuev1.QueueMode `validate:"required,gt=0" json:"mode"`
+	GroupCode            string            `validate:"required" json:"group_code"`
+	Weekdays             []int32           `validate:"required,min=1,dive,gte=0,lte=6" json:"weekdays"`
+	StartTime            string            `validate:"required" json:"start_time"`
+	PublishBeforeMinutes int32             `validate:"gte=0" json:"publish_before_minutes"`
+	DurationMinutes      int32             `validate:"gte=0" json:"duration_minutes"`
+	SlotMinutes          int32             `validate:"gte=0" json:"slot_minutes"`
+	SlotCapacity         int32             `validate:"gte=0" json:"slot_capacity"`
+}
+
+func newTemplateSpecInput(spec *queuev1.QueueTemplateSpec) templateSpecInput {
+	return templateSpecInput{
+		Title:                spec.GetTitle(),
+		Mode:                 spec.GetMode(),
+		GroupCode:            spec.GetGroupCode(),
+		Weekdays:             spec.GetWeekdays(),
+		StartTime:            spec.GetStartTime(),
+		PublishBeforeMinutes: spec.GetPublishBeforeMinutes(),
+		DurationMinutes:      spec.GetDurationMinutes(),
+		SlotMinutes:          spec.GetSlotMinutes(),
+		SlotCapacity:         spec.GetSlotCapacity(),
+	}
+}
+
+func (s *serverAPI) CreateTemplate(ctx context.Context, req *queuev1.CreateTemplateRequest) (*queuev1.CreateTemplateResponse, error) {
+	input := struct {
+		OwnerID int64             `validate:"required,gt=0" json:"owner_id"`
+		Spec    templateSpecInput `json:"spec"`
+	}{
+		OwnerID: req.GetOwnerId(),
+		Spec:    newTemplateSpecInput(req.GetSpec()),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	t, err := fromTemplateSpec(req.GetSpec())
+	if err != nil {
+		return nil, err
+	}
+	t.OwnerID = req.GetOwnerId()
+
+	created, err := s.queue.CreateTemplate(ctx, t)
+	if err != nil {
+		return nil, mapErr(err, "failed to create template")
+	}
+
+	return &queuev1.CreateTemplateResponse{Template: toTemplateDTO(created)}, nil
+}
+
+func (s *serverAPI) GetTemplate(ctx context.Context, req *queuev1.GetTemplateRequest) (*queuev1.GetTemplateResponse, error) {
+	input := struct {
+		TemplateID  int64 `validate:"required,gt=0" json:"template_id"`
+		RequesterID int64 `validate:"required,gt=0" json:"requester_id"`
+	}{
+		TemplateID:  req.GetTemplateId(),
+		RequesterID: req.GetRequesterId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	t, err := s.queue.GetTemplate(ctx, req.GetTemplateId(), req.GetRequesterId())
+	if err != nil {
+		return nil, mapErr(err, "failed to get template")
+	}
+
+	return &queuev1.GetTemplateResponse{Template: toTemplateDTO(t)}, nil
+}
+
+func (s *serverAPI) ListTemplates(ctx context.Context, req *queuev1.ListTemplatesRequest) (*queuev1.ListTemplatesResponse, error) {
+	input := struct {
+		GroupCode   string `json:"group_code"`
+		RequesterID int64  `validate:"required,gt=0" json:"requester_id"`
+	}{
+		GroupCode:   req.GetGroupCode(),
+		RequesterID: req.GetRequesterId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	templates, err := s.queue.ListTemplates(ctx, req.GetGroupCode(), req.GetRequesterId())
+	if err != nil {
+		return nil, mapErr(err, "failed to list templates")
+	}
+
+	resp := &queuev1.ListTemplatesResponse{Templates: make([]*queuev1.QueueTemplateDTO, 0, len(templates))}
+	for _, t := range templates {
+		resp.Templates = append(resp.Templates, toTemplateDTO(t))
+	}
+	return resp, nil
+}
+
+func (s *serverAPI) UpdateTemplate(ctx context.Context, req *queuev1.UpdateTemplateRequest) (*queuev1.UpdateTemplateResponse, error) {
+	input := struct {
+		TemplateID int64             `validate:"required,gt=0" json:"template_id"`
+		ActorID    int64             `validate:"required,gt=0" json:"actor_id"`
+		Spec       templateSpecInput `json:"spec"`
+	}{
+		TemplateID: req.GetTemplateId(),
+		ActorID:    req.GetActorId(),
+		Spec:       newTemplateSpecInput(req.GetSpec()),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	t, err := fromTemplateSpec(req.GetSpec())
+	if err != nil {
+		return nil, err
+	}
+	t.ID = req.GetTemplateId()
+
+	updated, err := s.queue.UpdateTemplate(ctx, t, req.GetActorId())
+	if err != nil {
+		return nil, mapErr(err, "failed to update template")
+	}
+
+	return &queuev1.UpdateTemplateResponse{Template: toTemplateDTO(updated)}, nil
+}
+
+func (s *serverAPI) DeleteTemplate(ctx context.Context, req *queuev1.DeleteTemplateRequest) (*queuev1.DeleteTemplateResponse, error) {
+	input := struct {
+		TemplateID int64 `validate:"required,gt=0" json:"template_id"`
+		ActorID    int64 `validate:"required,gt=0" json:"actor_id"`
+	}{
+		TemplateID: req.GetTemplateId(),
+		ActorID:    req.GetActorId(),
+	}
+	if err := validate.Struct(input); err != nil {
+		return nil, status.Error(codes.InvalidArgument, formatValidationError(err))
+	}
+
+	if err := s.queue.DeleteTemplate(ctx, req.GetTemplateId(), req.GetActorId()); err != nil {
+		return nil, mapErr(err, "failed to delete template")
+	}
+
+	return &queuev1.DeleteTemplateResponse{}, nil
+}
+
+func fromTemplateSpec(spec *queuev1.QueueTemplateSpec) (models.QueueTemplate, error) {
+	start, err := time.Parse("15:04", spec.GetStartTime())
+	if err != nil {
+		return models.QueueTemplate{}, status.Error(codes.InvalidArgument, "start_time: expected HH:MM")
+	}
+
+	weekdays := make([]time.Weekday, 0, len(spec.GetWeekdays()))
+	for _, d := range spec.GetWeekdays() {
+		weekdays = append(weekdays, time.Weekday(d))
+	}
+
+	return models.QueueTemplate{
+		Title:       spec.GetTitle(),
+		Description: spec.GetDescription(),
+		Mode:        toMode(spec.GetMode()),
+		GroupCode:   spec.GetGroupCode(),
+		Recurrence: models.Recurrence{
+			Weekdays:  weekdays,
+			StartTime: time.Duration(start.Hour())*time.Hour + time.Duration(start.Minute())*time.Minute,
+			Timezone:  spec.GetTimezone(),
+		},
+		PublishBefore: time.Duration(spec.GetPublishBeforeMinutes()) * time.Minute,
+		Duration:      time.Duration(spec.GetDurationMinutes()) * time.Minute,
+		SlotDuration:  time.Duration(spec.GetSlotMinutes()) * time.Minute,
+		SlotCapacity:  spec.GetSlotCapacity(),
+		LotteryAfter:  time.Duration(spec.GetLotteryAfterMinutes()) * time.Minute,
+		AutoArchive:   spec.GetAutoArchive(),
+		Paused:        spec.GetPaused(),
+	}, nil
+}
+
+func toTemplateDTO(t models.QueueTemplate) *queuev1.QueueTemplateDTO {
+	weekdays := make([]int32, 0, len(t.Recurrence.Weekdays))
+	for _, d := range t.Recurrence.Weekdays {
+		weekdays = append(weekdays, int32(d))
+	}
+	start := t.Recurrence.StartTime
+
+	return &queuev1.QueueTemplateDTO{
+		Id:      t.ID,
+		OwnerId: t.OwnerID,
+		Spec: &queuev1.QueueTemplateSpec{
+			Title:                t.Title,
+			Description:          t.Description,
+			Mode:                 toProtoMode(t.Mode),
+			GroupCode:            t.GroupCode,
+			Weekdays:             weekdays,
+			StartTime:            time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC).Add(start).Format("15:04"),
+			Timezone:             t.Recurrence.Timezone,
+			PublishBeforeMinutes: int32(t.PublishBefore / time.Minute),
+			DurationMinutes:      int32(t.Duration / time.Minute),
+			SlotMinutes:          int32(t.SlotDuration / time.Minute),
+			SlotCapacity:         t.SlotCapacity,
+			LotteryAfterMinutes:  int32(t.LotteryAfter / time.Minute),
+			AutoArchive:          t.AutoArchive,
+			Paused:               t.Paused,
+		},
+		LastQueueId: t.LastQueueID,
+		NextRunAt:   t.NextRunAt.Unix(),
+		CreatedAt:   t.CreatedAt.Unix(),
+		UpdatedAt:   t.UpdatedAt.Unix(),
+	}
+}
@@ -0,0 +1,165 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+)
+
+type Storage interface {
+	ClaimOutbox(ctx context.Context, limit int, lease time.Duration) ([]models.OutboxMessage, error)
+	DeleteOutbox(ctx context.Context, id int64) error
+	RetryOutbox(ctx context.Context, id int64, lastErr string, nextAttempt time.Time) error
+	BuryOutbox(ctx context.Context, id int64, lastErr string) error
+}
+
+type Notifier interface {
+	NotifyPositionSoon(ctx context.Context, p models.PositionSoonPayload) error
+	NotifyQueueOpened(ctx context.Context, p models.QueueOpenedPayload) error
+	NotifyQueueArchived(ctx context.Context, p models.QueueArchivedPayload) error
+	NotifyParticipantRemoved(ctx context.Context, p models.ParticipantRemovedPayload) error
+	NotifyCalled(ctx context.Context, p models.ParticipantCalledPayload) error
+	NotifySwapRequested(ctx context.Context, p models.SwapRequestedPayload) error
+	NotifySwapAnswered(ctx context.Context, p models.SwapAnsweredPayload) error
+}
+
+type Config struct {
+	Interval    time.Duration
+	BatchSize   int
+	MaxAttempts int
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// Lease is how long a claimed message stays hidden from other dispatchers.
+	Lease time.Duration
+}
+
+// Dispatcher delivers notifications queued in the outbox to the notification
+// service. Failed deliveries are retried with exponential backoff; after
+// MaxAttempts the message is moved to the dead letters.
+type Dispatcher struct {
+	log     *slog.Logger
+	storage Storage
+	notif   Notifier
+	cfg     Config
+}
+
+func New(log *slog.Logger, storage Storage, notif Notifier, cfg Config) *Dispatcher {
+	return &Dispatcher{log: log, storage: storage, notif: notif, cfg: cfg}
+}
+
+// Run dispatches the outbox every interval until ctx is done.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := d.Dispatch(ctx); err != nil {
+				d.log.Warn("failed to dispatch outbox", slog.Any("err", err))
+			}
+		}
+	}
+}
+
+// Dispatch delivers one batch of due messages.
+func (d *Dispatcher) Dispatch(ctx context.Context) error {
+	messages, err := d.storage.ClaimOutbox(ctx, d.cfg.BatchSize, d.cfg.Lease)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range messages {
+		if err := d.deliver(ctx, m); err != nil {
+			d.fail(ctx, m, err)
+			continue
+		}
+		if err := d.storage.DeleteOutbox(ctx, m.ID); err != nil {
+			d.log.Warn("failed to delete delivered message", slog.Int64("id", m.ID), slog.Any("err", err))
+		}
+	}
+	return nil
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, m models.OutboxMessage) error {
+	switch m.Kind {
+	case models.OutboxPositionSoon:
+		var p models.PositionSoonPayload
+		if err := json.Unmarshal(m.Payload, &p); err != nil {
+			return fmt.Errorf("decode payload: %w", err)
+		}
+		return d.notif.NotifyPositionSoon(ctx, p)
+	case models.OutboxQueueOpened:
+		var p models.QueueOpenedPayload
+		if err := json.Unmarshal(m.Payload, &p); err != nil {
+			return fmt.Errorf("decode payload: %w", err)
+		}
+		return d.notif.NotifyQueueOpened(ctx, p)
+	case models.OutboxQueueArchived:
+		var p models.QueueArchivedPayload
+		if err := json.Unmarshal(m.Payload, &p); err != nil {
+			return fmt.Errorf("decode payload: %w", err)
+		}
+		return d.notif.NotifyQueueArchived(ctx, p)
+	case models.OutboxParticipantRemoved:
+		var p models.ParticipantRemovedPayload
+		if err := json.Unmarshal(m.Payload, &p); err != nil {
+			return fmt.Errorf("decode payload: %w", err)
+		}
+		return d.notif.NotifyParticipantRemoved(ctx, p)
+	case models.OutboxParticipantCalled:
+		var p models.ParticipantCalledPayload
+		if err := json.Unmarshal(m.Payload, &p); err != nil {
+			return fmt.Errorf("decode payload: %w", err)
+		}
+		return d.notif.NotifyCalled(ctx, p)
+	case models.OutboxSwapRequested:
+		var p models.SwapRequestedPayload
+		if err := json.Unmarshal(m.Payload, &p); err != nil {
+			return fmt.Errorf("decode payload: %w", err)
+		}
+		return d.notif.NotifySwapRequested(ctx, p)
+	case models.OutboxSwapAnswered:
+		var p models.SwapAnsweredPayload
+		if err := json.Unmarshal(m.Payload, &p); err != nil {
+			return fmt.Errorf("decode payload: %w", err)
+		}
+		return d.notif.NotifySwapAnswered(ctx, p)
+	default:
+		return fmt.Errorf("unknown outbox kind %q", m.Kind)
+	}
+}
+
+func (d *Dispatcher) fail(ctx context.Context, m models.OutboxMessage, cause error) {
+	log := d.log.With(slog.Int64("id", m.ID), slog.String("kind", string(m.Kind)), slog.Any("err", cause))
+
+	attempts := m.Attempts + 1
+	if attempts >= d.cfg.MaxAttempts {
+		log.Error("notification moved to dead letters", slog.Int("attempts", attempts))
+		if err := d.storage.BuryOutbox(ctx, m.ID, cause.Error()); err != nil {
+			d.log.Warn("failed to bury outbox message", slog.Int64("id", m.ID), slog.Any("err", err))
+		}
+		return
+	}
+
+	log.Warn("notification delivery failed, will retry", slog.Int("attempts", attempts))
+	next := time.Now().Add(d.backoff(attempts))
+	if err := d.storage.RetryOutbox(ctx, m.ID, cause.Error(), next); err != nil {
+		d.log.Warn("failed to reschedule outbox message", slog.Int64("id", m.ID), slog.Any("err", err))
+	}
+}
+
+// backoff doubles the delay with every attempt, up to MaxBackoff.
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	delay := d.cfg.BaseBackoff
+	for i := 1; i < attempts && delay < d.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, d.cfg.MaxBackoff)
+}
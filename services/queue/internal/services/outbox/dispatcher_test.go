@@ -0,0 +1,36 @@
+package outbox
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDispatcherBackoff(t *testing.T) {
+	d := &Dispatcher{cfg: Config{BaseBackoff: time.Second, MaxBackoff: time.Minute}}
+
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{0, time.Second},
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{3, 4 * time.Second},
+		{6, 32 * time.Second},
+		{7, time.Minute},
+		{8, time.Minute},
+		{1000, time.Minute},
+	}
+	for _, tt := range tests {
+		if got := d.backoff(tt.attempts); got != tt.want {
+			t.Errorf("backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestDispatcherBackoffBaseAboveCap(t *testing.T) {
+	d := &Dispatcher{cfg: Config{BaseBackoff: 2 * time.Minute, MaxBackoff: time.Minute}}
+	if got := d.backoff(1); got != time.Minute {
+		t.Errorf("backoff(1) = %v, want the cap %v", got, time.Minute)
+	}
+}
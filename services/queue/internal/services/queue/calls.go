@@ -0,0 +1,87 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+)
+
+var ErrInvalidCallPolicy = errors.New("invalid call policy")
+
+// Bounds of the call policy. The timeout is checked by the call expiry loop,
+// so a very short one would be enforced late anyway.
+const (
+	minCallTimeout = 30 * time.Second
+	maxCallTimeout = time.Hour
+	maxMissShift   = 50
+)
+
+type CallStorage interface {
+	SetCallPolicy(ctx context.Context, queueID int64, timeout time.Duration, missShift int32, actorID int64) (models.Queue, error)
+	CheckIn(ctx context.Context, queueID int64, userID int64) (models.Participant, error)
+	ExpireCalls(ctx context.Context, now time.Time) ([]int64, error)
+}
+
+// SetCallPolicy turns calls on (a non-zero timeout) or off. A participant who
+// misses the call is moved back missShift places, or skipped when it is zero.
+func (s *Service) SetCallPolicy(ctx context.Context, queueID int64, actorID int64, group string, timeout time.Duration, missShift int32) (models.Queue, error) {
+	queue, _, err := s.storage.GetQueue(ctx, queueID)
+	if err != nil {
+		return models.Queue{}, err
+	}
+	if err := s.checkAccess(ctx, queue, actorID, group); err != nil {
+		return models.Queue{}, err
+	}
+	if err := s.authorize(ctx, queue, actorID, models.PermEdit); err != nil {
+		return models.Queue{}, err
+	}
+	if timeout != 0 && (timeout < minCallTimeout || timeout > maxCallTimeout) {
+		return models.Queue{}, ErrInvalidCallPolicy
+	}
+	if missShift < 0 || missShift > maxMissShift {
+		return models.Queue{}, ErrInvalidCallPolicy
+	}
+
+	q, err := s.storage.SetCallPolicy(ctx, queueID, timeout, missShift, actorID)
+	if err != nil {
+		return models.Queue{}, err
+	}
+	s.publish(ctx, queueID)
+	return q, nil
+}
+
+// CheckIn confirms that the called participant is coming, which stops the
+// call from timing out.
+func (s *Service) CheckIn(ctx context.Context, queueID int64, userID int64, group string) (models.Participant, error) {
+	queue, _, err := s.storage.GetQueue(ctx, queueID)
+	if err != nil {
+		return models.Participant{}, err
+	}
+	if err := s.checkAccess(ctx, queue, userID, group); err != nil {
+		return models.Participant{}, err
+	}
+
+	p, err := s.storage.CheckIn(ctx, queueID, userID)
+	if err != nil {
+		return models.Participant{}, err
+	}
+	s.publish(ctx, queueID)
+	return p, nil
+}
+
+// ExpireCalls moves back or skips the participants whose call timed out and
+// calls the next ones.
+func (s *Service) ExpireCalls(ctx context.Context) error {
+	queueIDs, err := s.storage.ExpireCalls(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	for _, id := range queueIDs {
+		s.log.Info("call timed out", slog.Int64("queue_id", id))
+		s.publish(ctx, id)
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package queue
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"strings"
+
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/storage"
+)
+
+var (
+	ErrInvalidInvite = errors.New("invalid invite code")
+	ErrGroupOwner    = errors.New("group owner cannot be removed")
+	ErrNotGroupAdmin = errors.New("not a group admin")
+)
+
+type GroupStorage interface {
+	CreateGroup(ctx context.Context, g models.Group, ownerName string) (models.Group, error)
+	GetGroup(ctx context.Context, groupID int64) (models.Group, error)
+	GroupByCode(ctx context.Context, code string) (models.Group, error)
+	GroupByInviteCode(ctx context.Context, inviteCode string) (models.Group, error)
+	ListUserGroups(ctx context.Context, userID int64) ([]models.UserGroup, error)
+	GroupMember(ctx context.Context, groupID int64, userID int64) (models.GroupMember, error)
+	IsGroupMember(ctx context.Context, groupCode string, userID int64) (bool, error)
+	AddGroupMember(ctx context.Context, m models.GroupMember) (models.GroupMember, error)
+	ListGroupMembers(ctx context.Context, groupID int64, status models.MemberStatus) ([]models.GroupMember, error)
+	ActivateGroupMember(ctx context.Context, groupID int64, userID int64) error
+	RemoveGroupMember(ctx context.Context, groupID int64, userID int64) error
+	UpdateInviteCode(ctx context.Context, groupID int64, inviteCode string) error
+}
+
+// CreateGroup registers a group under a unique code; the creator becomes its admin.
+func (s *Service) CreateGroup(ctx context.Context, code, name string, requireApproval bool, ownerID int64, ownerName string) (models.Group, error) {
+	invite, err := newInviteCode()
+	if err != nil {
+		return models.Group{}, err
+	}
+	return s.storage.CreateGroup(ctx, models.Group{
+		Code:            code,
+		Name:            name,
+		OwnerID:         ownerID,
+		InviteCode:      invite,
+		RequireApproval: requireApproval,
+	}, ownerName)
+}
+
+// ListGroups returns groups of the user, including pending join requests.
+// Invite codes are only visible to group admins.
+func (s *Service) ListGroups(ctx context.Context, userID int64) ([]models.UserGroup, error) {
+	groups, err := s.storage.ListUserGroups(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range groups {
+		if groups[i].Role != models.GroupRoleAdmin || groups[i].Status != models.MemberActive {
+			groups[i].InviteCode = ""
+		}
+	}
+	return groups, nil
+}
+
+func (s *Service) GetGroup(ctx context.Context, groupID int64, userID int64) (models.UserGroup, error) {
+	group, err := s.storage.GetGroup(ctx, groupID)
+	if err != nil {
+		return models.UserGroup{}, err
+	}
+
+	member, err := s.storage.GroupMember(ctx, groupID, userID)
+	switch {
+	case err == nil:
+	case errors.Is(err, storage.ErrGroupMemberMissing):
+		if !s.isAdmin(ctx, userID) {
+			return models.UserGroup{}, ErrGroupMismatch
+		}
+		// Admins see the group as its admins do.
+		member = models.GroupMember{Role: models.GroupRoleAdmin, Status: models.MemberActive}
+	default:
+		return models.UserGroup{}, err
+	}
+
+	ug := models.UserGroup{Group: group, Role: member.Role, Status: member.Status}
+	if member.Role != models.GroupRoleAdmin || member.Status != models.MemberActive {
+		ug.InviteCode = ""
+	}
+	return ug, nil
+}
+
+// JoinGroup uses an invite code. The membership is pending until a group
+// admin approves it if the group requires approval.
+func (s *Service) JoinGroup(ctx context.Context, inviteCode string, userID int64, fullName string) (models.GroupMember, error) {
+	group, err := s.storage.GroupByInviteCode(ctx, inviteCode)
+	if err != nil {
+		if errors.Is(err, storage.ErrGroupNotFound) {
+			return models.GroupMember{}, ErrInvalidInvite
+		}
+		return models.GroupMember{}, err
+	}
+
+	status := models.MemberActive
+	if group.RequireApproval {
+		status = models.MemberPending
+	}
+	return s.storage.AddGroupMember(ctx, models.GroupMember{
+		GroupID:  group.ID,
+		UserID:   userID,
+		FullName: fullName,
+		Role:     models.GroupRoleMember,
+		Status:   status,
+	})
+}
+
+// ListGroupMembers lists the group. Pending requests are only visible to group admins.
+func (s *Service) ListGroupMembers(ctx context.Context, groupID int64, userID int64, status models.MemberStatus) ([]models.GroupMember, error) {
+	group, err := s.GetGroup(ctx, groupID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if group.Status != models.MemberActive {
+		return nil, ErrGroupMismatch
+	}
+	if group.Role != models.GroupRoleAdmin {
+		if status == models.MemberPending {
+			return nil, ErrNotGroupAdmin
+		}
+		status = models.MemberActive
+	}
+	return s.storage.ListGroupMembers(ctx, groupID, status)
+}
+
+// ReviewGroupMember approves a pending join request or rejects (deletes) it.
+func (s *Service) ReviewGroupMember(ctx context.Context, groupID int64, userID int64, actorID int64, approve bool) error {
+	if err := s.requireGroupAdmin(ctx, groupID, actorID); err != nil {
+		return err
+	}
+
+	member, err := s.storage.GroupMember(ctx, groupID, userID)
+	if err != nil {
+		return err
+	}
+	if member.Status != models.MemberPending {
+		return storage.ErrGroupMemberMissing
+	}
+
+	if approve {
+		return s.storage.ActivateGroupMember(ctx, groupID, userID)
+	}
+	return s.storage.RemoveGroupMember(ctx, groupID, userID)
+}
+
+// RemoveGroupMember lets a user leave the group or a group admin remove anyone but the owner.
+func (s *Service) RemoveGroupMember(ctx context.Context, groupID int64, userID int64, actorID int64) error {
+	group, err := s.storage.GetGroup(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	if userID == group.OwnerID {
+		return ErrGroupOwner
+	}
+	if userID != actorID {
+		if err := s.requireGroupAdmin(ctx, groupID, actorID); err != nil {
+			return err
+		}
+	}
+	return s.storage.RemoveGroupMember(ctx, groupID, userID)
+}
+
+// RegenerateInviteCode invalidates the old invite link.
+func (s *Service) RegenerateInviteCode(ctx context.Context, groupID int64, actorID int64) (string, error) {
+	if err := s.requireGroupAdmin(ctx, groupID, actorID); err != nil {
+		return "", err
+	}
+	invite, err := newInviteCode()
+	if err != nil {
+		return "", err
+	}
+	if err := s.storage.UpdateInviteCode(ctx, groupID, invite); err != nil {
+		return "", err
+	}
+	return invite, nil
+}
+
+func (s *Service) requireGroupAdmin(ctx context.Context, groupID int64, actorID int64) error {
+	member, err := s.storage.GroupMember(ctx, groupID, actorID)
+	switch {
+	case err == nil:
+		if member.Role == models.GroupRoleAdmin && member.Status == models.MemberActive {
+			return nil
+		}
+	case !errors.Is(err, storage.ErrGroupMemberMissing):
+		return err
+	}
+	if s.isAdmin(ctx, actorID) {
+		return nil
+	}
+	return ErrNotGroupAdmin
+}
+
+func newInviteCode() (string, error) {
+	buf := make([]byte, 10)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(buf)), nil
+}
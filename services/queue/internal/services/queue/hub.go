@@ -0,0 +1,80 @@
+package queue
+
+import (
+	"sync"
+
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+)
+
+// hub fans out queue snapshots to in-process watchers.
+// Every subscriber channel holds at most one snapshot: a slow reader
+// skips intermediate states and always gets the latest one.
+type hub struct {
+	mu   sync.Mutex
+	subs map[int64]map[chan models.QueueSnapshot]struct{}
+}
+
+func newHub() *hub {
+	return &hub{subs: make(map[int64]map[chan models.QueueSnapshot]struct{})}
+}
+
+func (h *hub) subscribe(queueID int64) (chan models.QueueSnapshot, func()) {
+	ch := make(chan models.QueueSnapshot, 1)
+
+	h.mu.Lock()
+	if h.subs[queueID] == nil {
+		h.subs[queueID] = make(map[chan models.QueueSnapshot]struct{})
+	}
+	h.subs[queueID][ch] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+			delete(h.subs[queueID], ch)
+			if len(h.subs[queueID]) == 0 {
+				delete(h.subs, queueID)
+			}
+		})
+	}
+	return ch, unsubscribe
+}
+
+func (h *hub) watched(queueID int64) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.subs[queueID]) > 0
+}
+
+func (h *hub) publish(snap models.QueueSnapshot) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.subs[snap.Queue.ID] {
+		deliver(ch, snap)
+	}
+}
+
+// offer hands the initial snapshot to a fresh subscriber unless a newer
+// one has already been published to it.
+func (h *hub) offer(ch chan models.QueueSnapshot, snap models.QueueSnapshot) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	select {
+	case ch <- snap:
+	default:
+	}
+}
+
+// deliver replaces a pending snapshot with a newer one without blocking.
+func deliver(ch chan models.QueueSnapshot, snap models.QueueSnapshot) {
+	select {
+	case <-ch:
+	default:
+	}
+	select {
+	case ch <- snap:
+	default:
+	}
+}
@@ -0,0 +1,150 @@
+package queue
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+)
+
+var (
+	ErrNotRandomMode   = errors.New("queue is not in random mode")
+	ErrInvalidLottery  = errors.New("lottery time must be in the future and not before the queue opens")
+	ErrLotteryRequired = errors.New("random queue needs a lottery time or a closing time")
+	ErrLotteryPending  = errors.New("queue order is not drawn yet")
+)
+
+// seedSize is the number of random bytes in a lottery seed.
+const seedSize = 32
+
+type LotteryStorage interface {
+	SetLottery(ctx context.Context, queueID int64, lotteryAt *time.Time, actorID int64) (models.Queue, error)
+	DrawLottery(ctx context.Context, queueID int64, fallback string, actorID int64) (models.Queue, []models.Participant, error)
+	DueLotteries(ctx context.Context, now time.Time) ([]int64, error)
+}
+
+// SetLottery schedules the draw of a random queue; nil leaves the draw to the owner.
+func (s *Service) SetLottery(ctx context.Context, queueID int64, actorID int64, group string, lotteryAt *time.Time) (models.Queue, error) {
+	queue, err := s.lotteryQueue(ctx, queueID, actorID, group)
+	if err != nil {
+		return models.Queue{}, err
+	}
+	if !validLotteryTime(lotteryAt, queue.OpensAt, time.Now()) {
+		return models.Queue{}, ErrInvalidLottery
+	}
+
+	q, err := s.storage.SetLottery(ctx, queue.ID, lotteryAt, actorID)
+	if err != nil {
+		return models.Queue{}, err
+	}
+	s.publish(ctx, queueID)
+	return q, nil
+}
+
+// DrawLottery draws the order of a random queue now instead of waiting for
+// its lottery time.
+func (s *Service) DrawLottery(ctx context.Context, queueID int64, actorID int64, group string) (models.Queue, []models.Participant, error) {
+	queue, err := s.lotteryQueue(ctx, queueID, actorID, group)
+	if err != nil {
+		return models.Queue{}, nil, err
+	}
+	if queue.Status != models.StatusActive && queue.Status != models.StatusClosed {
+		return models.Queue{}, nil, ErrQueueInactive
+	}
+	return s.draw(ctx, queueID, actorID)
+}
+
+// DrawDueLotteries draws the random queues whose lottery time has come.
+func (s *Service) DrawDueLotteries(ctx context.Context) error {
+	ids, err := s.storage.DueLotteries(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if _, _, err := s.draw(ctx, id, 0); err != nil {
+			s.log.Warn("failed to draw queue order", slog.Int64("queue_id", id), slog.Any("err", err))
+		}
+	}
+	return nil
+}
+
+func (s *Service) draw(ctx context.Context, queueID int64, actorID int64) (models.Queue, []models.Participant, error) {
+	fallback, err := newSeed()
+	if err != nil {
+		return models.Queue{}, nil, err
+	}
+	q, order, err := s.storage.DrawLottery(ctx, queueID, fallback, actorID)
+	if err != nil {
+		return models.Queue{}, nil, err
+	}
+	s.log.Info("queue order drawn", slog.Int64("queue_id", queueID), slog.String("seed", q.LotterySeed), slog.Int("participants", len(order)))
+	s.publish(ctx, queueID)
+	return q, order, nil
+}
+
+// lotteryQueue loads a random queue the actor may edit.
+func (s *Service) lotteryQueue(ctx context.Context, queueID int64, actorID int64, group string) (models.Queue, error) {
+	queue, _, err := s.storage.GetQueue(ctx, queueID)
+	if err != nil {
+		return models.Queue{}, err
+	}
+	if err := s.checkAccess(ctx, queue, actorID, group); err != nil {
+		return models.Queue{}, err
+	}
+	if err := s.authorize(ctx, queue, actorID, models.PermEdit); err != nil {
+		return models.Queue{}, err
+	}
+	if queue.Mode != models.ModeRandom {
+		return models.Queue{}, ErrNotRandomMode
+	}
+	return queue, nil
+}
+
+// armLottery prepares a new queue for its draw. A random queue draws at
+// lotteryAt, or at closing when lotteryAt is nil, with a seed chosen now;
+// only the seed's hash is shown until the draw. Other modes take no lottery.
+func armLottery(q *models.Queue, lotteryAt *time.Time) error {
+	if q.Mode != models.ModeRandom {
+		if lotteryAt != nil {
+			return ErrNotRandomMode
+		}
+		return nil
+	}
+	if lotteryAt == nil {
+		lotteryAt = q.ClosesAt
+	}
+	if lotteryAt == nil {
+		return ErrLotteryRequired
+	}
+
+	seed, err := newSeed()
+	if err != nil {
+		return err
+	}
+	q.LotteryAt = lotteryAt
+	q.LotterySeed = seed
+	q.LotteryCommit = models.LotteryCommit(seed)
+	return nil
+}
+
+// validLotteryTime tells whether a draw may be scheduled at lotteryAt; nil
+// leaves the draw to the owner and is always valid.
+func validLotteryTime(lotteryAt, opensAt *time.Time, now time.Time) bool {
+	if lotteryAt == nil {
+		return true
+	}
+	return lotteryAt.After(now) && (opensAt == nil || !lotteryAt.Before(*opensAt))
+}
+
+func newSeed() (string, error) {
+	b := make([]byte, seedSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("lottery seed: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,88 @@
+package queue
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+)
+
+func TestValidLotteryTime(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		t := now.Add(d)
+		return &t
+	}
+
+	tests := []struct {
+		name      string
+		lotteryAt *time.Time
+		opensAt   *time.Time
+		want      bool
+	}{
+		{"no time", nil, nil, true},
+		{"no time, scheduled queue", nil, at(time.Hour), true},
+		{"future", at(time.Minute), nil, true},
+		{"now", at(0), nil, false},
+		{"past", at(-time.Minute), nil, false},
+		{"after opening", at(2 * time.Hour), at(time.Hour), true},
+		{"at opening", at(time.Hour), at(time.Hour), true},
+		{"before opening", at(30 * time.Minute), at(time.Hour), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validLotteryTime(tt.lotteryAt, tt.opensAt, now); got != tt.want {
+				t.Errorf("validLotteryTime = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestArmLottery(t *testing.T) {
+	closes := time.Date(2026, 3, 1, 18, 0, 0, 0, time.UTC)
+	explicit := time.Date(2026, 3, 1, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		queue     models.Queue
+		lotteryAt *time.Time
+		wantAt    *time.Time
+		wantErr   error
+	}{
+		{"random, explicit time", models.Queue{Mode: models.ModeRandom, ClosesAt: &closes}, &explicit, &explicit, nil},
+		{"random, defaults to closing", models.Queue{Mode: models.ModeRandom, ClosesAt: &closes}, nil, &closes, nil},
+		{"random, no time at all", models.Queue{Mode: models.ModeRandom}, nil, nil, ErrLotteryRequired},
+		{"live, no time", models.Queue{Mode: models.ModeLive, ClosesAt: &closes}, nil, nil, nil},
+		{"live, with a time", models.Queue{Mode: models.ModeLive}, &explicit, nil, ErrNotRandomMode},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := tt.queue
+			err := armLottery(&q, tt.lotteryAt)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("armLottery error = %v, want %v", err, tt.wantErr)
+			}
+
+			switch {
+			case tt.wantAt == nil && q.LotteryAt != nil:
+				t.Errorf("LotteryAt = %v, want none", q.LotteryAt)
+			case tt.wantAt != nil && (q.LotteryAt == nil || !q.LotteryAt.Equal(*tt.wantAt)):
+				t.Errorf("LotteryAt = %v, want %v", q.LotteryAt, *tt.wantAt)
+			}
+
+			if tt.wantAt == nil {
+				if q.LotterySeed != "" || q.LotteryCommit != "" {
+					t.Errorf("seed %q and commit %q are set without a lottery", q.LotterySeed, q.LotteryCommit)
+				}
+				return
+			}
+			if len(q.LotterySeed) != 2*seedSize {
+				t.Errorf("seed %q has %d hex digits, want %d", q.LotterySeed, len(q.LotterySeed), 2*seedSize)
+			}
+			if q.LotteryCommit != models.LotteryCommit(q.LotterySeed) {
+				t.Errorf("commit %q does not match the seed", q.LotteryCommit)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package queue
+
+import (
+	"context"
+	"errors"
+
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+)
+
+var (
+	ErrFixedOrder   = errors.New("order of the queue cannot be changed by hand")
+	ErrInvalidDefer = errors.New("invalid number of places to defer")
+)
+
+// Bounds of DeferTurn: how far one deferral moves a participant and how many
+// times a participant may defer while in the queue.
+const (
+	maxDeferShift = 10
+	maxDeferrals  = 2
+)
+
+type OrderStorage interface {
+	MoveParticipant(ctx context.Context, queue models.Queue, userID int64, position int32, actorID int64) ([]models.Participant, error)
+	SwapParticipants(ctx context.Context, queue models.Queue, firstUserID, secondUserID int64, actorID int64) ([]models.Participant, error)
+	ReorderQueue(ctx context.Context, queue models.Queue, userIDs []int64, actorID int64) ([]models.Participant, error)
+	DeferTurn(ctx context.Context, queue models.Queue, userID int64, shift int32, maxDeferrals int32) (models.Participant, error)
+}
+
+// MoveParticipant puts the participant at an absolute position. Returns the new order.
+func (s *Service) MoveParticipant(ctx context.Context, queueID int64, userID int64, position int32, actorID int64, group string) ([]models.Participant, error) {
+	queue, err := s.orderable(ctx, queueID, actorID, group)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := s.storage.MoveParticipant(ctx, queue, userID, position, actorID)
+	if err != nil {
+		return nil, err
+	}
+	s.publish(ctx, queueID)
+	return list, nil
+}
+
+// SwapParticipants exchanges the places of two participants. Returns the new order.
+func (s *Service) SwapParticipants(ctx context.Context, queueID int64, firstUserID, secondUserID int64, actorID int64, group string) ([]models.Participant, error) {
+	queue, err := s.orderable(ctx, queueID, actorID, group)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := s.storage.SwapParticipants(ctx, queue, firstUserID, secondUserID, actorID)
+	if err != nil {
+		return nil, err
+	}
+	s.publish(ctx, queueID)
+	return list, nil
+}
+
+// ReorderQueue replaces the whole order; userIDs lists every participant once, head first.
+func (s *Service) ReorderQueue(ctx context.Context, queueID int64, userIDs []int64, actorID int64, group string) ([]models.Participant, error) {
+	queue, err := s.orderable(ctx, queueID, actorID, group)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := s.storage.ReorderQueue(ctx, queue, userIDs, actorID)
+	if err != nil {
+		return nil, err
+	}
+	s.publish(ctx, queueID)
+	return list, nil
+}
+
+// DeferTurn lets the participants behind the user go first: the user moves
+// down by places (to the end if fewer stand behind). Returns the user at the
+// new place.
+func (s *Service) DeferTurn(ctx context.Context, queueID int64, userID int64, places int32, group string) (models.Participant, error) {
+	if places < 1 || places > maxDeferShift {
+		return models.Participant{}, ErrInvalidDefer
+	}
+	queue, _, err := s.storage.GetQueue(ctx, queueID)
+	if err != nil {
+		return models.Participant{}, err
+	}
+	if err := s.checkAccess(ctx, queue, userID, group); err != nil {
+		return models.Participant{}, err
+	}
+	if queue.Status != models.StatusActive && queue.Status != models.StatusClosed {
+		return models.Participant{}, ErrQueueInactive
+	}
+	if fixedOrder(queue) {
+		return models.Participant{}, ErrFixedOrder
+	}
+
+	p, err := s.storage.DeferTurn(ctx, queue, userID, places, maxDeferrals)
+	if err != nil {
+		return models.Participant{}, err
+	}
+	s.publish(ctx, queueID)
+	return p, nil
+}
+
+// orderable loads the queue and checks that the actor may change its order.
+func (s *Service) orderable(ctx context.Context, queueID int64, actorID int64, group string) (models.Queue, error) {
+	queue, _, err := s.storage.GetQueue(ctx, queueID)
+	if err != nil {
+		return models.Queue{}, err
+	}
+	if err := s.checkAccess(ctx, queue, actorID, group); err != nil {
+		return models.Queue{}, err
+	}
+	if err := s.authorize(ctx, queue, actorID, models.PermModerate); err != nil {
+		return models.Queue{}, err
+	}
+	if queue.Status != models.StatusActive && queue.Status != models.StatusClosed {
+		return models.Queue{}, ErrQueueInactive
+	}
+	if fixedOrder(queue) {
+		return models.Queue{}, ErrFixedOrder
+	}
+	return queue, nil
+}
+
+// fixedOrder tells whether the order follows a rule nobody may override: slot
+// times, the draw of a random queue, or the priorities of a priority queue.
+func fixedOrder(queue models.Queue) bool {
+	return queue.Mode == models.ModeSlots || queue.Mode == models.ModeRandom || queue.Mode == models.ModePriority
+}
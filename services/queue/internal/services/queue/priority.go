@@ -0,0 +1,55 @@
+package queue
+
+import (
+	"context"
+	"errors"
+
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+)
+
+var (
+	ErrNotPriorityMode = errors.New("queue is not in priority mode")
+	ErrInvalidPriority = errors.New("invalid priority")
+)
+
+// Bounds of a participant priority. Zero is the default; a negative priority
+// lets newcomers of the default level go first.
+const (
+	minPriority = -10
+	maxPriority = 10
+)
+
+type PriorityStorage interface {
+	SetPriority(ctx context.Context, queue models.Queue, userID int64, priority int32, actorID int64) ([]models.Participant, error)
+}
+
+// SetPriority changes the priority of a participant of a priority queue and
+// moves them accordingly. Returns the new order.
+func (s *Service) SetPriority(ctx context.Context, queueID int64, userID int64, priority int32, actorID int64, group string) ([]models.Participant, error) {
+	if priority < minPriority || priority > maxPriority {
+		return nil, ErrInvalidPriority
+	}
+	queue, _, err := s.storage.GetQueue(ctx, queueID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkAccess(ctx, queue, actorID, group); err != nil {
+		return nil, err
+	}
+	if err := s.authorize(ctx, queue, actorID, models.PermEdit); err != nil {
+		return nil, err
+	}
+	if queue.Mode != models.ModePriority {
+		return nil, ErrNotPriorityMode
+	}
+	if queue.Status != models.StatusActive && queue.Status != models.StatusClosed {
+		return nil, ErrQueueInactive
+	}
+
+	list, err := s.storage.SetPriority(ctx, queue, userID, priority, actorID)
+	if err != nil {
+		return nil, err
+	}
+	s.publish(ctx, queueID)
+	return list, nil
+}
@@ -3,11 +3,11 @@ package queue
 import (
 	"context"
 	"errors"
-	"fmt"
 	"log/slog"
 	"time"
 
 	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/storage"
 )
 
 var (
@@ -15,57 +15,129 @@ var (
 	ErrForbidden     = errors.New("forbidden")
 	ErrSlotRequired  = errors.New("slot time required for slots mode")
 	ErrQueueInactive = errors.New("queue is not active")
+	ErrInvalidRole   = errors.New("invalid role")
+	ErrCreatorRole   = errors.New("queue creator must stay owner")
+)
+
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 200
 )
 
 type Storage interface {
-	ListQueues(ctx context.Context, group string) ([]models.Queue, error)
+	ListQueues(ctx context.Context, groups []string) ([]models.Queue, error)
 	CreateQueue(ctx context.Context, q models.Queue) (models.Queue, error)
 	GetQueue(ctx context.Context, queueID int64) (models.Queue, []models.Participant, error)
-	UpdateStatus(ctx context.Context, queueID int64, status models.QueueStatus) error
-	UpdateQueue(ctx context.Context, queueID int64, title, description string) (models.Queue, error)
-	DeleteQueue(ctx context.Context, queueID int64) error
-	AddParticipant(ctx context.Context, queue models.Queue, userID int64, fullName string, slotTime *time.Time) (int32, error)
-	RemoveParticipant(ctx context.Context, queue models.Queue, userID int64) error
-	Advance(ctx context.Context, queue models.Queue) (models.Participant, error)
+	UpdateStatus(ctx context.Context, queueID int64, status models.QueueStatus, actorID int64) error
+	UpdateQueue(ctx context.Context, queueID int64, title, description string, notifyTop int32, actorID int64) (models.Queue, error)
+	DeleteQueue(ctx context.Context, queueID int64, actorID int64) error
+	AddParticipant(ctx context.Context, queue models.Queue, userID int64, fullName string, slotTime *time.Time, actorID int64) (int32, error)
+	RemoveParticipant(ctx context.Context, queue models.Queue, userID int64, actorID int64) error
+	Advance(ctx context.Context, queue models.Queue, actorID int64, outcome models.Outcome) (models.Participant, models.Participant, error)
+	ListQueueEvents(ctx context.Context, queueID int64, filter models.EventFilter) ([]models.QueueEvent, error)
+	ListHistory(ctx context.Context, queueID int64, filter models.HistoryFilter) ([]models.HistoryEntry, error)
+	MemberRole(ctx context.Context, queueID int64, userID int64) (models.Role, error)
+	ListMembers(ctx context.Context, queueID int64) ([]models.QueueMember, error)
+	GrantRole(ctx context.Context, member models.QueueMember) (models.QueueMember, error)
+	RevokeRole(ctx context.Context, queueID int64, userID int64, actorID int64) error
+	GroupStorage
+	ScheduleStorage
+	SlotStorage
+	TemplateStorage
+	CallStorage
+	OrderStorage
+	SwapStorage
+	LotteryStorage
+	PriorityStorage
 }
 
-type Notifier interface {
-	NotifyPositionSoon(ctx context.Context, userID int64, queueTitle string, position int32) error
+// AdminChecker tells whether a user is a global admin (auth service).
+type AdminChecker interface {
+	IsAdmin(ctx context.Context, userID int64) (bool, error)
 }
 
 type Service struct {
 	log     *slog.Logger
 	storage Storage
-	notif   Notifier
+	admins  AdminChecker
+	hub     *hub
 }
 
-func New(log *slog.Logger, storage Storage, notif Notifier) *Service {
-	return &Service{log: log, storage: storage, notif: notif}
+// New creates the queue service. Notifications are not sent from here: storage
+// queues them in the outbox together with the change that causes them.
+func New(log *slog.Logger, storage Storage, admins AdminChecker) *Service {
+	return &Service{log: log, storage: storage, admins: admins, hub: newHub()}
 }
 
-func (s *Service) ListQueues(ctx context.Context, group string) ([]models.Queue, error) {
-	return s.storage.ListQueues(ctx, group)
+// ListQueues returns not archived queues of the group, or of all the user's groups when group is empty.
+func (s *Service) ListQueues(ctx context.Context, group string, userID int64) ([]models.Queue, error) {
+	if group != "" {
+		if err := s.checkGroupAccess(ctx, group, userID); err != nil {
+			return nil, err
+		}
+		return s.storage.ListQueues(ctx, []string{group})
+	}
+
+	groups, err := s.storage.ListUserGroups(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	codes := make([]string, 0, len(groups))
+	for _, g := range groups {
+		if g.Status == models.MemberActive {
+			codes = append(codes, g.Code)
+		}
+	}
+	if len(codes) == 0 {
+		return nil, nil
+	}
+	return s.storage.ListQueues(ctx, codes)
 }
 
-func (s *Service) CreateQueue(ctx context.Context, title, description, group string, mode models.QueueMode, ownerID int64) (models.Queue, error) {
+// CreateQueue creates a queue in the group. opensAt and closesAt are optional:
+// a queue that opens in the future is published as scheduled.
+func (s *Service) CreateQueue(ctx context.Context, title, description, group string, mode models.QueueMode, ownerID int64, opensAt, closesAt, lotteryAt *time.Time, notifyTop int32) (models.Queue, error) {
+	now := time.Now()
+	status, err := newSchedule(opensAt, closesAt, now)
+	if err != nil {
+		return models.Queue{}, err
+	}
+	if _, err := s.storage.GroupByCode(ctx, group); err != nil {
+		return models.Queue{}, err
+	}
+	if err := s.checkGroupAccess(ctx, group, ownerID); err != nil {
+		return models.Queue{}, err
+	}
+
 	q := models.Queue{
 		Title:       title,
 		Description: description,
 		Mode:        mode,
-		Status:      models.StatusActive,
+		Status:      status,
 		GroupCode:   group,
 		OwnerID:     ownerID,
+		OpensAt:     opensAt,
+		ClosesAt:    closesAt,
+		NotifyTop:   notifyTop,
+	}
+	if !validLotteryTime(lotteryAt, opensAt, now) {
+		return models.Queue{}, ErrInvalidLottery
+	}
+	if err := armLottery(&q, lotteryAt); err != nil {
+		return models.Queue{}, err
 	}
 	return s.storage.CreateQueue(ctx, q)
 }
 
-func (s *Service) GetQueue(ctx context.Context, queueID int64, group string) (models.Queue, []models.Participant, error) {
+// GetQueue returns the queue if the user may see it. group is optional and
+// only narrows the lookup; access is decided by group membership.
+func (s *Service) GetQueue(ctx context.Context, queueID int64, userID int64, group string) (models.Queue, []models.Participant, error) {
 	q, parts, err := s.storage.GetQueue(ctx, queueID)
 	if err != nil {
 		return models.Queue{}, nil, err
 	}
-	if q.GroupCode != group {
-		return models.Queue{}, nil, ErrGroupMismatch
+	if err := s.checkAccess(ctx, q, userID, group); err != nil {
+		return models.Queue{}, nil, err
 	}
 	return q, parts, nil
 }
@@ -75,35 +147,25 @@ func (s *Service) JoinQueue(ctx context.Context, queueID, userID int64, fullName
 	if err != nil {
 		return 0, err
 	}
-	if queue.GroupCode != group {
-		return 0, ErrGroupMismatch
+	if err := s.checkAccess(ctx, queue, userID, group); err != nil {
+		return 0, err
 	}
-	if queue.Status != models.StatusActive {
-		return 0, ErrQueueInactive
+	if err := checkOpen(queue, time.Now()); err != nil {
+		return 0, err
 	}
 
 	var slotTimePtr *time.Time
 	if queue.Mode == models.ModeSlots {
-		if slotTimeStr == "" {
-			return 0, ErrSlotRequired
-		}
-		t, err := time.Parse(time.RFC3339, slotTimeStr)
-		if err != nil {
-			return 0, fmt.Errorf("invalid slot_time: %w", err)
+		if slotTimePtr, err = parseSlotTime(slotTimeStr, time.Now()); err != nil {
+			return 0, err
 		}
-		slotTimePtr = &t
 	}
 
-	position, err := s.storage.AddParticipant(ctx, queue, userID, fullName, slotTimePtr)
+	position, err := s.storage.AddParticipant(ctx, queue, userID, fullName, slotTimePtr, userID)
 	if err != nil {
 		return 0, err
 	}
-	if position <= 3 {
-		if err := s.notif.NotifyPositionSoon(ctx, userID, queue.Title, position); err != nil {
-			s.log.Warn("failed to send notification", slog.Any("err", err))
-		}
-	}
-	s.notifyTopPositions(ctx, queueID, queue.Title)
+	s.publish(ctx, queueID)
 	return position, nil
 }
 
@@ -112,38 +174,51 @@ func (s *Service) LeaveQueue(ctx context.Context, queueID, userID int64, group s
 	if err != nil {
 		return err
 	}
-	if queue.GroupCode != group {
-		return ErrGroupMismatch
+	if err := s.checkAccess(ctx, queue, userID, group); err != nil {
+		return err
 	}
 
-	if err := s.storage.RemoveParticipant(ctx, queue, userID); err != nil {
+	if err := s.storage.RemoveParticipant(ctx, queue, userID, userID); err != nil {
 		return err
 	}
-	s.notifyTopPositions(ctx, queueID, queue.Title)
+	s.publish(ctx, queueID)
 	return nil
 }
 
-func (s *Service) AdvanceQueue(ctx context.Context, queueID int64, actorID int64, group string) (models.Participant, error) {
+// AdvanceQueue takes the head of the queue out. With skip the participant is
+// recorded as skipped (did not show up) instead of served. In a queue with
+// calls the head is called first and taken out on the next advance, which
+// calls the participant after them. Returns the removed and the called
+// participants; either may be zero.
+func (s *Service) AdvanceQueue(ctx context.Context, queueID int64, actorID int64, group string, skip bool) (models.Participant, models.Participant, error) {
 	queue, _, err := s.storage.GetQueue(ctx, queueID)
 	if err != nil {
-		return models.Participant{}, err
+		return models.Participant{}, models.Participant{}, err
 	}
-	if queue.GroupCode != group {
-		return models.Participant{}, ErrGroupMismatch
+	if err := s.checkAccess(ctx, queue, actorID, group); err != nil {
+		return models.Participant{}, models.Participant{}, err
 	}
-	if queue.OwnerID != actorID {
-		return models.Participant{}, ErrForbidden
+	if err := s.authorize(ctx, queue, actorID, models.PermModerate); err != nil {
+		return models.Participant{}, models.Participant{}, err
 	}
-	if queue.Status != models.StatusActive {
-		return models.Participant{}, ErrQueueInactive
+	// A closed queue takes nobody new, but those already in it are still served.
+	if queue.Status != models.StatusActive && queue.Status != models.StatusClosed {
+		return models.Participant{}, models.Participant{}, ErrQueueInactive
+	}
+	if queue.AwaitsDraw() {
+		return models.Participant{}, models.Participant{}, ErrLotteryPending
 	}
 
-	removed, err := s.storage.Advance(ctx, queue)
+	outcome := models.OutcomeServed
+	if skip {
+		outcome = models.OutcomeSkipped
+	}
+	removed, called, err := s.storage.Advance(ctx, queue, actorID, outcome)
 	if err != nil {
-		return models.Participant{}, err
+		return models.Participant{}, models.Participant{}, err
 	}
-	s.notifyTopPositions(ctx, queueID, queue.Title)
-	return removed, nil
+	s.publish(ctx, queueID)
+	return removed, called, nil
 }
 
 func (s *Service) RemoveParticipant(ctx context.Context, queueID int64, userID int64, actorID int64, group string) error {
@@ -151,16 +226,16 @@ func (s *Service) RemoveParticipant(ctx context.Context, queueID int64, userID i
 	if err != nil {
 		return err
 	}
-	if queue.GroupCode != group {
-		return ErrGroupMismatch
+	if err := s.checkAccess(ctx, queue, actorID, group); err != nil {
+		return err
 	}
-	if queue.OwnerID != actorID {
-		return ErrForbidden
+	if err := s.authorize(ctx, queue, actorID, models.PermModerate); err != nil {
+		return err
 	}
-	if err := s.storage.RemoveParticipant(ctx, queue, userID); err != nil {
+	if err := s.storage.RemoveParticipant(ctx, queue, userID, actorID); err != nil {
 		return err
 	}
-	s.notifyTopPositions(ctx, queueID, queue.Title)
+	s.publish(ctx, queueID)
 	return nil
 }
 
@@ -169,13 +244,17 @@ func (s *Service) ArchiveQueue(ctx context.Context, queueID int64, actorID int64
 	if err != nil {
 		return err
 	}
-	if queue.GroupCode != group {
-		return ErrGroupMismatch
+	if err := s.checkAccess(ctx, queue, actorID, group); err != nil {
+		return err
 	}
-	if queue.OwnerID != actorID {
-		return ErrForbidden
+	if err := s.authorize(ctx, queue, actorID, models.PermEdit); err != nil {
+		return err
 	}
-	return s.storage.UpdateStatus(ctx, queueID, models.StatusArchived)
+	if err := s.storage.UpdateStatus(ctx, queueID, models.StatusArchived, actorID); err != nil {
+		return err
+	}
+	s.publish(ctx, queueID)
+	return nil
 }
 
 func (s *Service) DeleteQueue(ctx context.Context, queueID int64, actorID int64, group string) error {
@@ -183,27 +262,37 @@ func (s *Service) DeleteQueue(ctx context.Context, queueID int64, actorID int64,
 	if err != nil {
 		return err
 	}
-	if queue.GroupCode != group {
-		return ErrGroupMismatch
+	if err := s.checkAccess(ctx, queue, actorID, group); err != nil {
+		return err
 	}
-	if queue.OwnerID != actorID {
-		return ErrForbidden
+	if err := s.authorize(ctx, queue, actorID, models.PermDelete); err != nil {
+		return err
 	}
-	return s.storage.DeleteQueue(ctx, queueID)
+	if err := s.storage.DeleteQueue(ctx, queueID, actorID); err != nil {
+		return err
+	}
+	s.hub.publish(models.QueueSnapshot{Queue: queue, Deleted: true})
+	return nil
 }
 
-func (s *Service) UpdateQueue(ctx context.Context, queueID int64, actorID int64, group string, title string, description string) (models.Queue, error) {
+// UpdateQueue changes the queue; an empty title and zero notifyTop keep the current values.
+func (s *Service) UpdateQueue(ctx context.Context, queueID int64, actorID int64, group string, title string, description string, notifyTop int32) (models.Queue, error) {
 	queue, _, err := s.storage.GetQueue(ctx, queueID)
 	if err != nil {
 		return models.Queue{}, err
 	}
-	if queue.GroupCode != group {
-		return models.Queue{}, ErrGroupMismatch
+	if err := s.checkAccess(ctx, queue, actorID, group); err != nil {
+		return models.Queue{}, err
 	}
-	if queue.OwnerID != actorID {
-		return models.Queue{}, ErrForbidden
+	if err := s.authorize(ctx, queue, actorID, models.PermEdit); err != nil {
+		return models.Queue{}, err
 	}
-	return s.storage.UpdateQueue(ctx, queueID, title, description)
+	updated, err := s.storage.UpdateQueue(ctx, queueID, title, description, notifyTop, actorID)
+	if err != nil {
+		return models.Queue{}, err
+	}
+	s.publish(ctx, queueID)
+	return updated, nil
 }
 
 func (s *Service) AddParticipant(ctx context.Context, queueID int64, userID int64, fullName string, actorID int64, group string, slotTimeStr string) (int32, error) {
@@ -211,57 +300,224 @@ func (s *Service) AddParticipant(ctx context.Context, queueID int64, userID int6
 	if err != nil {
 		return 0, err
 	}
-	if queue.GroupCode != group {
-		return 0, ErrGroupMismatch
+	if err := s.checkAccess(ctx, queue, actorID, group); err != nil {
+		return 0, err
 	}
-	if queue.OwnerID != actorID {
-		return 0, ErrForbidden
+	if err := s.authorize(ctx, queue, actorID, models.PermModerate); err != nil {
+		return 0, err
 	}
 	if queue.Mode != models.ModeManaged {
 		return 0, ErrForbidden
 	}
-	if queue.Status != models.StatusActive {
-		return 0, ErrQueueInactive
+	if err := checkOpen(queue, time.Now()); err != nil {
+		return 0, err
 	}
 
 	var slotTimePtr *time.Time
 	if queue.Mode == models.ModeSlots {
-		if slotTimeStr == "" {
-			return 0, ErrSlotRequired
+		if slotTimePtr, err = parseSlotTime(slotTimeStr, time.Now()); err != nil {
+			return 0, err
 		}
-		t, err := time.Parse(time.RFC3339, slotTimeStr)
-		if err != nil {
-			return 0, fmt.Errorf("invalid slot_time: %w", err)
-		}
-		slotTimePtr = &t
 	}
 
-	position, err := s.storage.AddParticipant(ctx, queue, userID, fullName, slotTimePtr)
+	position, err := s.storage.AddParticipant(ctx, queue, userID, fullName, slotTimePtr, actorID)
 	if err != nil {
 		return 0, err
 	}
-	// Notify added user regardless of position (manual add requirement)
-	if err := s.notif.NotifyPositionSoon(ctx, userID, queue.Title, position); err != nil {
-		s.log.Warn("failed to send manual add notification", slog.Any("err", err))
-	}
-	s.notifyTopPositions(ctx, queueID, queue.Title)
+	s.publish(ctx, queueID)
 	return position, nil
 }
 
-// notifyTopPositions sends notifications to first three participants (if any).
-func (s *Service) notifyTopPositions(ctx context.Context, queueID int64, queueTitle string) {
-	_, participants, err := s.storage.GetQueue(ctx, queueID)
+// ListQueueEvents returns a page of the queue audit log, newest first, and the
+// cursor for the next page (zero when there are no more events).
+func (s *Service) ListQueueEvents(ctx context.Context, queueID int64, userID int64, group string, filter models.EventFilter) ([]models.QueueEvent, int64, error) {
+	if _, _, err := s.GetQueue(ctx, queueID, userID, group); err != nil {
+		return nil, 0, err
+	}
+
+	filter.Limit = pageLimit(filter.Limit)
+
+	events, err := s.storage.ListQueueEvents(ctx, queueID, filter)
 	if err != nil {
-		return
+		return nil, 0, err
+	}
+
+	var next int64
+	if len(events) == filter.Limit {
+		next = events[len(events)-1].ID
+	}
+	return events, next, nil
+}
+
+// GetQueueHistory returns a page of participants who left the queue, newest
+// first, and the cursor for the next page (zero when there are no more entries).
+func (s *Service) GetQueueHistory(ctx context.Context, queueID int64, userID int64, group string, filter models.HistoryFilter) ([]models.HistoryEntry, int64, error) {
+	if _, _, err := s.GetQueue(ctx, queueID, userID, group); err != nil {
+		return nil, 0, err
+	}
+
+	filter.Limit = pageLimit(filter.Limit)
+
+	entries, err := s.storage.ListHistory(ctx, queueID, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var next int64
+	if len(entries) == filter.Limit {
+		next = entries[len(entries)-1].ID
+	}
+	return entries, next, nil
+}
+
+// ListMembers returns users with a role in the queue.
+func (s *Service) ListMembers(ctx context.Context, queueID int64, userID int64, group string) ([]models.QueueMember, error) {
+	if _, _, err := s.GetQueue(ctx, queueID, userID, group); err != nil {
+		return nil, err
+	}
+	return s.storage.ListMembers(ctx, queueID)
+}
+
+// GrantRole gives the user a role in the queue, replacing any previous one.
+// Only owners (and admins) manage roles; the creator always stays an owner.
+func (s *Service) GrantRole(ctx context.Context, queueID int64, userID int64, role models.Role, actorID int64, group string) (models.QueueMember, error) {
+	if !role.Valid() {
+		return models.QueueMember{}, ErrInvalidRole
+	}
+	queue, _, err := s.GetQueue(ctx, queueID, actorID, group)
+	if err != nil {
+		return models.QueueMember{}, err
+	}
+	if err := s.authorize(ctx, queue, actorID, models.PermManageMembers); err != nil {
+		return models.QueueMember{}, err
+	}
+	if userID == queue.OwnerID && role != models.RoleOwner {
+		return models.QueueMember{}, ErrCreatorRole
+	}
+
+	return s.storage.GrantRole(ctx, models.QueueMember{
+		QueueID:   queueID,
+		UserID:    userID,
+		Role:      role,
+		GrantedBy: actorID,
+	})
+}
+
+func (s *Service) RevokeRole(ctx context.Context, queueID int64, userID int64, actorID int64, group string) error {
+	queue, _, err := s.GetQueue(ctx, queueID, actorID, group)
+	if err != nil {
+		return err
+	}
+	if err := s.authorize(ctx, queue, actorID, models.PermManageMembers); err != nil {
+		return err
+	}
+	if userID == queue.OwnerID {
+		return ErrCreatorRole
+	}
+	return s.storage.RevokeRole(ctx, queueID, userID, actorID)
+}
+
+// checkAccess tells whether the user may see the queue: active members of its
+// group, users with a role in the queue and global admins can. A non-empty
+// group from the request must match the queue's group.
+func (s *Service) checkAccess(ctx context.Context, queue models.Queue, userID int64, group string) error {
+	if group != "" && queue.GroupCode != group {
+		return ErrGroupMismatch
+	}
+	if queue.OwnerID == userID {
+		return nil
+	}
+
+	_, err := s.storage.MemberRole(ctx, queue.ID, userID)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, storage.ErrMemberNotFound) {
+		return err
+	}
+
+	return s.checkGroupAccess(ctx, queue.GroupCode, userID)
+}
+
+// checkGroupAccess lets active group members and global admins through.
+func (s *Service) checkGroupAccess(ctx context.Context, group string, userID int64) error {
+	ok, err := s.storage.IsGroupMember(ctx, group, userID)
+	if err != nil {
+		return err
 	}
-	limit := 3
-	if len(participants) < limit {
-		limit = len(participants)
+	if ok || s.isAdmin(ctx, userID) {
+		return nil
 	}
-	for i := 0; i < limit; i++ {
-		p := participants[i]
-		if err := s.notif.NotifyPositionSoon(ctx, p.UserID, queueTitle, p.Position); err != nil {
-			s.log.Warn("failed to send notification", slog.Any("err", err))
+	return ErrGroupMismatch
+}
+
+// authorize checks that the actor may perform perm on the queue: through
+// their role in it, or as a global admin, who can do anything an owner can.
+func (s *Service) authorize(ctx context.Context, queue models.Queue, actorID int64, perm models.Permission) error {
+	if queue.OwnerID == actorID {
+		return nil
+	}
+
+	role, err := s.storage.MemberRole(ctx, queue.ID, actorID)
+	switch {
+	case err == nil:
+		if role.Can(perm) {
+			return nil
 		}
+	case !errors.Is(err, storage.ErrMemberNotFound):
+		return err
+	}
+
+	if !s.isAdmin(ctx, actorID) {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// isAdmin asks the auth service; when it is unreachable the user is treated as a regular one.
+func (s *Service) isAdmin(ctx context.Context, userID int64) bool {
+	isAdmin, err := s.admins.IsAdmin(ctx, userID)
+	if err != nil {
+		s.log.Warn("failed to check admin", slog.Int64("user_id", userID), slog.Any("err", err))
+		return false
+	}
+	return isAdmin
+}
+
+func pageLimit(limit int) int {
+	if limit <= 0 {
+		return defaultPageLimit
+	}
+	if limit > maxPageLimit {
+		return maxPageLimit
+	}
+	return limit
+}
+
+// WatchQueue subscribes to snapshots of the queue. The current state is
+// delivered first; the returned cancel func must be called to unsubscribe.
+func (s *Service) WatchQueue(ctx context.Context, queueID int64, userID int64, group string) (<-chan models.QueueSnapshot, func(), error) {
+	updates, cancel := s.hub.subscribe(queueID)
+
+	q, parts, err := s.GetQueue(ctx, queueID, userID, group)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+	s.hub.offer(updates, models.QueueSnapshot{Queue: q, Participants: parts})
+
+	return updates, cancel, nil
+}
+
+// publish pushes the current queue state to watchers, if there are any.
+func (s *Service) publish(ctx context.Context, queueID int64) {
+	if !s.hub.watched(queueID) {
+		return
+	}
+	q, parts, err := s.storage.GetQueue(ctx, queueID)
+	if err != nil {
+		s.log.Warn("failed to load queue snapshot", slog.Int64("queue_id", queueID), slog.Any("err", err))
+		return
 	}
+	s.hub.publish(models.QueueSnapshot{Queue: q, Participants: parts})
 }
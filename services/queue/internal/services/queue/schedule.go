@@ -0,0 +1,84 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+)
+
+var (
+	ErrQueueNotOpen    = errors.New("queue is not open yet")
+	ErrQueueClosed     = errors.New("queue is closed")
+	ErrInvalidSchedule = errors.New("invalid queue schedule")
+)
+
+type ScheduleStorage interface {
+	OpenDueQueues(ctx context.Context, now time.Time) ([]models.Queue, error)
+	CloseDueQueues(ctx context.Context, now time.Time) ([]models.Queue, error)
+}
+
+// ApplySchedule opens and closes queues whose time has come and pushes the new
+// state to watchers.
+func (s *Service) ApplySchedule(ctx context.Context) error {
+	now := time.Now()
+
+	opened, err := s.storage.OpenDueQueues(ctx, now)
+	if err != nil {
+		return err
+	}
+	for _, q := range opened {
+		s.log.Info("queue opened", slog.Int64("queue_id", q.ID))
+		s.publish(ctx, q.ID)
+	}
+
+	closed, err := s.storage.CloseDueQueues(ctx, now)
+	if err != nil {
+		return err
+	}
+	for _, q := range closed {
+		s.log.Info("queue closed", slog.Int64("queue_id", q.ID))
+		s.publish(ctx, q.ID)
+	}
+	return nil
+}
+
+// newSchedule validates the open/close window of a new queue and picks its
+// initial status: scheduled when it opens in the future, active otherwise.
+func newSchedule(opensAt, closesAt *time.Time, now time.Time) (models.QueueStatus, error) {
+	if closesAt != nil {
+		if !closesAt.After(now) {
+			return "", ErrInvalidSchedule
+		}
+		if opensAt != nil && !closesAt.After(*opensAt) {
+			return "", ErrInvalidSchedule
+		}
+	}
+	if opensAt != nil && opensAt.After(now) {
+		return models.StatusScheduled, nil
+	}
+	return models.StatusActive, nil
+}
+
+// checkOpen tells whether participants may be added to the queue now. The
+// times are checked as well as the status, so a lagging scheduler neither
+// lets anyone in early nor keeps the queue open too long.
+func checkOpen(queue models.Queue, now time.Time) error {
+	switch queue.Status {
+	case models.StatusScheduled:
+		if queue.OpensAt == nil || now.Before(*queue.OpensAt) {
+			return ErrQueueNotOpen
+		}
+	case models.StatusClosed:
+		return ErrQueueClosed
+	case models.StatusActive:
+	default:
+		return ErrQueueInactive
+	}
+	if queue.ClosesAt != nil && !now.Before(*queue.ClosesAt) {
+		return ErrQueueClosed
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+)
+
+var (
+	ErrNotSlotsMode        = errors.New("queue is not in slots mode")
+	ErrInvalidSlotSchedule = errors.New("invalid slot schedule")
+	ErrSlotPassed          = errors.New("slot has already started")
+)
+
+// maxSlots bounds a single schedule so that listing slots stays cheap.
+const maxSlots = 1000
+
+type SlotStorage interface {
+	SetSlotSchedule(ctx context.Context, schedule models.SlotSchedule, actorID int64) (models.SlotSchedule, error)
+	SlotSchedule(ctx context.Context, queueID int64) (models.SlotSchedule, error)
+	SlotOccupancy(ctx context.Context, queueID int64) (map[time.Time]int32, error)
+}
+
+// SetSlotSchedule defines the slots of a queue in slots mode, replacing the previous schedule.
+func (s *Service) SetSlotSchedule(ctx context.Context, queueID int64, actorID int64, group string, schedule models.SlotSchedule) (models.SlotSchedule, error) {
+	queue, _, err := s.GetQueue(ctx, queueID, actorID, group)
+	if err != nil {
+		return models.SlotSchedule{}, err
+	}
+	if err := s.authorize(ctx, queue, actorID, models.PermEdit); err != nil {
+		return models.SlotSchedule{}, err
+	}
+	if queue.Mode != models.ModeSlots {
+		return models.SlotSchedule{}, ErrNotSlotsMode
+	}
+	if err := validateSlotSchedule(schedule); err != nil {
+		return models.SlotSchedule{}, err
+	}
+
+	schedule.QueueID = queueID
+	return s.storage.SetSlotSchedule(ctx, schedule, actorID)
+}
+
+// ListFreeSlots returns the queue schedule and its upcoming slots that still have room.
+func (s *Service) ListFreeSlots(ctx context.Context, queueID int64, userID int64, group string) (models.SlotSchedule, []models.Slot, error) {
+	queue, _, err := s.GetQueue(ctx, queueID, userID, group)
+	if err != nil {
+		return models.SlotSchedule{}, nil, err
+	}
+	if queue.Mode != models.ModeSlots {
+		return models.SlotSchedule{}, nil, ErrNotSlotsMode
+	}
+
+	schedule, err := s.storage.SlotSchedule(ctx, queueID)
+	if err != nil {
+		return models.SlotSchedule{}, nil, err
+	}
+	taken, err := s.storage.SlotOccupancy(ctx, queueID)
+	if err != nil {
+		return models.SlotSchedule{}, nil, err
+	}
+
+	now := time.Now()
+	var free []models.Slot
+	for _, slot := range schedule.Slots() {
+		if !slot.StartsAt.After(now) {
+			continue
+		}
+		slot.Taken = taken[slot.StartsAt.UTC()]
+		if slot.Taken < slot.Capacity {
+			free = append(free, slot)
+		}
+	}
+	return schedule, free, nil
+}
+
+func validateSlotSchedule(schedule models.SlotSchedule) error {
+	if !schedule.EndsAt.After(schedule.StartsAt) || schedule.SlotDuration < time.Minute || schedule.Capacity <= 0 {
+		return ErrInvalidSlotSchedule
+	}
+	// Slots are stored in whole minutes.
+	if schedule.SlotDuration%time.Minute != 0 {
+		return ErrInvalidSlotSchedule
+	}
+	if schedule.EndsAt.Sub(schedule.StartsAt)/schedule.SlotDuration > maxSlots {
+		return ErrInvalidSlotSchedule
+	}
+	for _, b := range schedule.Breaks {
+		if !b.EndsAt.After(b.StartsAt) {
+			return ErrInvalidSlotSchedule
+		}
+	}
+	return nil
+}
+
+// parseSlotTime reads the slot a participant asks for; it must not have started yet.
+func parseSlotTime(value string, now time.Time) (*time.Time, error) {
+	if value == "" {
+		return nil, ErrSlotRequired
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid slot_time: %w", err)
+	}
+	if !t.After(now) {
+		return nil, ErrSlotPassed
+	}
+	return &t, nil
+}
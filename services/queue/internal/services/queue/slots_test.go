@@ -0,0 +1,57 @@
+package queue
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+)
+
+func TestValidateSlotSchedule(t *testing.T) {
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	valid := models.SlotSchedule{
+		StartsAt:     start,
+		EndsAt:       start.Add(3 * time.Hour),
+		SlotDuration: 15 * time.Minute,
+		Capacity:     2,
+		Breaks:       []models.SlotBreak{{StartsAt: start.Add(time.Hour), EndsAt: start.Add(90 * time.Minute)}},
+	}
+
+	tests := []struct {
+		name   string
+		change func(s *models.SlotSchedule)
+		valid  bool
+	}{
+		{"valid", func(s *models.SlotSchedule) {}, true},
+		{"no breaks", func(s *models.SlotSchedule) { s.Breaks = nil }, true},
+		{"ends at start", func(s *models.SlotSchedule) { s.EndsAt = s.StartsAt }, false},
+		{"ends before start", func(s *models.SlotSchedule) { s.EndsAt = s.StartsAt.Add(-time.Hour) }, false},
+		{"slot under a minute", func(s *models.SlotSchedule) { s.SlotDuration = 30 * time.Second }, false},
+		{"slot not whole minutes", func(s *models.SlotSchedule) { s.SlotDuration = 90 * time.Second }, false},
+		{"slot of whole minutes", func(s *models.SlotSchedule) { s.SlotDuration = 7 * time.Minute }, true},
+		{"no capacity", func(s *models.SlotSchedule) { s.Capacity = 0 }, false},
+		{"negative capacity", func(s *models.SlotSchedule) { s.Capacity = -1 }, false},
+		{"max slots", func(s *models.SlotSchedule) { s.EndsAt = s.StartsAt.Add(maxSlots * time.Minute); s.SlotDuration = time.Minute }, true},
+		{"too many slots", func(s *models.SlotSchedule) {
+			s.EndsAt = s.StartsAt.Add((maxSlots + 1) * time.Minute)
+			s.SlotDuration = time.Minute
+		}, false},
+		{"empty break", func(s *models.SlotSchedule) { s.Breaks[0].EndsAt = s.Breaks[0].StartsAt }, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := valid
+			s.Breaks = append([]models.SlotBreak(nil), valid.Breaks...)
+			tt.change(&s)
+
+			err := validateSlotSchedule(s)
+			if tt.valid && err != nil {
+				t.Fatalf("validateSlotSchedule = %v, want nil", err)
+			}
+			if !tt.valid && !errors.Is(err, ErrInvalidSlotSchedule) {
+				t.Fatalf("validateSlotSchedule = %v, want %v", err, ErrInvalidSlotSchedule)
+			}
+		})
+	}
+}
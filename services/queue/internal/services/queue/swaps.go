@@ -0,0 +1,146 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/storage"
+)
+
+var (
+	ErrSwapsForbidden = errors.New("swaps are forbidden in this queue")
+	ErrSwapWithSelf   = errors.New("cannot swap with yourself")
+)
+
+// swapRequestTTL is how long the other participant has to answer a swap request.
+const swapRequestTTL = 10 * time.Minute
+
+type SwapStorage interface {
+	SetSwapsAllowed(ctx context.Context, queueID int64, allowed bool, actorID int64) (models.Queue, error)
+	CreateSwapRequest(ctx context.Context, queue models.Queue, fromUserID, toUserID int64, expiresAt time.Time) (models.SwapRequest, error)
+	GetSwapRequest(ctx context.Context, id int64) (models.SwapRequest, error)
+	AnswerSwapRequest(ctx context.Context, queue models.Queue, id int64, accept bool, now time.Time) (models.SwapRequest, []models.Participant, error)
+	CancelSwapRequest(ctx context.Context, id int64) (models.SwapRequest, error)
+	ListSwapRequests(ctx context.Context, queueID int64, userID int64, now time.Time) ([]models.SwapRequest, error)
+	ExpireSwapRequests(ctx context.Context, now time.Time) (int64, error)
+}
+
+// SetSwapsAllowed lets participants swap places by agreement, or forbids it.
+func (s *Service) SetSwapsAllowed(ctx context.Context, queueID int64, actorID int64, group string, allowed bool) (models.Queue, error) {
+	queue, _, err := s.storage.GetQueue(ctx, queueID)
+	if err != nil {
+		return models.Queue{}, err
+	}
+	if err := s.checkAccess(ctx, queue, actorID, group); err != nil {
+		return models.Queue{}, err
+	}
+	if err := s.authorize(ctx, queue, actorID, models.PermEdit); err != nil {
+		return models.Queue{}, err
+	}
+
+	q, err := s.storage.SetSwapsAllowed(ctx, queueID, allowed, actorID)
+	if err != nil {
+		return models.Queue{}, err
+	}
+	s.publish(ctx, queueID)
+	return q, nil
+}
+
+// RequestSwap asks another participant to exchange places with the user. The
+// request expires if it is not answered in time.
+func (s *Service) RequestSwap(ctx context.Context, queueID int64, userID, targetUserID int64, group string) (models.SwapRequest, error) {
+	if userID == targetUserID {
+		return models.SwapRequest{}, ErrSwapWithSelf
+	}
+	queue, err := s.swappable(ctx, queueID, userID, group)
+	if err != nil {
+		return models.SwapRequest{}, err
+	}
+	return s.storage.CreateSwapRequest(ctx, queue, userID, targetUserID, time.Now().Add(swapRequestTTL))
+}
+
+// AnswerSwap accepts or declines a request sent to the user. Accepting swaps
+// the places at once and returns the new order.
+func (s *Service) AnswerSwap(ctx context.Context, requestID int64, userID int64, group string, accept bool) (models.SwapRequest, []models.Participant, error) {
+	r, err := s.storage.GetSwapRequest(ctx, requestID)
+	if err != nil {
+		return models.SwapRequest{}, nil, err
+	}
+	// Requests of other users are not revealed.
+	if r.ToUserID != userID {
+		return models.SwapRequest{}, nil, storage.ErrSwapNotFound
+	}
+	queue, err := s.swappable(ctx, r.QueueID, userID, group)
+	if err != nil {
+		return models.SwapRequest{}, nil, err
+	}
+
+	r, list, err := s.storage.AnswerSwapRequest(ctx, queue, requestID, accept, time.Now())
+	if err != nil {
+		return models.SwapRequest{}, nil, err
+	}
+	if accept {
+		s.publish(ctx, queue.ID)
+	}
+	return r, list, nil
+}
+
+// CancelSwap withdraws a request the user sent.
+func (s *Service) CancelSwap(ctx context.Context, requestID int64, userID int64) (models.SwapRequest, error) {
+	r, err := s.storage.GetSwapRequest(ctx, requestID)
+	if err != nil {
+		return models.SwapRequest{}, err
+	}
+	if r.FromUserID != userID {
+		return models.SwapRequest{}, storage.ErrSwapNotFound
+	}
+	return s.storage.CancelSwapRequest(ctx, requestID)
+}
+
+// ListSwapRequests returns the pending requests the user sent or received in the queue.
+func (s *Service) ListSwapRequests(ctx context.Context, queueID int64, userID int64, group string) ([]models.SwapRequest, error) {
+	queue, _, err := s.storage.GetQueue(ctx, queueID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkAccess(ctx, queue, userID, group); err != nil {
+		return nil, err
+	}
+	return s.storage.ListSwapRequests(ctx, queueID, userID, time.Now())
+}
+
+// ExpireSwapRequests closes the requests nobody answered in time.
+func (s *Service) ExpireSwapRequests(ctx context.Context) error {
+	n, err := s.storage.ExpireSwapRequests(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		s.log.Info("swap requests expired", slog.Int64("count", n))
+	}
+	return nil
+}
+
+// swappable loads the queue and checks that its participants may swap places.
+func (s *Service) swappable(ctx context.Context, queueID int64, userID int64, group string) (models.Queue, error) {
+	queue, _, err := s.storage.GetQueue(ctx, queueID)
+	if err != nil {
+		return models.Queue{}, err
+	}
+	if err := s.checkAccess(ctx, queue, userID, group); err != nil {
+		return models.Queue{}, err
+	}
+	if queue.Status != models.StatusActive && queue.Status != models.StatusClosed {
+		return models.Queue{}, ErrQueueInactive
+	}
+	if fixedOrder(queue) {
+		return models.Queue{}, ErrFixedOrder
+	}
+	if !queue.SwapsAllowed {
+		return models.Queue{}, ErrSwapsForbidden
+	}
+	return queue, nil
+}
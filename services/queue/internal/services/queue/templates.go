@@ -0,0 +1,239 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+)
+
+var ErrInvalidTemplate = errors.New("invalid queue template")
+
+type TemplateStorage interface {
+	CreateTemplate(ctx context.Context, t models.QueueTemplate) (models.QueueTemplate, error)
+	GetTemplate(ctx context.Context, templateID int64) (models.QueueTemplate, error)
+	ListTemplates(ctx context.Context, group string, ownerID int64) ([]models.QueueTemplate, error)
+	UpdateTemplate(ctx context.Context, t models.QueueTemplate) (models.QueueTemplate, error)
+	DeleteTemplate(ctx context.Context, templateID int64) error
+	DueTemplates(ctx context.Context, now time.Time) ([]models.QueueTemplate, error)
+	InstantiateTemplate(ctx context.Context, t models.QueueTemplate, q models.Queue, slots *models.SlotSchedule, nextRunAt time.Time) (models.Queue, error)
+	SkipTemplateRun(ctx context.Context, t models.QueueTemplate, nextRunAt time.Time) error
+}
+
+// CreateTemplate stores a recurring queue template in a group the owner belongs to.
+func (s *Service) CreateTemplate(ctx context.Context, t models.QueueTemplate) (models.QueueTemplate, error) {
+	if err := s.prepareTemplate(ctx, &t, t.OwnerID); err != nil {
+		return models.QueueTemplate{}, err
+	}
+	return s.storage.CreateTemplate(ctx, t)
+}
+
+// GetTemplate returns the template if the user may see its group.
+func (s *Service) GetTemplate(ctx context.Context, templateID int64, userID int64) (models.QueueTemplate, error) {
+	t, err := s.storage.GetTemplate(ctx, templateID)
+	if err != nil {
+		return models.QueueTemplate{}, err
+	}
+	if t.OwnerID != userID {
+		if err := s.checkGroupAccess(ctx, t.GroupCode, userID); err != nil {
+			return models.QueueTemplate{}, err
+		}
+	}
+	return t, nil
+}
+
+// ListTemplates returns templates of the group, or the user's own templates when group is empty.
+func (s *Service) ListTemplates(ctx context.Context, group string, userID int64) ([]models.QueueTemplate, error) {
+	if group != "" {
+		if err := s.checkGroupAccess(ctx, group, userID); err != nil {
+			return nil, err
+		}
+	}
+	return s.storage.ListTemplates(ctx, group, userID)
+}
+
+// UpdateTemplate replaces the template settings; only its owner and admins may.
+// The next run is recalculated from the new rule.
+func (s *Service) UpdateTemplate(ctx context.Context, t models.QueueTemplate, actorID int64) (models.QueueTemplate, error) {
+	current, err := s.editableTemplate(ctx, t.ID, actorID)
+	if err != nil {
+		return models.QueueTemplate{}, err
+	}
+	t.OwnerID = current.OwnerID
+	if err := s.prepareTemplate(ctx, &t, actorID); err != nil {
+		return models.QueueTemplate{}, err
+	}
+	return s.storage.UpdateTemplate(ctx, t)
+}
+
+func (s *Service) DeleteTemplate(ctx context.Context, templateID int64, actorID int64) error {
+	if _, err := s.editableTemplate(ctx, templateID, actorID); err != nil {
+		return err
+	}
+	return s.storage.DeleteTemplate(ctx, templateID)
+}
+
+// InstantiateTemplates publishes the next queue of every template that is due.
+// Runs missed while the service was down are not made up: a template yields
+// at most one queue per pass and then moves on to its next future run.
+func (s *Service) InstantiateTemplates(ctx context.Context) error {
+	now := time.Now()
+
+	templates, err := s.storage.DueTemplates(ctx, now)
+	if err != nil {
+		return err
+	}
+	for _, t := range templates {
+		if err := s.instantiate(ctx, t, now); err != nil {
+			s.log.Warn("failed to instantiate queue template", slog.Int64("template_id", t.ID), slog.Any("err", err))
+		}
+	}
+	return nil
+}
+
+func (s *Service) instantiate(ctx context.Context, t models.QueueTemplate, now time.Time) error {
+	opensAt := t.NextRunAt
+	next, err := t.Recurrence.Next(later(opensAt, now))
+	if err != nil {
+		return err
+	}
+
+	var closesAt *time.Time
+	if t.Duration > 0 {
+		c := opensAt.Add(t.Duration)
+		if !c.After(now) {
+			return s.storage.SkipTemplateRun(ctx, t, next)
+		}
+		closesAt = &c
+	}
+
+	status := models.StatusActive
+	if opensAt.After(now) {
+		status = models.StatusScheduled
+	}
+	q := models.Queue{
+		Title:       t.Title,
+		Description: t.Description,
+		Mode:        t.Mode,
+		Status:      status,
+		GroupCode:   t.GroupCode,
+		OwnerID:     t.OwnerID,
+		OpensAt:     &opensAt,
+		ClosesAt:    closesAt,
+	}
+	// A lottery time already past, when publishing catches up, draws on the
+	// next scheduler tick.
+	var lotteryAt *time.Time
+	if t.LotteryAfter > 0 {
+		l := opensAt.Add(t.LotteryAfter)
+		lotteryAt = &l
+	}
+	if err := armLottery(&q, lotteryAt); err != nil {
+		return err
+	}
+
+	var slots *models.SlotSchedule
+	if t.Mode == models.ModeSlots && closesAt != nil {
+		slots = &models.SlotSchedule{
+			StartsAt:     opensAt,
+			EndsAt:       *closesAt,
+			SlotDuration: t.SlotDuration,
+			Capacity:     t.SlotCapacity,
+		}
+	}
+
+	created, err := s.storage.InstantiateTemplate(ctx, t, q, slots, next)
+	if err != nil {
+		return err
+	}
+	s.log.Info("queue created from template", slog.Int64("template_id", t.ID), slog.Int64("queue_id", created.ID))
+
+	if t.AutoArchive && t.LastQueueID != 0 {
+		s.publish(ctx, t.LastQueueID)
+	}
+	return nil
+}
+
+// prepareTemplate validates the template, checks that the actor may use its
+// group and sets the first run.
+func (s *Service) prepareTemplate(ctx context.Context, t *models.QueueTemplate, actorID int64) error {
+	if t.Recurrence.Timezone == "" {
+		t.Recurrence.Timezone = "UTC"
+	}
+	if err := validateTemplate(*t); err != nil {
+		return err
+	}
+	if _, err := s.storage.GroupByCode(ctx, t.GroupCode); err != nil {
+		return err
+	}
+	if err := s.checkGroupAccess(ctx, t.GroupCode, actorID); err != nil {
+		return err
+	}
+
+	next, err := t.Recurrence.Next(time.Now())
+	if err != nil {
+		return ErrInvalidTemplate
+	}
+	t.NextRunAt = next
+	return nil
+}
+
+func (s *Service) editableTemplate(ctx context.Context, templateID int64, actorID int64) (models.QueueTemplate, error) {
+	t, err := s.storage.GetTemplate(ctx, templateID)
+	if err != nil {
+		return models.QueueTemplate{}, err
+	}
+	if t.OwnerID != actorID && !s.isAdmin(ctx, actorID) {
+		return models.QueueTemplate{}, ErrForbidden
+	}
+	return t, nil
+}
+
+func validateTemplate(t models.QueueTemplate) error {
+	r := t.Recurrence
+	if t.Title == "" || len(r.Weekdays) == 0 || r.StartTime < 0 || r.StartTime >= 24*time.Hour {
+		return ErrInvalidTemplate
+	}
+	for _, d := range r.Weekdays {
+		if d < time.Sunday || d > time.Saturday {
+			return ErrInvalidTemplate
+		}
+	}
+	if _, err := time.LoadLocation(r.Timezone); err != nil {
+		return ErrInvalidTemplate
+	}
+	if t.PublishBefore < 0 || t.Duration < 0 {
+		return ErrInvalidTemplate
+	}
+	// Slots are laid over the open window, so a slots template needs one.
+	if t.Mode == models.ModeSlots {
+		if t.Duration == 0 || t.SlotDuration < time.Minute || t.SlotDuration%time.Minute != 0 || t.SlotCapacity <= 0 {
+			return ErrInvalidTemplate
+		}
+		if t.Duration/t.SlotDuration > maxSlots {
+			return ErrInvalidTemplate
+		}
+	}
+	// A random template must say when its queues draw: after LotteryAfter
+	// or, by default, at closing.
+	if t.Mode == models.ModeRandom {
+		if t.LotteryAfter < 0 || t.LotteryAfter%time.Minute != 0 {
+			return ErrInvalidTemplate
+		}
+		if t.Duration == 0 && t.LotteryAfter == 0 || t.Duration > 0 && t.LotteryAfter > t.Duration {
+			return ErrInvalidTemplate
+		}
+	} else if t.LotteryAfter != 0 {
+		return ErrInvalidTemplate
+	}
+	return nil
+}
+
+func later(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
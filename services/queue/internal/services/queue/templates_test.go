@@ -0,0 +1,85 @@
+package queue
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+)
+
+func TestValidateTemplate(t *testing.T) {
+	base := models.QueueTemplate{
+		Title: "Lab",
+		Mode:  models.ModeLive,
+		Recurrence: models.Recurrence{
+			Weekdays:  []time.Weekday{time.Monday, time.Thursday},
+			StartTime: 10 * time.Hour,
+			Timezone:  "UTC",
+		},
+		PublishBefore: time.Hour,
+		Duration:      2 * time.Hour,
+	}
+	slots := func(t *models.QueueTemplate) {
+		t.Mode = models.ModeSlots
+		t.SlotDuration = 10 * time.Minute
+		t.SlotCapacity = 1
+	}
+	random := func(t *models.QueueTemplate) { t.Mode = models.ModeRandom }
+
+	tests := []struct {
+		name   string
+		change func(t *models.QueueTemplate)
+		valid  bool
+	}{
+		{"valid", func(t *models.QueueTemplate) {}, true},
+		{"open-ended", func(t *models.QueueTemplate) { t.Duration = 0 }, true},
+		{"no title", func(t *models.QueueTemplate) { t.Title = "" }, false},
+		{"no weekdays", func(t *models.QueueTemplate) { t.Recurrence.Weekdays = nil }, false},
+		{"bad weekday", func(t *models.QueueTemplate) { t.Recurrence.Weekdays = []time.Weekday{7} }, false},
+		{"start before midnight", func(t *models.QueueTemplate) { t.Recurrence.StartTime = -time.Minute }, false},
+		{"start at next midnight", func(t *models.QueueTemplate) { t.Recurrence.StartTime = 24 * time.Hour }, false},
+		{"unknown timezone", func(t *models.QueueTemplate) { t.Recurrence.Timezone = "Mars/Olympus" }, false},
+		{"negative publish before", func(t *models.QueueTemplate) { t.PublishBefore = -time.Minute }, false},
+		{"negative duration", func(t *models.QueueTemplate) { t.Duration = -time.Minute }, false},
+
+		{"slots", slots, true},
+		{"slots, open-ended", func(t *models.QueueTemplate) { slots(t); t.Duration = 0 }, false},
+		{"slots, slot under a minute", func(t *models.QueueTemplate) { slots(t); t.SlotDuration = 30 * time.Second }, false},
+		{"slots, slot not whole minutes", func(t *models.QueueTemplate) { slots(t); t.SlotDuration = 90 * time.Second }, false},
+		{"slots, no capacity", func(t *models.QueueTemplate) { slots(t); t.SlotCapacity = 0 }, false},
+		{"slots, too many slots", func(t *models.QueueTemplate) {
+			slots(t)
+			t.SlotDuration = time.Minute
+			t.Duration = (maxSlots + 1) * time.Minute
+		}, false},
+
+		{"random, draws at closing", random, true},
+		{"random, draws after opening", func(t *models.QueueTemplate) { random(t); t.LotteryAfter = 30 * time.Minute }, true},
+		{"random, draws at closing time", func(t *models.QueueTemplate) { random(t); t.LotteryAfter = t.Duration }, true},
+		{"random, draws after closing", func(t *models.QueueTemplate) { random(t); t.LotteryAfter = t.Duration + time.Minute }, false},
+		{"random, open-ended with a draw", func(t *models.QueueTemplate) {
+			random(t)
+			t.Duration = 0
+			t.LotteryAfter = time.Hour
+		}, true},
+		{"random, open-ended without a draw", func(t *models.QueueTemplate) { random(t); t.Duration = 0 }, false},
+		{"random, draw not whole minutes", func(t *models.QueueTemplate) { random(t); t.LotteryAfter = 90 * time.Second }, false},
+		{"random, negative draw", func(t *models.QueueTemplate) { random(t); t.LotteryAfter = -time.Minute }, false},
+		{"live with a draw", func(t *models.QueueTemplate) { t.LotteryAfter = time.Minute }, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := base
+			tt.change(&tmpl)
+
+			err := validateTemplate(tmpl)
+			if tt.valid && err != nil {
+				t.Fatalf("validateTemplate = %v, want nil", err)
+			}
+			if !tt.valid && !errors.Is(err, ErrInvalidTemplate) {
+				t.Fatalf("validateTemplate = %v, want %v", err, ErrInvalidTemplate)
+			}
+		})
+	}
+}
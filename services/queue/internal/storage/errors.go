@@ -7,4 +7,22 @@ var (
 	ErrParticipantExists  = errors.New("participant already in queue")
 	ErrParticipantMissing = errors.New("participant not found in queue")
 	ErrNotOwner           = errors.New("not a queue owner")
+	ErrMemberNotFound     = errors.New("queue member not found")
+	ErrGroupNotFound      = errors.New("group not found")
+	ErrGroupExists        = errors.New("group already exists")
+	ErrGroupMemberExists  = errors.New("already a group member")
+	ErrGroupMemberMissing = errors.New("group member not found")
+	ErrNoSlotSchedule     = errors.New("queue has no slot schedule")
+	ErrSlotUnavailable    = errors.New("slot is not in the schedule")
+	ErrSlotFull           = errors.New("slot is full")
+	ErrTemplateNotFound   = errors.New("queue template not found")
+	ErrNotCalled          = errors.New("participant is not called")
+	ErrInvalidPosition    = errors.New("position is out of the queue")
+	ErrOrderMismatch      = errors.New("order does not list every participant exactly once")
+	ErrSwapRequestExists  = errors.New("swap request already pending")
+	ErrSwapNotFound       = errors.New("swap request not found")
+	ErrSwapNotPending     = errors.New("swap request is no longer pending")
+	ErrDeferLimit         = errors.New("participant has deferred too many times")
+	ErrNobodyBehind       = errors.New("nobody stands behind the participant")
+	ErrAlreadyDrawn       = errors.New("queue order is already drawn")
 )
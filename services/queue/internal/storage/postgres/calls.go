@@ -0,0 +1,239 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/storage"
+)
+
+// SetCallPolicy changes how the queue calls participants. Turning calls off
+// drops the pending call, if any.
+func (s *Storage) SetCallPolicy(ctx context.Context, queueID int64, timeout time.Duration, missShift int32, actorID int64) (models.Queue, error) {
+	const query = `UPDATE queues SET call_timeout_seconds = $2, call_miss_shift = $3, updated_at = NOW()
+WHERE id = $1 RETURNING ` + queueColumns
+
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return models.Queue{}, fmt.Errorf("postgres: begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q, err := scanQueue(tx.QueryRow(ctx, query, queueID, int32(timeout/time.Second), missShift))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Queue{}, storage.ErrQueueNotFound
+		}
+		return models.Queue{}, fmt.Errorf("postgres: set call policy: %w", err)
+	}
+
+	if !q.CallsEnabled() {
+		if _, err := tx.Exec(ctx, `UPDATE queue_participants SET called_at = NULL, checked_in_at = NULL WHERE queue_id = $1`, queueID); err != nil {
+			return models.Queue{}, fmt.Errorf("postgres: reset calls: %w", err)
+		}
+	}
+
+	if err := appendEvent(ctx, tx, models.QueueEvent{QueueID: queueID, Type: models.EventUpdated, ActorID: actorID}); err != nil {
+		return models.Queue{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return models.Queue{}, fmt.Errorf("postgres: commit: %w", err)
+	}
+	return q, nil
+}
+
+// CheckIn records that the called participant is coming. Checking in twice is
+// not an error.
+func (s *Storage) CheckIn(ctx context.Context, queueID int64, userID int64) (models.Participant, error) {
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return models.Participant{}, fmt.Errorf("postgres: begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	p, err := scanParticipant(tx.QueryRow(ctx, `SELECT `+participantColumns+`
+FROM queue_participants WHERE queue_id = $1 AND user_id = $2 FOR UPDATE`, queueID, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Participant{}, storage.ErrParticipantMissing
+		}
+		return models.Participant{}, fmt.Errorf("postgres: get participant: %w", err)
+	}
+	if p.CalledAt == nil {
+		return models.Participant{}, storage.ErrNotCalled
+	}
+	if p.CheckedInAt != nil {
+		return p, nil
+	}
+
+	if err := tx.QueryRow(ctx, `UPDATE queue_participants SET checked_in_at = NOW() WHERE id = $1 RETURNING checked_in_at`, p.ID).
+		Scan(&p.CheckedInAt); err != nil {
+		return models.Participant{}, fmt.Errorf("postgres: check in: %w", err)
+	}
+	if err := appendEvent(ctx, tx, models.QueueEvent{QueueID: queueID, Type: models.EventCheckedIn, ActorID: userID, UserID: userID, Position: p.Position}); err != nil {
+		return models.Participant{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return models.Participant{}, fmt.Errorf("postgres: commit: %w", err)
+	}
+	return p, nil
+}
+
+// ExpireCalls handles the participants who did not check in before their
+// call timed out: each is moved back by the queue's miss shift, or skipped
+// when the shift is zero, in slots mode, or on the second missed call. The
+// next head is called right away. The scheduler is the actor, so events carry
+// a zero actor id. Returns the ids of the changed queues.
+func (s *Storage) ExpireCalls(ctx context.Context, now time.Time) ([]int64, error) {
+	const query = `SELECT p.id, p.queue_id FROM queue_participants p JOIN queues q ON q.id = p.queue_id
+WHERE q.call_timeout_seconds > 0 AND q.status IN ('active', 'closed') AND p.called_at IS NOT NULL AND p.checked_in_at IS NULL
+AND p.called_at + make_interval(secs => q.call_timeout_seconds) <= $1
+FOR UPDATE OF p SKIP LOCKED`
+
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("postgres: begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	rows, err := tx.Query(ctx, query, now)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: list expired calls: %w", err)
+	}
+	type expired struct{ participantID, queueID int64 }
+	var list []expired
+	for rows.Next() {
+		var e expired
+		if err := rows.Scan(&e.participantID, &e.queueID); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("postgres: scan expired call: %w", err)
+		}
+		list = append(list, e)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres: rows error: %w", err)
+	}
+
+	queueIDs := make([]int64, 0, len(list))
+	for _, e := range list {
+		q, err := scanQueue(tx.QueryRow(ctx, `SELECT `+queueColumns+` FROM queues WHERE id = $1`, e.queueID))
+		if err != nil {
+			return nil, fmt.Errorf("postgres: get queue: %w", err)
+		}
+		p, err := scanParticipant(tx.QueryRow(ctx, `SELECT `+participantColumns+` FROM queue_participants WHERE id = $1`, e.participantID))
+		if err != nil {
+			return nil, fmt.Errorf("postgres: get participant: %w", err)
+		}
+		if err := missCall(ctx, tx, q, p); err != nil {
+			return nil, err
+		}
+		queueIDs = append(queueIDs, q.ID)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("postgres: commit: %w", err)
+	}
+	return queueIDs, nil
+}
+
+func missCall(ctx context.Context, tx pgx.Tx, queue models.Queue, p models.Participant) error {
+	if queue.Mode == models.ModePriority {
+		if err := missPriorityCall(ctx, tx, queue, p); err != nil {
+			return err
+		}
+		if _, err := callHead(ctx, tx, queue, 0); err != nil {
+			return err
+		}
+		return enqueueTopPositions(ctx, tx, queue)
+	}
+
+	var count int32
+	if err := tx.QueryRow(ctx, `SELECT COUNT(*) FROM queue_participants WHERE queue_id = $1`, queue.ID).Scan(&count); err != nil {
+		return fmt.Errorf("postgres: count participants: %w", err)
+	}
+	target := min(p.Position+queue.CallMissShift, count)
+
+	if queue.Mode != models.ModeSlots && p.MissedCalls == 0 && target > p.Position {
+		if _, err := tx.Exec(ctx, `UPDATE queue_participants SET position = position - 1
+WHERE queue_id = $1 AND position > $2 AND position <= $3`, queue.ID, p.Position, target); err != nil {
+			return fmt.Errorf("postgres: shift positions: %w", err)
+		}
+		if _, err := tx.Exec(ctx, `UPDATE queue_participants SET position = $2, called_at = NULL, checked_in_at = NULL,
+missed_calls = missed_calls + 1 WHERE id = $1`, p.ID, target); err != nil {
+			return fmt.Errorf("postgres: move participant back: %w", err)
+		}
+		if err := appendEvent(ctx, tx, models.QueueEvent{QueueID: queue.ID, Type: models.EventCallMissed, UserID: p.UserID, Position: target}); err != nil {
+			return err
+		}
+	} else if err := takeOut(ctx, tx, queue, p, models.OutcomeSkipped, 0); err != nil {
+		return err
+	}
+
+	if _, err := callHead(ctx, tx, queue, 0); err != nil {
+		return err
+	}
+	return enqueueTopPositions(ctx, tx, queue)
+}
+
+// missPriorityCall handles a missed call in a priority queue. Its order comes
+// from models.PriorityOrder alone, so the participant is not moved back:
+// the call is withdrawn and they return to their place, behind anyone of a
+// higher level who joined meanwhile, to be called again. A second miss, or
+// a queue that skips on a miss, takes them out.
+func missPriorityCall(ctx context.Context, tx pgx.Tx, queue models.Queue, p models.Participant) error {
+	if p.MissedCalls > 0 || queue.CallMissShift == 0 {
+		return takeOut(ctx, tx, queue, p, models.OutcomeSkipped, 0)
+	}
+	if _, err := tx.Exec(ctx, `UPDATE queue_participants SET called_at = NULL, checked_in_at = NULL,
+missed_calls = missed_calls + 1 WHERE id = $1`, p.ID); err != nil {
+		return fmt.Errorf("postgres: withdraw call: %w", err)
+	}
+	order, err := sortByPriority(ctx, tx, queue.ID)
+	if err != nil {
+		return err
+	}
+	position := int32(slices.IndexFunc(order, func(o models.Participant) bool { return o.ID == p.ID }) + 1)
+	return appendEvent(ctx, tx, models.QueueEvent{QueueID: queue.ID, Type: models.EventCallMissed, UserID: p.UserID, Position: position})
+}
+
+// callHead calls the participant now at the head of the queue, if there is one.
+func callHead(ctx context.Context, tx pgx.Tx, queue models.Queue, actorID int64) (models.Participant, error) {
+	head, err := lockHead(ctx, tx, queue)
+	if errors.Is(err, storage.ErrParticipantMissing) {
+		return models.Participant{}, nil
+	}
+	if err != nil {
+		return models.Participant{}, err
+	}
+	return callParticipant(ctx, tx, queue, head, actorID)
+}
+
+// callParticipant marks the participant as called and asks them to check in.
+func callParticipant(ctx context.Context, tx pgx.Tx, queue models.Queue, p models.Participant, actorID int64) (models.Participant, error) {
+	if err := tx.QueryRow(ctx, `UPDATE queue_participants SET called_at = NOW(), checked_in_at = NULL WHERE id = $1 RETURNING called_at`, p.ID).
+		Scan(&p.CalledAt); err != nil {
+		return models.Participant{}, fmt.Errorf("postgres: call participant: %w", err)
+	}
+	p.CheckedInAt = nil
+
+	if err := appendEvent(ctx, tx, models.QueueEvent{QueueID: queue.ID, Type: models.EventCalled, ActorID: actorID, UserID: p.UserID, Position: p.Position}); err != nil {
+		return models.Participant{}, err
+	}
+	if err := enqueue(ctx, tx, models.OutboxParticipantCalled, models.ParticipantCalledPayload{
+		UserID:     p.UserID,
+		QueueID:    queue.ID,
+		QueueTitle: queue.Title,
+		Timeout:    int32(queue.CallTimeout / time.Second),
+	}); err != nil {
+		return models.Participant{}, err
+	}
+	return p, nil
+}
@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+)
+
+// appendEvent writes an audit record inside the transaction of the mutation it describes,
+// so the log never disagrees with the data.
+func appendEvent(ctx context.Context, tx pgx.Tx, ev models.QueueEvent) error {
+	const query = `INSERT INTO queue_events (queue_id, type, actor_id, user_id, position)
+VALUES ($1, $2, $3, NULLIF($4, 0), NULLIF($5, 0))`
+
+	if _, err := tx.Exec(ctx, query, ev.QueueID, ev.Type, ev.ActorID, ev.UserID, ev.Position); err != nil {
+		return fmt.Errorf("postgres: append event: %w", err)
+	}
+	return nil
+}
+
+func (s *Storage) ListQueueEvents(ctx context.Context, queueID int64, filter models.EventFilter) ([]models.QueueEvent, error) {
+	conds := []string{"queue_id = $1"}
+	args := []any{queueID}
+	addCond := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+
+	if filter.ActorID > 0 {
+		addCond("actor_id = $%d", filter.ActorID)
+	}
+	if filter.Type != "" {
+		addCond("type = $%d", filter.Type)
+	}
+	if !filter.Since.IsZero() {
+		addCond("created_at >= $%d", filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		addCond("created_at < $%d", filter.Until)
+	}
+	if filter.BeforeID > 0 {
+		addCond("id < $%d", filter.BeforeID)
+	}
+
+	args = append(args, filter.Limit)
+
+	query := fmt.Sprintf(`SELECT id, queue_id, type, actor_id, COALESCE(user_id, 0), COALESCE(position, 0), created_at
+FROM queue_events WHERE %s ORDER BY id DESC LIMIT $%d`, strings.Join(conds, " AND "), len(args))
+
+	rows, err := s.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: list events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []models.QueueEvent
+	for rows.Next() {
+		var ev models.QueueEvent
+		if err := rows.Scan(&ev.ID, &ev.QueueID, &ev.Type, &ev.ActorID, &ev.UserID, &ev.Position, &ev.CreatedAt); err != nil {
+			return nil, fmt.Errorf("postgres: scan event: %w", err)
+		}
+		events = append(events, ev)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres: events rows error: %w", err)
+	}
+
+	return events, nil
+}
+
+func statusEvent(status models.QueueStatus) models.EventType {
+	if status == models.StatusArchived {
+		return models.EventArchived
+	}
+	return models.EventUpdated
+}
@@ -0,0 +1,224 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/storage"
+)
+
+const groupColumns = `id, code, name, owner_id, invite_code, require_approval, created_at`
+
+func scanGroup(row pgx.Row) (models.Group, error) {
+	var g models.Group
+	err := row.Scan(&g.ID, &g.Code, &g.Name, &g.OwnerID, &g.InviteCode, &g.RequireApproval, &g.CreatedAt)
+	return g, err
+}
+
+// CreateGroup stores the group and makes its owner an active admin.
+func (s *Storage) CreateGroup(ctx context.Context, g models.Group, ownerName string) (models.Group, error) {
+	const query = `INSERT INTO groups (code, name, owner_id, invite_code, require_approval)
+VALUES ($1, $2, $3, $4, $5) RETURNING id, created_at`
+
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return models.Group{}, fmt.Errorf("postgres: begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := tx.QueryRow(ctx, query, g.Code, g.Name, g.OwnerID, g.InviteCode, g.RequireApproval).Scan(&g.ID, &g.CreatedAt); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return models.Group{}, storage.ErrGroupExists
+		}
+		return models.Group{}, fmt.Errorf("postgres: create group: %w", err)
+	}
+
+	owner := models.GroupMember{GroupID: g.ID, UserID: g.OwnerID, FullName: ownerName, Role: models.GroupRoleAdmin, Status: models.MemberActive}
+	if err := insertGroupMember(ctx, tx, owner); err != nil {
+		return models.Group{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return models.Group{}, fmt.Errorf("postgres: commit: %w", err)
+	}
+	return g, nil
+}
+
+func (s *Storage) GetGroup(ctx context.Context, groupID int64) (models.Group, error) {
+	g, err := scanGroup(s.pool.QueryRow(ctx, `SELECT `+groupColumns+` FROM groups WHERE id = $1`, groupID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Group{}, storage.ErrGroupNotFound
+		}
+		return models.Group{}, fmt.Errorf("postgres: get group: %w", err)
+	}
+	return g, nil
+}
+
+func (s *Storage) GroupByCode(ctx context.Context, code string) (models.Group, error) {
+	g, err := scanGroup(s.pool.QueryRow(ctx, `SELECT `+groupColumns+` FROM groups WHERE code = $1`, code))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Group{}, storage.ErrGroupNotFound
+		}
+		return models.Group{}, fmt.Errorf("postgres: get group by code: %w", err)
+	}
+	return g, nil
+}
+
+func (s *Storage) GroupByInviteCode(ctx context.Context, inviteCode string) (models.Group, error) {
+	g, err := scanGroup(s.pool.QueryRow(ctx, `SELECT `+groupColumns+` FROM groups WHERE invite_code = $1`, inviteCode))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Group{}, storage.ErrGroupNotFound
+		}
+		return models.Group{}, fmt.Errorf("postgres: get group by invite: %w", err)
+	}
+	return g, nil
+}
+
+// ListUserGroups returns groups the user is a member of, including pending requests.
+func (s *Storage) ListUserGroups(ctx context.Context, userID int64) ([]models.UserGroup, error) {
+	const query = `SELECT g.id, g.code, g.name, g.owner_id, g.invite_code, g.require_approval, g.created_at, m.role, m.status
+FROM groups g JOIN group_members m ON m.group_id = g.id
+WHERE m.user_id = $1 ORDER BY g.name`
+
+	rows, err := s.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: list user groups: %w", err)
+	}
+	defer rows.Close()
+
+	var groups []models.UserGroup
+	for rows.Next() {
+		var g models.UserGroup
+		if err := rows.Scan(&g.ID, &g.Code, &g.Name, &g.OwnerID, &g.InviteCode, &g.RequireApproval, &g.CreatedAt, &g.Role, &g.Status); err != nil {
+			return nil, fmt.Errorf("postgres: scan group: %w", err)
+		}
+		groups = append(groups, g)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres: groups rows error: %w", err)
+	}
+	return groups, nil
+}
+
+func (s *Storage) GroupMember(ctx context.Context, groupID int64, userID int64) (models.GroupMember, error) {
+	const query = `SELECT group_id, user_id, full_name, role, status, created_at
+FROM group_members WHERE group_id = $1 AND user_id = $2`
+
+	var m models.GroupMember
+	if err := s.pool.QueryRow(ctx, query, groupID, userID).
+		Scan(&m.GroupID, &m.UserID, &m.FullName, &m.Role, &m.Status, &m.CreatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.GroupMember{}, storage.ErrGroupMemberMissing
+		}
+		return models.GroupMember{}, fmt.Errorf("postgres: get group member: %w", err)
+	}
+	return m, nil
+}
+
+// IsGroupMember reports whether the user is an active member of the group with the given code.
+func (s *Storage) IsGroupMember(ctx context.Context, groupCode string, userID int64) (bool, error) {
+	const query = `SELECT EXISTS(SELECT 1 FROM group_members m JOIN groups g ON g.id = m.group_id
+WHERE g.code = $1 AND m.user_id = $2 AND m.status = 'active')`
+
+	var ok bool
+	if err := s.pool.QueryRow(ctx, query, groupCode, userID).Scan(&ok); err != nil {
+		return false, fmt.Errorf("postgres: check group member: %w", err)
+	}
+	return ok, nil
+}
+
+func insertGroupMember(ctx context.Context, tx pgx.Tx, m models.GroupMember) error {
+	const query = `INSERT INTO group_members (group_id, user_id, full_name, role, status) VALUES ($1, $2, $3, $4, $5)`
+
+	if _, err := tx.Exec(ctx, query, m.GroupID, m.UserID, m.FullName, m.Role, m.Status); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return storage.ErrGroupMemberExists
+		}
+		return fmt.Errorf("postgres: insert group member: %w", err)
+	}
+	return nil
+}
+
+func (s *Storage) AddGroupMember(ctx context.Context, m models.GroupMember) (models.GroupMember, error) {
+	const query = `INSERT INTO group_members (group_id, user_id, full_name, role, status) VALUES ($1, $2, $3, $4, $5)
+RETURNING created_at`
+
+	if err := s.pool.QueryRow(ctx, query, m.GroupID, m.UserID, m.FullName, m.Role, m.Status).Scan(&m.CreatedAt); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return models.GroupMember{}, storage.ErrGroupMemberExists
+		}
+		return models.GroupMember{}, fmt.Errorf("postgres: add group member: %w", err)
+	}
+	return m, nil
+}
+
+// ListGroupMembers returns members of the group; an empty status means any status.
+func (s *Storage) ListGroupMembers(ctx context.Context, groupID int64, status models.MemberStatus) ([]models.GroupMember, error) {
+	const query = `SELECT group_id, user_id, full_name, role, status, created_at
+FROM group_members WHERE group_id = $1 AND ($2 = '' OR status = $2) ORDER BY created_at ASC`
+
+	rows, err := s.pool.Query(ctx, query, groupID, string(status))
+	if err != nil {
+		return nil, fmt.Errorf("postgres: list group members: %w", err)
+	}
+	defer rows.Close()
+
+	var members []models.GroupMember
+	for rows.Next() {
+		var m models.GroupMember
+		if err := rows.Scan(&m.GroupID, &m.UserID, &m.FullName, &m.Role, &m.Status, &m.CreatedAt); err != nil {
+			return nil, fmt.Errorf("postgres: scan group member: %w", err)
+		}
+		members = append(members, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres: group members rows error: %w", err)
+	}
+	return members, nil
+}
+
+// ActivateGroupMember approves a pending join request.
+func (s *Storage) ActivateGroupMember(ctx context.Context, groupID int64, userID int64) error {
+	const query = `UPDATE group_members SET status = 'active' WHERE group_id = $1 AND user_id = $2 AND status = 'pending'`
+
+	cmd, err := s.pool.Exec(ctx, query, groupID, userID)
+	if err != nil {
+		return fmt.Errorf("postgres: activate group member: %w", err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return storage.ErrGroupMemberMissing
+	}
+	return nil
+}
+
+func (s *Storage) RemoveGroupMember(ctx context.Context, groupID int64, userID int64) error {
+	cmd, err := s.pool.Exec(ctx, `DELETE FROM group_members WHERE group_id = $1 AND user_id = $2`, groupID, userID)
+	if err != nil {
+		return fmt.Errorf("postgres: remove group member: %w", err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return storage.ErrGroupMemberMissing
+	}
+	return nil
+}
+
+func (s *Storage) UpdateInviteCode(ctx context.Context, groupID int64, inviteCode string) error {
+	cmd, err := s.pool.Exec(ctx, `UPDATE groups SET invite_code = $1 WHERE id = $2`, inviteCode, groupID)
+	if err != nil {
+		return fmt.Errorf("postgres: update invite code: %w", err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return storage.ErrGroupNotFound
+	}
+	return nil
+}
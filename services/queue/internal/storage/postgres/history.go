@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+)
+
+// archiveParticipant moves a participant that has just been deleted from the
+// queue into history, in the same transaction.
+func archiveParticipant(ctx context.Context, tx pgx.Tx, p models.Participant, outcome models.Outcome, actorID int64) error {
+	const query = `INSERT INTO queue_history (queue_id, user_id, full_name, position, slot_time, outcome, actor_id, joined_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	if _, err := tx.Exec(ctx, query, p.QueueID, p.UserID, p.FullName, p.Position, p.SlotTime, outcome, actorID, p.CreatedAt); err != nil {
+		return fmt.Errorf("postgres: archive participant: %w", err)
+	}
+	return nil
+}
+
+func (s *Storage) ListHistory(ctx context.Context, queueID int64, filter models.HistoryFilter) ([]models.HistoryEntry, error) {
+	conds := []string{"queue_id = $1"}
+	args := []any{queueID}
+	addCond := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+
+	if filter.UserID > 0 {
+		addCond("user_id = $%d", filter.UserID)
+	}
+	if filter.Outcome != "" {
+		addCond("outcome = $%d", filter.Outcome)
+	}
+	if filter.BeforeID > 0 {
+		addCond("id < $%d", filter.BeforeID)
+	}
+	args = append(args, filter.Limit)
+
+	query := fmt.Sprintf(`SELECT id, queue_id, user_id, full_name, position, slot_time, outcome, actor_id, joined_at, finished_at
+FROM queue_history WHERE %s ORDER BY id DESC LIMIT $%d`, strings.Join(conds, " AND "), len(args))
+
+	rows, err := s.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: list history: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []models.HistoryEntry
+	for rows.Next() {
+		var h models.HistoryEntry
+		if err := rows.Scan(&h.ID, &h.QueueID, &h.UserID, &h.FullName, &h.Position, &h.SlotTime, &h.Outcome, &h.ActorID, &h.JoinedAt, &h.FinishedAt); err != nil {
+			return nil, fmt.Errorf("postgres: scan history: %w", err)
+		}
+		entries = append(entries, h)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres: history rows error: %w", err)
+	}
+
+	return entries, nil
+}
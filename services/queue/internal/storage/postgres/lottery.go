@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/storage"
+)
+
+// SetLottery schedules the draw of a random queue; nil leaves it to the owner.
+func (s *Storage) SetLottery(ctx context.Context, queueID int64, lotteryAt *time.Time, actorID int64) (models.Queue, error) {
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return models.Queue{}, fmt.Errorf("postgres: begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q, err := lockQueue(ctx, tx, queueID)
+	if err != nil {
+		return models.Queue{}, err
+	}
+	if q.DrawnAt != nil {
+		return models.Queue{}, storage.ErrAlreadyDrawn
+	}
+
+	q, err = scanQueue(tx.QueryRow(ctx, `UPDATE queues SET lottery_at = $2, updated_at = NOW() WHERE id = $1 RETURNING `+queueColumns,
+		queueID, lotteryAt))
+	if err != nil {
+		return models.Queue{}, fmt.Errorf("postgres: set lottery: %w", err)
+	}
+	if err := appendEvent(ctx, tx, models.QueueEvent{QueueID: queueID, Type: models.EventUpdated, ActorID: actorID}); err != nil {
+		return models.Queue{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return models.Queue{}, fmt.Errorf("postgres: commit: %w", err)
+	}
+	return q, nil
+}
+
+// DrawLottery orders the participants of a random queue by models.DrawOrder
+// with the seed chosen at creation and freezes the order. Queues created
+// before seeds were committed to have none; they are drawn with fallback,
+// which is stored. It happens once per queue. Returns the queue and the
+// drawn order.
+func (s *Storage) DrawLottery(ctx context.Context, queueID int64, fallback string, actorID int64) (models.Queue, []models.Participant, error) {
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return models.Queue{}, nil, fmt.Errorf("postgres: begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q, err := lockQueue(ctx, tx, queueID)
+	if err != nil {
+		return models.Queue{}, nil, err
+	}
+	if q.DrawnAt != nil {
+		return models.Queue{}, nil, storage.ErrAlreadyDrawn
+	}
+
+	seed := q.LotterySeed
+	if seed == "" {
+		seed = fallback
+	}
+
+	q, err = scanQueue(tx.QueryRow(ctx, `UPDATE queues SET lottery_seed = $2, drawn_at = NOW(), updated_at = NOW()
+WHERE id = $1 RETURNING `+queueColumns, queueID, seed))
+	if err != nil {
+		return models.Queue{}, nil, fmt.Errorf("postgres: store lottery seed: %w", err)
+	}
+
+	list, err := lockParticipants(ctx, tx, queueID)
+	if err != nil {
+		return models.Queue{}, nil, err
+	}
+	order := models.DrawOrder(seed, list)
+	if err := writeOrder(ctx, tx, q, order, actorID); err != nil {
+		return models.Queue{}, nil, err
+	}
+	if err := appendEvent(ctx, tx, models.QueueEvent{QueueID: queueID, Type: models.EventDrawn, ActorID: actorID}); err != nil {
+		return models.Queue{}, nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return models.Queue{}, nil, fmt.Errorf("postgres: commit: %w", err)
+	}
+	return q, order, nil
+}
+
+// DueLotteries returns the ids of random queues whose draw time has come.
+func (s *Storage) DueLotteries(ctx context.Context, now time.Time) ([]int64, error) {
+	const query = `SELECT id FROM queues
+WHERE mode = 'random' AND drawn_at IS NULL AND lottery_at <= $1 AND status IN ('active', 'closed')`
+
+	rows, err := s.pool.Query(ctx, query, now)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: list due lotteries: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("postgres: scan due lottery: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres: rows error: %w", err)
+	}
+	return ids, nil
+}
+
+func lockQueue(ctx context.Context, tx pgx.Tx, queueID int64) (models.Queue, error) {
+	q, err := scanQueue(tx.QueryRow(ctx, `SELECT `+queueColumns+` FROM queues WHERE id = $1 FOR UPDATE`, queueID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Queue{}, storage.ErrQueueNotFound
+		}
+		return models.Queue{}, fmt.Errorf("postgres: lock queue: %w", err)
+	}
+	return q, nil
+}
@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/storage"
+)
+
+func upsertMember(ctx context.Context, tx pgx.Tx, m models.QueueMember) error {
+	const query = `INSERT INTO queue_members (queue_id, user_id, role, granted_by) VALUES ($1, $2, $3, $4)
+ON CONFLICT (queue_id, user_id) DO UPDATE SET role = EXCLUDED.role, granted_by = EXCLUDED.granted_by`
+
+	if _, err := tx.Exec(ctx, query, m.QueueID, m.UserID, m.Role, m.GrantedBy); err != nil {
+		return fmt.Errorf("postgres: upsert member: %w", err)
+	}
+	return nil
+}
+
+// MemberRole returns the role of the user in the queue or storage.ErrMemberNotFound.
+func (s *Storage) MemberRole(ctx context.Context, queueID int64, userID int64) (models.Role, error) {
+	const query = `SELECT role FROM queue_members WHERE queue_id = $1 AND user_id = $2`
+
+	var role models.Role
+	if err := s.pool.QueryRow(ctx, query, queueID, userID).Scan(&role); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", storage.ErrMemberNotFound
+		}
+		return "", fmt.Errorf("postgres: get member role: %w", err)
+	}
+	return role, nil
+}
+
+func (s *Storage) ListMembers(ctx context.Context, queueID int64) ([]models.QueueMember, error) {
+	const query = `SELECT queue_id, user_id, role, granted_by, created_at
+FROM queue_members WHERE queue_id = $1 ORDER BY created_at ASC`
+
+	rows, err := s.pool.Query(ctx, query, queueID)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: list members: %w", err)
+	}
+	defer rows.Close()
+
+	var members []models.QueueMember
+	for rows.Next() {
+		var m models.QueueMember
+		if err := rows.Scan(&m.QueueID, &m.UserID, &m.Role, &m.GrantedBy, &m.CreatedAt); err != nil {
+			return nil, fmt.Errorf("postgres: scan member: %w", err)
+		}
+		members = append(members, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres: members rows error: %w", err)
+	}
+	return members, nil
+}
+
+// GrantRole sets the role of the user in the queue, replacing the previous one.
+func (s *Storage) GrantRole(ctx context.Context, member models.QueueMember) (models.QueueMember, error) {
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return models.QueueMember{}, fmt.Errorf("postgres: begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := upsertMember(ctx, tx, member); err != nil {
+		return models.QueueMember{}, err
+	}
+	if err := tx.QueryRow(ctx, `SELECT created_at FROM queue_members WHERE queue_id = $1 AND user_id = $2`,
+		member.QueueID, member.UserID).Scan(&member.CreatedAt); err != nil {
+		return models.QueueMember{}, fmt.Errorf("postgres: get member: %w", err)
+	}
+
+	ev := models.QueueEvent{QueueID: member.QueueID, Type: models.EventRoleGranted, ActorID: member.GrantedBy, UserID: member.UserID}
+	if err := appendEvent(ctx, tx, ev); err != nil {
+		return models.QueueMember{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return models.QueueMember{}, fmt.Errorf("postgres: commit: %w", err)
+	}
+	return member, nil
+}
+
+func (s *Storage) RevokeRole(ctx context.Context, queueID int64, userID int64, actorID int64) error {
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("postgres: begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	cmd, err := tx.Exec(ctx, `DELETE FROM queue_members WHERE queue_id = $1 AND user_id = $2`, queueID, userID)
+	if err != nil {
+		return fmt.Errorf("postgres: revoke role: %w", err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return storage.ErrMemberNotFound
+	}
+
+	ev := models.QueueEvent{QueueID: queueID, Type: models.EventRoleRevoked, ActorID: actorID, UserID: userID}
+	if err := appendEvent(ctx, tx, ev); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("postgres: commit: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,271 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/storage"
+)
+
+// MoveParticipant puts the participant at the given position; everyone in
+// between shifts by one place. Returns the new order.
+func (s *Storage) MoveParticipant(ctx context.Context, queue models.Queue, userID int64, position int32, actorID int64) ([]models.Participant, error) {
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("postgres: begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	list, err := lockParticipants(ctx, tx, queue.ID)
+	if err != nil {
+		return nil, err
+	}
+	i := slices.IndexFunc(list, func(p models.Participant) bool { return p.UserID == userID })
+	if i < 0 {
+		return nil, storage.ErrParticipantMissing
+	}
+	if position < 1 || int(position) > len(list) {
+		return nil, storage.ErrInvalidPosition
+	}
+
+	p := list[i]
+	list = slices.Insert(slices.Delete(list, i, i+1), int(position)-1, p)
+	if err := writeOrder(ctx, tx, queue, list, actorID); err != nil {
+		return nil, err
+	}
+	if err := appendEvent(ctx, tx, models.QueueEvent{QueueID: queue.ID, Type: models.EventMoved, ActorID: actorID, UserID: userID, Position: position}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("postgres: commit: %w", err)
+	}
+	return list, nil
+}
+
+// SwapParticipants exchanges the places of two participants. Returns the new order.
+func (s *Storage) SwapParticipants(ctx context.Context, queue models.Queue, firstUserID, secondUserID int64, actorID int64) ([]models.Participant, error) {
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("postgres: begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	list, err := swapPlaces(ctx, tx, queue, firstUserID, secondUserID, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("postgres: commit: %w", err)
+	}
+	return list, nil
+}
+
+// swapPlaces exchanges the places of two participants and records a move
+// for each. Returns the new order.
+func swapPlaces(ctx context.Context, tx pgx.Tx, queue models.Queue, firstUserID, secondUserID int64, actorID int64) ([]models.Participant, error) {
+	list, err := lockParticipants(ctx, tx, queue.ID)
+	if err != nil {
+		return nil, err
+	}
+	i := slices.IndexFunc(list, func(p models.Participant) bool { return p.UserID == firstUserID })
+	j := slices.IndexFunc(list, func(p models.Participant) bool { return p.UserID == secondUserID })
+	if i < 0 || j < 0 {
+		return nil, storage.ErrParticipantMissing
+	}
+
+	list[i], list[j] = list[j], list[i]
+	if err := writeOrder(ctx, tx, queue, list, actorID); err != nil {
+		return nil, err
+	}
+	for _, k := range []int{i, j} {
+		ev := models.QueueEvent{QueueID: queue.ID, Type: models.EventMoved, ActorID: actorID, UserID: list[k].UserID, Position: int32(k + 1)}
+		if err := appendEvent(ctx, tx, ev); err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+// ReorderQueue sets the whole order at once. userIDs must list every
+// participant of the queue exactly once, head first. Returns the new order.
+func (s *Storage) ReorderQueue(ctx context.Context, queue models.Queue, userIDs []int64, actorID int64) ([]models.Participant, error) {
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("postgres: begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	list, err := lockParticipants(ctx, tx, queue.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(userIDs) != len(list) {
+		return nil, storage.ErrOrderMismatch
+	}
+	byUser := make(map[int64]models.Participant, len(list))
+	for _, p := range list {
+		byUser[p.UserID] = p
+	}
+	order := make([]models.Participant, 0, len(userIDs))
+	for _, id := range userIDs {
+		p, ok := byUser[id]
+		if !ok {
+			return nil, storage.ErrOrderMismatch
+		}
+		delete(byUser, id)
+		order = append(order, p)
+	}
+
+	if err := writeOrder(ctx, tx, queue, order, actorID); err != nil {
+		return nil, err
+	}
+	if err := appendEvent(ctx, tx, models.QueueEvent{QueueID: queue.ID, Type: models.EventReordered, ActorID: actorID}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("postgres: commit: %w", err)
+	}
+	return order, nil
+}
+
+// DeferTurn moves the participant down by shift places, or to the end if
+// fewer stand behind, letting those behind go first. A participant may defer
+// at most maxDeferrals times. A called participant loses the call and the new
+// head is called. Returns the participant at the new place.
+func (s *Storage) DeferTurn(ctx context.Context, queue models.Queue, userID int64, shift int32, maxDeferrals int32) (models.Participant, error) {
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return models.Participant{}, fmt.Errorf("postgres: begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	p, err := scanParticipant(tx.QueryRow(ctx, `SELECT `+participantColumns+`
+FROM queue_participants WHERE queue_id = $1 AND user_id = $2 FOR UPDATE`, queue.ID, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Participant{}, storage.ErrParticipantMissing
+		}
+		return models.Participant{}, fmt.Errorf("postgres: get participant: %w", err)
+	}
+	if p.Deferrals >= maxDeferrals {
+		return models.Participant{}, storage.ErrDeferLimit
+	}
+
+	var count int32
+	if err := tx.QueryRow(ctx, `SELECT COUNT(*) FROM queue_participants WHERE queue_id = $1`, queue.ID).Scan(&count); err != nil {
+		return models.Participant{}, fmt.Errorf("postgres: count participants: %w", err)
+	}
+	target := min(p.Position+shift, count)
+	if target <= p.Position {
+		return models.Participant{}, storage.ErrNobodyBehind
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE queue_participants SET position = position - 1
+WHERE queue_id = $1 AND position > $2 AND position <= $3`, queue.ID, p.Position, target); err != nil {
+		return models.Participant{}, fmt.Errorf("postgres: shift positions: %w", err)
+	}
+	wasCalled := p.CalledAt != nil
+	p, err = scanParticipant(tx.QueryRow(ctx, `UPDATE queue_participants SET position = $2, called_at = NULL, checked_in_at = NULL,
+deferrals = deferrals + 1 WHERE id = $1 RETURNING `+participantColumns, p.ID, target))
+	if err != nil {
+		return models.Participant{}, fmt.Errorf("postgres: defer participant: %w", err)
+	}
+
+	if err := appendEvent(ctx, tx, models.QueueEvent{QueueID: queue.ID, Type: models.EventDeferred, ActorID: userID, UserID: userID, Position: target}); err != nil {
+		return models.Participant{}, err
+	}
+	if queue.CallsEnabled() && wasCalled {
+		if _, err := callHead(ctx, tx, queue, userID); err != nil {
+			return models.Participant{}, err
+		}
+	}
+	if err := enqueueTopPositions(ctx, tx, queue); err != nil {
+		return models.Participant{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return models.Participant{}, fmt.Errorf("postgres: commit: %w", err)
+	}
+	return p, nil
+}
+
+// lockParticipants returns the participants of the queue by position and
+// locks them, so concurrent joins, advances and moves wait for the new order.
+func lockParticipants(ctx context.Context, tx pgx.Tx, queueID int64) ([]models.Participant, error) {
+	rows, err := tx.Query(ctx, `SELECT `+participantColumns+`
+FROM queue_participants WHERE queue_id = $1 ORDER BY position FOR UPDATE`, queueID)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: lock participants: %w", err)
+	}
+	defer rows.Close()
+
+	var list []models.Participant
+	for rows.Next() {
+		p, err := scanParticipant(rows)
+		if err != nil {
+			return nil, fmt.Errorf("postgres: scan participant: %w", err)
+		}
+		list = append(list, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres: participants rows error: %w", err)
+	}
+	return list, nil
+}
+
+// writeOrder numbers the participants 1..n in the given order, so positions
+// never have gaps or repeats. A call is withdrawn from whoever is no longer
+// at the head, and the new head is called in their place. The head is
+// reported to the notification service as usual.
+func writeOrder(ctx context.Context, tx pgx.Tx, queue models.Queue, order []models.Participant, actorID int64) error {
+	calledHead, err := renumber(ctx, tx, queue.ID, order)
+	if err != nil {
+		return err
+	}
+	if queue.CallsEnabled() && calledHead != 0 && len(order) > 0 && order[0].ID != calledHead {
+		if order[0], err = callParticipant(ctx, tx, queue, order[0], actorID); err != nil {
+			return err
+		}
+	}
+	return enqueueTopPositions(ctx, tx, queue)
+}
+
+// renumber is the part of writeOrder that stores the positions and withdraws
+// the call from whoever is no longer at the head. Returns the id of the head
+// that was called before, or zero.
+func renumber(ctx context.Context, tx pgx.Tx, queueID int64, order []models.Participant) (int64, error) {
+	var calledHead int64
+	err := tx.QueryRow(ctx, `SELECT id FROM queue_participants WHERE queue_id = $1 AND position = 1 AND called_at IS NOT NULL`, queueID).
+		Scan(&calledHead)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return 0, fmt.Errorf("postgres: get called head: %w", err)
+	}
+
+	ids := make([]int64, len(order))
+	positions := make([]int32, len(order))
+	for i := range order {
+		ids[i] = order[i].ID
+		positions[i] = int32(i + 1)
+		order[i].Position = positions[i]
+		if i > 0 {
+			order[i].CalledAt, order[i].CheckedInAt = nil, nil
+		}
+	}
+
+	const query = `UPDATE queue_participants p SET position = o.position,
+called_at = CASE WHEN o.position = 1 THEN p.called_at END,
+checked_in_at = CASE WHEN o.position = 1 THEN p.checked_in_at END
+FROM unnest($2::bigint[], $3::int[]) AS o(id, position)
+WHERE p.queue_id = $1 AND p.id = o.id`
+	if _, err := tx.Exec(ctx, query, queueID, ids, positions); err != nil {
+		return 0, fmt.Errorf("postgres: write order: %w", err)
+	}
+	return calledHead, nil
+}
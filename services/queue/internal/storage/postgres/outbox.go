@@ -0,0 +1,176 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+)
+
+func enqueue(ctx context.Context, tx pgx.Tx, kind models.OutboxKind, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("postgres: encode outbox payload: %w", err)
+	}
+	if _, err := tx.Exec(ctx, `INSERT INTO notification_outbox (kind, payload) VALUES ($1, $2)`, kind, data); err != nil {
+		return fmt.Errorf("postgres: enqueue notification: %w", err)
+	}
+	return nil
+}
+
+func enqueuePositionSoon(ctx context.Context, tx pgx.Tx, queue models.Queue, p models.Participant, added bool) error {
+	return enqueue(ctx, tx, models.OutboxPositionSoon, models.PositionSoonPayload{
+		UserID:        p.UserID,
+		QueueID:       queue.ID,
+		ParticipantID: p.ID,
+		QueueTitle:    queue.Title,
+		Position:      p.Position,
+		NotifyTop:     queue.NotifyTop,
+		Added:         added,
+	})
+}
+
+// enqueueTopPositions reports the head of the queue as it is after the change.
+// In a queue with calls the first place is not reported: its participant
+// learns that their turn has come from the call. Nothing is reported before
+// the draw of a random queue, as positions are not final yet.
+func enqueueTopPositions(ctx context.Context, tx pgx.Tx, queue models.Queue) error {
+	if queue.AwaitsDraw() {
+		return nil
+	}
+	rows, err := tx.Query(ctx, `SELECT id, user_id, position FROM queue_participants WHERE queue_id = $1 ORDER BY position LIMIT $2`,
+		queue.ID, queue.NotifyTop)
+	if err != nil {
+		return fmt.Errorf("postgres: list top participants: %w", err)
+	}
+
+	var top []models.Participant
+	for rows.Next() {
+		var p models.Participant
+		if err := rows.Scan(&p.ID, &p.UserID, &p.Position); err != nil {
+			rows.Close()
+			return fmt.Errorf("postgres: scan top participant: %w", err)
+		}
+		top = append(top, p)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("postgres: rows error: %w", err)
+	}
+
+	for _, p := range top {
+		if queue.CallsEnabled() && p.Position == 1 {
+			continue
+		}
+		if err := enqueuePositionSoon(ctx, tx, queue, p, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// enqueueQueueOpened tells active members of the queue's group that it has opened.
+func enqueueQueueOpened(ctx context.Context, tx pgx.Tx, queue models.Queue) error {
+	const query = `SELECT gm.user_id FROM group_members gm JOIN groups g ON g.id = gm.group_id
+WHERE g.code = $1 AND gm.status = 'active'`
+
+	rows, err := tx.Query(ctx, query, queue.GroupCode)
+	if err != nil {
+		return fmt.Errorf("postgres: list group members: %w", err)
+	}
+	userIDs, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		return fmt.Errorf("postgres: scan group members: %w", err)
+	}
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	return enqueue(ctx, tx, models.OutboxQueueOpened, models.QueueOpenedPayload{
+		UserIDs:    userIDs,
+		QueueID:    queue.ID,
+		QueueTitle: queue.Title,
+	})
+}
+
+// enqueueQueueArchived tells the users still standing in the queue that it was archived.
+func enqueueQueueArchived(ctx context.Context, tx pgx.Tx, queueID int64, title string) error {
+	rows, err := tx.Query(ctx, `SELECT user_id FROM queue_participants WHERE queue_id = $1 ORDER BY position`, queueID)
+	if err != nil {
+		return fmt.Errorf("postgres: list participants: %w", err)
+	}
+	userIDs, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		return fmt.Errorf("postgres: scan participants: %w", err)
+	}
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	return enqueue(ctx, tx, models.OutboxQueueArchived, models.QueueArchivedPayload{
+		UserIDs:    userIDs,
+		QueueID:    queueID,
+		QueueTitle: title,
+	})
+}
+
+// ClaimOutbox takes up to limit pending messages that are due and hides them
+// from other dispatchers for lease, so several instances never send the same
+// message at once. A message whose sender dies reappears after the lease.
+func (s *Storage) ClaimOutbox(ctx context.Context, limit int, lease time.Duration) ([]models.OutboxMessage, error) {
+	const query = `UPDATE notification_outbox SET next_attempt_at = NOW() + $2::interval
+WHERE id IN (
+	SELECT id FROM notification_outbox WHERE status = 'pending' AND next_attempt_at <= NOW()
+	ORDER BY id LIMIT $1 FOR UPDATE SKIP LOCKED
+) RETURNING id, kind, payload, attempts, created_at`
+
+	rows, err := s.pool.Query(ctx, query, limit, lease)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: claim outbox: %w", err)
+	}
+	defer rows.Close()
+
+	var messages []models.OutboxMessage
+	for rows.Next() {
+		var m models.OutboxMessage
+		if err := rows.Scan(&m.ID, &m.Kind, &m.Payload, &m.Attempts, &m.CreatedAt); err != nil {
+			return nil, fmt.Errorf("postgres: scan outbox message: %w", err)
+		}
+		messages = append(messages, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres: rows error: %w", err)
+	}
+	return messages, nil
+}
+
+// DeleteOutbox drops a delivered message.
+func (s *Storage) DeleteOutbox(ctx context.Context, id int64) error {
+	if _, err := s.pool.Exec(ctx, `DELETE FROM notification_outbox WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("postgres: delete outbox message: %w", err)
+	}
+	return nil
+}
+
+// RetryOutbox records a failed attempt and puts the message back for nextAttempt.
+func (s *Storage) RetryOutbox(ctx context.Context, id int64, lastErr string, nextAttempt time.Time) error {
+	const query = `UPDATE notification_outbox SET attempts = attempts + 1, last_error = $2, next_attempt_at = $3 WHERE id = $1`
+
+	if _, err := s.pool.Exec(ctx, query, id, lastErr, nextAttempt); err != nil {
+		return fmt.Errorf("postgres: retry outbox message: %w", err)
+	}
+	return nil
+}
+
+// BuryOutbox records the last failed attempt and moves the message to the dead letters.
+func (s *Storage) BuryOutbox(ctx context.Context, id int64, lastErr string) error {
+	const query = `UPDATE notification_outbox SET status = 'dead', attempts = attempts + 1, last_error = $2 WHERE id = $1`
+
+	if _, err := s.pool.Exec(ctx, query, id, lastErr); err != nil {
+		return fmt.Errorf("postgres: bury outbox message: %w", err)
+	}
+	return nil
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"math/rand"
+	"slices"
 	"time"
 
 	"github.com/jackc/pgx/v5"
@@ -36,11 +36,32 @@ func (s *Storage) Close() {
 	s.pool.Close()
 }
 
-func (s *Storage) ListQueues(ctx context.Context, group string) ([]models.Queue, error) {
-	const query = `SELECT id, title, description, mode, status, group_code, owner_id, created_at, updated_at 
-FROM queues WHERE group_code = $1 AND status = 'active' ORDER BY created_at DESC`
+const queueColumns = `id, title, description, mode, status, group_code, owner_id, opens_at, closes_at, notify_top,
+call_timeout_seconds, call_miss_shift, swaps_allowed, lottery_at, lottery_seed, lottery_commit, drawn_at, created_at, updated_at`
 
-	rows, err := s.pool.Query(ctx, query, group)
+func scanQueue(row pgx.Row) (models.Queue, error) {
+	var q models.Queue
+	var callTimeout int32
+	err := row.Scan(&q.ID, &q.Title, &q.Description, &q.Mode, &q.Status, &q.GroupCode, &q.OwnerID, &q.OpensAt, &q.ClosesAt, &q.NotifyTop,
+		&callTimeout, &q.CallMissShift, &q.SwapsAllowed, &q.LotteryAt, &q.LotterySeed, &q.LotteryCommit, &q.DrawnAt, &q.CreatedAt, &q.UpdatedAt)
+	q.CallTimeout = time.Duration(callTimeout) * time.Second
+	return q, err
+}
+
+const participantColumns = `id, queue_id, user_id, position, slot_time, full_name, created_at, called_at, checked_in_at, missed_calls, deferrals, priority`
+
+func scanParticipant(row pgx.Row) (models.Participant, error) {
+	var p models.Participant
+	err := row.Scan(&p.ID, &p.QueueID, &p.UserID, &p.Position, &p.SlotTime, &p.FullName, &p.CreatedAt, &p.CalledAt, &p.CheckedInAt, &p.MissedCalls, &p.Deferrals, &p.Priority)
+	return p, err
+}
+
+// ListQueues returns not archived queues of the given groups.
+func (s *Storage) ListQueues(ctx context.Context, groups []string) ([]models.Queue, error) {
+	const query = `SELECT ` + queueColumns + `
+FROM queues WHERE group_code = ANY($1) AND status <> 'archived' ORDER BY created_at DESC`
+
+	rows, err := s.pool.Query(ctx, query, groups)
 	if err != nil {
 		return nil, fmt.Errorf("postgres: list queues: %w", err)
 	}
@@ -48,8 +69,8 @@ FROM queues WHERE group_code = $1 AND status = 'active' ORDER BY created_at DESC
 
 	var queues []models.Queue
 	for rows.Next() {
-		var q models.Queue
-		if err := rows.Scan(&q.ID, &q.Title, &q.Description, &q.Mode, &q.Status, &q.GroupCode, &q.OwnerID, &q.CreatedAt, &q.UpdatedAt); err != nil {
+		q, err := scanQueue(rows)
+		if err != nil {
 			return nil, fmt.Errorf("postgres: scan queue: %w", err)
 		}
 		queues = append(queues, q)
@@ -62,26 +83,59 @@ FROM queues WHERE group_code = $1 AND status = 'active' ORDER BY created_at DESC
 }
 
 func (s *Storage) CreateQueue(ctx context.Context, q models.Queue) (models.Queue, error) {
-	const query = `INSERT INTO queues (title, description, mode, status, group_code, owner_id)
-VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, created_at, updated_at`
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return models.Queue{}, fmt.Errorf("postgres: begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
 
-	err := s.pool.QueryRow(ctx, query, q.Title, q.Description, q.Mode, q.Status, q.GroupCode, q.OwnerID).
-		Scan(&q.ID, &q.CreatedAt, &q.UpdatedAt)
+	q, err = insertQueue(ctx, tx, q)
+	if err != nil {
+		return models.Queue{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return models.Queue{}, fmt.Errorf("postgres: commit: %w", err)
+	}
+
+	return q, nil
+}
+
+// insertQueue stores a new queue with its creator as owner and records the creation.
+func insertQueue(ctx context.Context, tx pgx.Tx, q models.Queue) (models.Queue, error) {
+	if q.NotifyTop == 0 {
+		q.NotifyTop = models.DefaultNotifyTop
+	}
+
+	const query = `INSERT INTO queues (title, description, mode, status, group_code, owner_id, opens_at, closes_at, notify_top,
+lottery_at, lottery_seed, lottery_commit)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) RETURNING id, swaps_allowed, created_at, updated_at`
+
+	err := tx.QueryRow(ctx, query, q.Title, q.Description, q.Mode, q.Status, q.GroupCode, q.OwnerID, q.OpensAt, q.ClosesAt, q.NotifyTop,
+		q.LotteryAt, q.LotterySeed, q.LotteryCommit).
+		Scan(&q.ID, &q.SwapsAllowed, &q.CreatedAt, &q.UpdatedAt)
 	if err != nil {
 		return models.Queue{}, fmt.Errorf("postgres: create queue: %w", err)
 	}
 
+	if err := appendEvent(ctx, tx, models.QueueEvent{QueueID: q.ID, Type: models.EventCreated, ActorID: q.OwnerID}); err != nil {
+		return models.Queue{}, err
+	}
+
+	if err := upsertMember(ctx, tx, models.QueueMember{QueueID: q.ID, UserID: q.OwnerID, Role: models.RoleOwner, GrantedBy: q.OwnerID}); err != nil {
+		return models.Queue{}, err
+	}
+
 	return q, nil
 }
 
 func (s *Storage) GetQueue(ctx context.Context, queueID int64) (models.Queue, []models.Participant, error) {
-	const queueQuery = `SELECT id, title, description, mode, status, group_code, owner_id, created_at, updated_at FROM queues WHERE id = $1`
-	const participantQuery = `SELECT id, queue_id, user_id, position, slot_time, full_name, created_at 
+	const queueQuery = `SELECT ` + queueColumns + ` FROM queues WHERE id = $1`
+	const participantQuery = `SELECT ` + participantColumns + `
 FROM queue_participants WHERE queue_id = $1 ORDER BY position ASC`
 
-	var q models.Queue
-	if err := s.pool.QueryRow(ctx, queueQuery, queueID).
-		Scan(&q.ID, &q.Title, &q.Description, &q.Mode, &q.Status, &q.GroupCode, &q.OwnerID, &q.CreatedAt, &q.UpdatedAt); err != nil {
+	q, err := scanQueue(s.pool.QueryRow(ctx, queueQuery, queueID))
+	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return models.Queue{}, nil, storage.ErrQueueNotFound
 		}
@@ -96,8 +150,8 @@ FROM queue_participants WHERE queue_id = $1 ORDER BY position ASC`
 
 	var participants []models.Participant
 	for rows.Next() {
-		var p models.Participant
-		if err := rows.Scan(&p.ID, &p.QueueID, &p.UserID, &p.Position, &p.SlotTime, &p.FullName, &p.CreatedAt); err != nil {
+		p, err := scanParticipant(rows)
+		if err != nil {
 			return models.Queue{}, nil, fmt.Errorf("postgres: scan participant: %w", err)
 		}
 		participants = append(participants, p)
@@ -109,44 +163,95 @@ FROM queue_participants WHERE queue_id = $1 ORDER BY position ASC`
 	return q, participants, nil
 }
 
-func (s *Storage) UpdateStatus(ctx context.Context, queueID int64, status models.QueueStatus) error {
-	const query = `UPDATE queues SET status = $1, updated_at = NOW() WHERE id = $2`
-	cmd, err := s.pool.Exec(ctx, query, status, queueID)
+func (s *Storage) UpdateStatus(ctx context.Context, queueID int64, status models.QueueStatus, actorID int64) error {
+	const query = `UPDATE queues SET status = $1, updated_at = NOW() WHERE id = $2 RETURNING title`
+
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
+		return fmt.Errorf("postgres: begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var title string
+	if err := tx.QueryRow(ctx, query, status, queueID).Scan(&title); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return storage.ErrQueueNotFound
+		}
 		return fmt.Errorf("postgres: update status: %w", err)
 	}
-	if cmd.RowsAffected() == 0 {
-		return storage.ErrQueueNotFound
+
+	if status == models.StatusArchived {
+		if err := enqueueQueueArchived(ctx, tx, queueID, title); err != nil {
+			return err
+		}
+	}
+
+	if err := appendEvent(ctx, tx, models.QueueEvent{QueueID: queueID, Type: statusEvent(status), ActorID: actorID}); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("postgres: commit: %w", err)
 	}
 	return nil
 }
 
-func (s *Storage) UpdateQueue(ctx context.Context, queueID int64, title, description string) (models.Queue, error) {
-	const query = `UPDATE queues SET title = COALESCE(NULLIF($1, ''), title), description = $2, updated_at = NOW() WHERE id = $3 RETURNING id, title, description, mode, status, group_code, owner_id, created_at, updated_at`
-	var q models.Queue
-	if err := s.pool.QueryRow(ctx, query, title, description, queueID).
-		Scan(&q.ID, &q.Title, &q.Description, &q.Mode, &q.Status, &q.GroupCode, &q.OwnerID, &q.CreatedAt, &q.UpdatedAt); err != nil {
+func (s *Storage) UpdateQueue(ctx context.Context, queueID int64, title, description string, notifyTop int32, actorID int64) (models.Queue, error) {
+	const query = `UPDATE queues SET title = COALESCE(NULLIF($1, ''), title), description = $2, notify_top = COALESCE(NULLIF($4, 0), notify_top), updated_at = NOW()
+WHERE id = $3 RETURNING ` + queueColumns
+
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return models.Queue{}, fmt.Errorf("postgres: begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q, err := scanQueue(tx.QueryRow(ctx, query, title, description, queueID, notifyTop))
+	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return models.Queue{}, storage.ErrQueueNotFound
 		}
 		return models.Queue{}, fmt.Errorf("postgres: update queue: %w", err)
 	}
+
+	if err := appendEvent(ctx, tx, models.QueueEvent{QueueID: queueID, Type: models.EventUpdated, ActorID: actorID}); err != nil {
+		return models.Queue{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return models.Queue{}, fmt.Errorf("postgres: commit: %w", err)
+	}
 	return q, nil
 }
 
-func (s *Storage) DeleteQueue(ctx context.Context, queueID int64) error {
+func (s *Storage) DeleteQueue(ctx context.Context, queueID int64, actorID int64) error {
 	const query = `DELETE FROM queues WHERE id = $1`
-	cmd, err := s.pool.Exec(ctx, query, queueID)
+
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("postgres: begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	cmd, err := tx.Exec(ctx, query, queueID)
 	if err != nil {
 		return fmt.Errorf("postgres: delete queue: %w", err)
 	}
 	if cmd.RowsAffected() == 0 {
 		return storage.ErrQueueNotFound
 	}
+
+	if err := appendEvent(ctx, tx, models.QueueEvent{QueueID: queueID, Type: models.EventDeleted, ActorID: actorID}); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("postgres: commit: %w", err)
+	}
 	return nil
 }
 
-func (s *Storage) AddParticipant(ctx context.Context, queue models.Queue, userID int64, fullName string, slotTime *time.Time) (int32, error) {
+func (s *Storage) AddParticipant(ctx context.Context, queue models.Queue, userID int64, fullName string, slotTime *time.Time, actorID int64) (int32, error) {
 	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
 		return 0, fmt.Errorf("postgres: begin tx: %w", err)
@@ -164,23 +269,20 @@ func (s *Storage) AddParticipant(ctx context.Context, queue models.Queue, userID
 	var position int32
 
 	switch queue.Mode {
-	case models.ModeLive, models.ModeManaged:
+	// A random queue takes registrations in order until its draw; those who
+	// join after the draw go to the end and never displace anyone. A priority
+	// newcomer is put in line by sortByPriority once inserted.
+	case models.ModeLive, models.ModeManaged, models.ModeRandom, models.ModePriority:
 		if err := tx.QueryRow(ctx, `SELECT COALESCE(MAX(position),0)+1 FROM queue_participants WHERE queue_id=$1`, queue.ID).Scan(&position); err != nil {
 			return 0, fmt.Errorf("postgres: calc position: %w", err)
 		}
-	case models.ModeRandom:
-		var count int32
-		if err := tx.QueryRow(ctx, `SELECT COUNT(*) FROM queue_participants WHERE queue_id=$1`, queue.ID).Scan(&count); err != nil {
-			return 0, fmt.Errorf("postgres: count participants: %w", err)
-		}
-		position = randomPosition(count + 1)
-		if _, err := tx.Exec(ctx, `UPDATE queue_participants SET position = position + 1 WHERE queue_id=$1 AND position >= $2`, queue.ID, position); err != nil {
-			return 0, fmt.Errorf("postgres: shift positions: %w", err)
-		}
 	case models.ModeSlots:
 		if slotTime == nil {
 			return 0, fmt.Errorf("slot_time is required for slots mode")
 		}
+		if err := reserveSlot(ctx, tx, queue.ID, *slotTime); err != nil {
+			return 0, err
+		}
 		if err := tx.QueryRow(ctx, `SELECT COALESCE(COUNT(*),0)+1 FROM queue_participants WHERE queue_id=$1 AND (slot_time <= $2 OR slot_time IS NULL)`, queue.ID, slotTime).Scan(&position); err != nil {
 			return 0, fmt.Errorf("postgres: calc slot position: %w", err)
 		}
@@ -191,15 +293,41 @@ func (s *Storage) AddParticipant(ctx context.Context, queue models.Queue, userID
 		return 0, fmt.Errorf("unsupported queue mode: %s", queue.Mode)
 	}
 
-	if _, err := tx.Exec(ctx,
-		`INSERT INTO queue_participants (queue_id, user_id, position, slot_time, full_name) VALUES ($1, $2, $3, $4, $5)`,
-		queue.ID, userID, position, slotTime, fullName); err != nil {
+	var participantID int64
+	if err := tx.QueryRow(ctx,
+		`INSERT INTO queue_participants (queue_id, user_id, position, slot_time, full_name) VALUES ($1, $2, $3, $4, $5) RETURNING id`,
+		queue.ID, userID, position, slotTime, fullName).Scan(&participantID); err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
 			return 0, storage.ErrParticipantExists
 		}
 		return 0, fmt.Errorf("postgres: insert participant: %w", err)
 	}
+	if queue.Mode == models.ModePriority {
+		order, err := sortByPriority(ctx, tx, queue.ID)
+		if err != nil {
+			return 0, err
+		}
+		position = int32(slices.IndexFunc(order, func(p models.Participant) bool { return p.ID == participantID }) + 1)
+	}
+
+	evType := models.EventAdded
+	if actorID == userID {
+		evType = models.EventJoined
+	}
+	if err := appendEvent(ctx, tx, models.QueueEvent{QueueID: queue.ID, Type: evType, ActorID: actorID, UserID: userID, Position: position}); err != nil {
+		return 0, err
+	}
+	// A manually added participant is told where they are even if far from the head.
+	if evType == models.EventAdded && position > queue.NotifyTop && !queue.AwaitsDraw() {
+		p := models.Participant{ID: participantID, UserID: userID, Position: position}
+		if err := enqueuePositionSoon(ctx, tx, queue, p, true); err != nil {
+			return 0, err
+		}
+	}
+	if err := enqueueTopPositions(ctx, tx, queue); err != nil {
+		return 0, err
+	}
 
 	if err := tx.Commit(ctx); err != nil {
 		return 0, fmt.Errorf("postgres: commit: %w", err)
@@ -208,25 +336,55 @@ func (s *Storage) AddParticipant(ctx context.Context, queue models.Queue, userID
 	return position, nil
 }
 
-func (s *Storage) RemoveParticipant(ctx context.Context, queue models.Queue, userID int64) error {
+func (s *Storage) RemoveParticipant(ctx context.Context, queue models.Queue, userID int64, actorID int64) error {
 	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
 		return fmt.Errorf("postgres: begin tx: %w", err)
 	}
 	defer func() { _ = tx.Rollback(ctx) }()
 
-	var position int32
-	if err := tx.QueryRow(ctx, `DELETE FROM queue_participants WHERE queue_id=$1 AND user_id=$2 RETURNING position`, queue.ID, userID).Scan(&position); err != nil {
+	p, err := scanParticipant(tx.QueryRow(ctx, `DELETE FROM queue_participants WHERE queue_id=$1 AND user_id=$2
+RETURNING `+participantColumns, queue.ID, userID))
+	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return storage.ErrParticipantMissing
 		}
 		return fmt.Errorf("postgres: delete participant: %w", err)
 	}
 
-	if _, err := tx.Exec(ctx, `UPDATE queue_participants SET position = position - 1 WHERE queue_id=$1 AND position > $2`, queue.ID, position); err != nil {
+	if _, err := tx.Exec(ctx, `UPDATE queue_participants SET position = position - 1 WHERE queue_id=$1 AND position > $2`, queue.ID, p.Position); err != nil {
 		return fmt.Errorf("postgres: shift positions after delete: %w", err)
 	}
 
+	evType, outcome := models.EventRemoved, models.OutcomeRemoved
+	if actorID == userID {
+		evType, outcome = models.EventLeft, models.OutcomeLeft
+	}
+	if err := archiveParticipant(ctx, tx, p, outcome, actorID); err != nil {
+		return err
+	}
+	if err := appendEvent(ctx, tx, models.QueueEvent{QueueID: queue.ID, Type: evType, ActorID: actorID, UserID: userID, Position: p.Position}); err != nil {
+		return err
+	}
+	if evType == models.EventRemoved {
+		if err := enqueue(ctx, tx, models.OutboxParticipantRemoved, models.ParticipantRemovedPayload{
+			UserID:     userID,
+			QueueID:    queue.ID,
+			QueueTitle: queue.Title,
+		}); err != nil {
+			return err
+		}
+	}
+	// Whoever stood behind a called participant is called in their place.
+	if queue.CallsEnabled() && p.CalledAt != nil {
+		if _, err := callHead(ctx, tx, queue, actorID); err != nil {
+			return err
+		}
+	}
+	if err := enqueueTopPositions(ctx, tx, queue); err != nil {
+		return err
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return fmt.Errorf("postgres: commit: %w", err)
 	}
@@ -234,45 +392,86 @@ func (s *Storage) RemoveParticipant(ctx context.Context, queue models.Queue, use
 	return nil
 }
 
-func (s *Storage) Advance(ctx context.Context, queue models.Queue) (models.Participant, error) {
+// Advance takes the head of the queue out and records it in history with the given
+// outcome: served for a regular advance, skipped when the participant did not show up.
+// In a queue with calls a head who was not called yet is only called, and taking
+// out the called head calls the next one. Positions of a priority queue already
+// follow the priorities, so its head is the earliest of the highest level.
+// Returns the removed and the called participants; either may be zero.
+func (s *Storage) Advance(ctx context.Context, queue models.Queue, actorID int64, outcome models.Outcome) (models.Participant, models.Participant, error) {
 	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
-		return models.Participant{}, fmt.Errorf("postgres: begin tx: %w", err)
+		return models.Participant{}, models.Participant{}, fmt.Errorf("postgres: begin tx: %w", err)
 	}
 	defer func() { _ = tx.Rollback(ctx) }()
 
+	head, err := lockHead(ctx, tx, queue)
+	if err != nil {
+		return models.Participant{}, models.Participant{}, err
+	}
+
+	var removed, called models.Participant
+	if queue.CallsEnabled() && head.CalledAt == nil {
+		if called, err = callParticipant(ctx, tx, queue, head, actorID); err != nil {
+			return models.Participant{}, models.Participant{}, err
+		}
+	} else {
+		if err := takeOut(ctx, tx, queue, head, outcome, actorID); err != nil {
+			return models.Participant{}, models.Participant{}, err
+		}
+		removed = head
+		if queue.CallsEnabled() {
+			if called, err = callHead(ctx, tx, queue, actorID); err != nil {
+				return models.Participant{}, models.Participant{}, err
+			}
+		}
+	}
+	if err := enqueueTopPositions(ctx, tx, queue); err != nil {
+		return models.Participant{}, models.Participant{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return models.Participant{}, models.Participant{}, fmt.Errorf("postgres: commit: %w", err)
+	}
+
+	return removed, called, nil
+}
+
+// lockHead returns the participant at the head of the queue: the first by
+// position, or by slot time in slots mode.
+func lockHead(ctx context.Context, tx pgx.Tx, queue models.Queue) (models.Participant, error) {
 	orderClause := "position ASC"
 	if queue.Mode == models.ModeSlots {
 		orderClause = "slot_time ASC NULLS LAST"
 	}
+	query := fmt.Sprintf(`SELECT %s FROM queue_participants WHERE queue_id=$1 ORDER BY %s LIMIT 1 FOR UPDATE`, participantColumns, orderClause)
 
-	var p models.Participant
-	query := fmt.Sprintf(`DELETE FROM queue_participants WHERE id = (
-		SELECT id FROM queue_participants WHERE queue_id=$1 ORDER BY %s LIMIT 1
-	) RETURNING id, queue_id, user_id, position, slot_time, full_name, created_at`, orderClause)
-
-	if err := tx.QueryRow(ctx, query, queue.ID).Scan(&p.ID, &p.QueueID, &p.UserID, &p.Position, &p.SlotTime, &p.FullName, &p.CreatedAt); err != nil {
+	p, err := scanParticipant(tx.QueryRow(ctx, query, queue.ID))
+	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return models.Participant{}, storage.ErrParticipantMissing
 		}
-		return models.Participant{}, fmt.Errorf("postgres: advance delete: %w", err)
+		return models.Participant{}, fmt.Errorf("postgres: get queue head: %w", err)
 	}
+	return p, nil
+}
 
+// takeOut deletes the participant, closes the gap and records them in history
+// as served or skipped.
+func takeOut(ctx context.Context, tx pgx.Tx, queue models.Queue, p models.Participant, outcome models.Outcome, actorID int64) error {
+	if _, err := tx.Exec(ctx, `DELETE FROM queue_participants WHERE id = $1`, p.ID); err != nil {
+		return fmt.Errorf("postgres: advance delete: %w", err)
+	}
 	if _, err := tx.Exec(ctx, `UPDATE queue_participants SET position = position - 1 WHERE queue_id=$1 AND position > $2`, queue.ID, p.Position); err != nil {
-		return models.Participant{}, fmt.Errorf("postgres: shift positions after advance: %w", err)
+		return fmt.Errorf("postgres: shift positions after advance: %w", err)
 	}
 
-	if err := tx.Commit(ctx); err != nil {
-		return models.Participant{}, fmt.Errorf("postgres: commit: %w", err)
+	evType := models.EventAdvanced
+	if outcome == models.OutcomeSkipped {
+		evType = models.EventSkipped
 	}
-
-	return p, nil
-}
-
-func randomPosition(max int32) int32 {
-	if max <= 1 {
-		return 1
+	if err := archiveParticipant(ctx, tx, p, outcome, actorID); err != nil {
+		return err
 	}
-	rand.Seed(time.Now().UnixNano())
-	return int32(rand.Intn(int(max)) + 1)
+	return appendEvent(ctx, tx, models.QueueEvent{QueueID: queue.ID, Type: evType, ActorID: actorID, UserID: p.UserID, Position: p.Position})
 }
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/storage"
+)
+
+// SetPriority gives the participant of a priority queue a new priority and
+// puts them in line by models.PriorityOrder. Returns the new order.
+func (s *Storage) SetPriority(ctx context.Context, queue models.Queue, userID int64, priority int32, actorID int64) ([]models.Participant, error) {
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("postgres: begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	list, err := lockParticipants(ctx, tx, queue.ID)
+	if err != nil {
+		return nil, err
+	}
+	i := slices.IndexFunc(list, func(p models.Participant) bool { return p.UserID == userID })
+	if i < 0 {
+		return nil, storage.ErrParticipantMissing
+	}
+	if _, err := tx.Exec(ctx, `UPDATE queue_participants SET priority = $2 WHERE id = $1`, list[i].ID, priority); err != nil {
+		return nil, fmt.Errorf("postgres: set priority: %w", err)
+	}
+	list[i].Priority = priority
+
+	order := models.PriorityOrder(list)
+	if err := writeOrder(ctx, tx, queue, order, actorID); err != nil {
+		return nil, err
+	}
+	position := int32(slices.IndexFunc(order, func(p models.Participant) bool { return p.UserID == userID }) + 1)
+	if err := appendEvent(ctx, tx, models.QueueEvent{QueueID: queue.ID, Type: models.EventPriorityChanged, ActorID: actorID, UserID: userID, Position: position}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("postgres: commit: %w", err)
+	}
+	return order, nil
+}
+
+// sortByPriority puts the participants of a priority queue in
+// models.PriorityOrder. The called head stays in place, so nobody has to be
+// called; reporting the head is left to the caller. Returns the order.
+func sortByPriority(ctx context.Context, tx pgx.Tx, queueID int64) ([]models.Participant, error) {
+	list, err := lockParticipants(ctx, tx, queueID)
+	if err != nil {
+		return nil, err
+	}
+	order := models.PriorityOrder(list)
+	if _, err := renumber(ctx, tx, queueID, order); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+)
+
+// OpenDueQueues activates scheduled queues whose opens_at has come, queues the
+// "queue opened" notification for their groups and returns them.
+func (s *Storage) OpenDueQueues(ctx context.Context, now time.Time) ([]models.Queue, error) {
+	const query = `UPDATE queues SET status = 'active', updated_at = NOW()
+WHERE status = 'scheduled' AND opens_at <= $1 RETURNING ` + queueColumns
+
+	return s.moveDueQueues(ctx, query, now, models.EventOpened, enqueueQueueOpened)
+}
+
+// CloseDueQueues closes active queues whose closes_at has passed and returns them.
+func (s *Storage) CloseDueQueues(ctx context.Context, now time.Time) ([]models.Queue, error) {
+	const query = `UPDATE queues SET status = 'closed', updated_at = NOW()
+WHERE status = 'active' AND closes_at <= $1 RETURNING ` + queueColumns
+
+	return s.moveDueQueues(ctx, query, now, models.EventClosed, nil)
+}
+
+// moveDueQueues runs a status transition and records it for every affected
+// queue, calling then (if set) for each in the same transaction. The scheduler
+// is the actor, so events carry a zero actor id.
+func (s *Storage) moveDueQueues(ctx context.Context, query string, now time.Time, evType models.EventType,
+	then func(ctx context.Context, tx pgx.Tx, q models.Queue) error) ([]models.Queue, error) {
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("postgres: begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	rows, err := tx.Query(ctx, query, now)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: update due queues: %w", err)
+	}
+
+	var queues []models.Queue
+	for rows.Next() {
+		q, err := scanQueue(rows)
+		if err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("postgres: scan queue: %w", err)
+		}
+		queues = append(queues, q)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres: rows error: %w", err)
+	}
+
+	for _, q := range queues {
+		if err := appendEvent(ctx, tx, models.QueueEvent{QueueID: q.ID, Type: evType}); err != nil {
+			return nil, err
+		}
+		if then != nil {
+			if err := then(ctx, tx, q); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("postgres: commit: %w", err)
+	}
+	return queues, nil
+}
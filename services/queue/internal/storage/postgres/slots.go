@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/storage"
+)
+
+// SetSlotSchedule creates or replaces the slot schedule of the queue together with its breaks.
+// Participants already booked keep their slots even if the new schedule no longer has them.
+func (s *Storage) SetSlotSchedule(ctx context.Context, schedule models.SlotSchedule, actorID int64) (models.SlotSchedule, error) {
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return models.SlotSchedule{}, fmt.Errorf("postgres: begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	schedule, err = saveSlotSchedule(ctx, tx, schedule, actorID)
+	if err != nil {
+		return models.SlotSchedule{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return models.SlotSchedule{}, fmt.Errorf("postgres: commit: %w", err)
+	}
+	return schedule, nil
+}
+
+func saveSlotSchedule(ctx context.Context, tx pgx.Tx, schedule models.SlotSchedule, actorID int64) (models.SlotSchedule, error) {
+	const query = `INSERT INTO queue_slot_schedules (queue_id, starts_at, ends_at, slot_minutes, capacity)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (queue_id) DO UPDATE SET starts_at = EXCLUDED.starts_at, ends_at = EXCLUDED.ends_at,
+	slot_minutes = EXCLUDED.slot_minutes, capacity = EXCLUDED.capacity, updated_at = NOW()
+RETURNING updated_at`
+
+	minutes := int32(schedule.SlotDuration / time.Minute)
+	if err := tx.QueryRow(ctx, query, schedule.QueueID, schedule.StartsAt, schedule.EndsAt, minutes, schedule.Capacity).
+		Scan(&schedule.UpdatedAt); err != nil {
+		return models.SlotSchedule{}, fmt.Errorf("postgres: set slot schedule: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM queue_slot_breaks WHERE queue_id = $1`, schedule.QueueID); err != nil {
+		return models.SlotSchedule{}, fmt.Errorf("postgres: clear slot breaks: %w", err)
+	}
+	for _, b := range schedule.Breaks {
+		if _, err := tx.Exec(ctx, `INSERT INTO queue_slot_breaks (queue_id, starts_at, ends_at) VALUES ($1, $2, $3)`,
+			schedule.QueueID, b.StartsAt, b.EndsAt); err != nil {
+			return models.SlotSchedule{}, fmt.Errorf("postgres: insert slot break: %w", err)
+		}
+	}
+
+	if err := appendEvent(ctx, tx, models.QueueEvent{QueueID: schedule.QueueID, Type: models.EventSlotsUpdated, ActorID: actorID}); err != nil {
+		return models.SlotSchedule{}, err
+	}
+	return schedule, nil
+}
+
+func (s *Storage) SlotSchedule(ctx context.Context, queueID int64) (models.SlotSchedule, error) {
+	return slotSchedule(ctx, s.pool, queueID, "")
+}
+
+// SlotOccupancy returns how many participants are booked in each slot, keyed by slot start in UTC.
+func (s *Storage) SlotOccupancy(ctx context.Context, queueID int64) (map[time.Time]int32, error) {
+	const query = `SELECT slot_time, COUNT(*) FROM queue_participants
+WHERE queue_id = $1 AND slot_time IS NOT NULL GROUP BY slot_time`
+
+	rows, err := s.pool.Query(ctx, query, queueID)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: slot occupancy: %w", err)
+	}
+	defer rows.Close()
+
+	taken := make(map[time.Time]int32)
+	for rows.Next() {
+		var (
+			slot  time.Time
+			count int32
+		)
+		if err := rows.Scan(&slot, &count); err != nil {
+			return nil, fmt.Errorf("postgres: scan slot occupancy: %w", err)
+		}
+		taken[slot.UTC()] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres: rows error: %w", err)
+	}
+	return taken, nil
+}
+
+// reserveSlot checks that t starts a slot of the queue schedule and that the
+// slot still has room. The schedule row stays locked until the transaction
+// ends, so concurrent bookings of the same queue cannot overfill a slot.
+func reserveSlot(ctx context.Context, tx pgx.Tx, queueID int64, t time.Time) error {
+	schedule, err := slotSchedule(ctx, tx, queueID, "FOR UPDATE")
+	if err != nil {
+		return err
+	}
+	if !schedule.Has(t) {
+		return storage.ErrSlotUnavailable
+	}
+
+	var taken int32
+	if err := tx.QueryRow(ctx, `SELECT COUNT(*) FROM queue_participants WHERE queue_id = $1 AND slot_time = $2`, queueID, t).
+		Scan(&taken); err != nil {
+		return fmt.Errorf("postgres: count slot participants: %w", err)
+	}
+	if taken >= schedule.Capacity {
+		return storage.ErrSlotFull
+	}
+	return nil
+}
+
+type querier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+func slotSchedule(ctx context.Context, q querier, queueID int64, lock string) (models.SlotSchedule, error) {
+	query := `SELECT queue_id, starts_at, ends_at, slot_minutes, capacity, updated_at
+FROM queue_slot_schedules WHERE queue_id = $1 ` + lock
+
+	var (
+		schedule models.SlotSchedule
+		minutes  int32
+	)
+	if err := q.QueryRow(ctx, query, queueID).
+		Scan(&schedule.QueueID, &schedule.StartsAt, &schedule.EndsAt, &minutes, &schedule.Capacity, &schedule.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.SlotSchedule{}, storage.ErrNoSlotSchedule
+		}
+		return models.SlotSchedule{}, fmt.Errorf("postgres: get slot schedule: %w", err)
+	}
+	schedule.SlotDuration = time.Duration(minutes) * time.Minute
+
+	rows, err := q.Query(ctx, `SELECT starts_at, ends_at FROM queue_slot_breaks WHERE queue_id = $1 ORDER BY starts_at`, queueID)
+	if err != nil {
+		return models.SlotSchedule{}, fmt.Errorf("postgres: list slot breaks: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var b models.SlotBreak
+		if err := rows.Scan(&b.StartsAt, &b.EndsAt); err != nil {
+			return models.SlotSchedule{}, fmt.Errorf("postgres: scan slot break: %w", err)
+		}
+		schedule.Breaks = append(schedule.Breaks, b)
+	}
+	if err := rows.Err(); err != nil {
+		return models.SlotSchedule{}, fmt.Errorf("postgres: rows error: %w", err)
+	}
+	return schedule, nil
+}
@@ -0,0 +1,228 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/storage"
+)
+
+const swapColumns = `id, queue_id, from_user_id, to_user_id, status, created_at, expires_at, answered_at`
+
+func scanSwap(row pgx.Row) (models.SwapRequest, error) {
+	var r models.SwapRequest
+	err := row.Scan(&r.ID, &r.QueueID, &r.FromUserID, &r.ToUserID, &r.Status, &r.CreatedAt, &r.ExpiresAt, &r.AnsweredAt)
+	return r, err
+}
+
+// SetSwapsAllowed lets participants of the queue ask each other to swap
+// places, or forbids it. Forbidding cancels the pending requests.
+func (s *Storage) SetSwapsAllowed(ctx context.Context, queueID int64, allowed bool, actorID int64) (models.Queue, error) {
+	const query = `UPDATE queues SET swaps_allowed = $2, updated_at = NOW() WHERE id = $1 RETURNING ` + queueColumns
+
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return models.Queue{}, fmt.Errorf("postgres: begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q, err := scanQueue(tx.QueryRow(ctx, query, queueID, allowed))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.Queue{}, storage.ErrQueueNotFound
+		}
+		return models.Queue{}, fmt.Errorf("postgres: set swaps allowed: %w", err)
+	}
+
+	if !allowed {
+		if _, err := tx.Exec(ctx, `UPDATE swap_requests SET status = $2, answered_at = NOW()
+WHERE queue_id = $1 AND status = 'pending'`, queueID, models.SwapCancelled); err != nil {
+			return models.Queue{}, fmt.Errorf("postgres: cancel swap requests: %w", err)
+		}
+	}
+
+	if err := appendEvent(ctx, tx, models.QueueEvent{QueueID: queueID, Type: models.EventUpdated, ActorID: actorID}); err != nil {
+		return models.Queue{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return models.Queue{}, fmt.Errorf("postgres: commit: %w", err)
+	}
+	return q, nil
+}
+
+// CreateSwapRequest stores a pending request and asks the other participant
+// to answer it. Both users must be in the queue.
+func (s *Storage) CreateSwapRequest(ctx context.Context, queue models.Queue, fromUserID, toUserID int64, expiresAt time.Time) (models.SwapRequest, error) {
+	const participantQuery = `SELECT ` + participantColumns + ` FROM queue_participants WHERE queue_id = $1 AND user_id = $2`
+	const insertQuery = `INSERT INTO swap_requests (queue_id, from_user_id, to_user_id, expires_at)
+VALUES ($1, $2, $3, $4) RETURNING ` + swapColumns
+
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return models.SwapRequest{}, fmt.Errorf("postgres: begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	from, err := scanParticipant(tx.QueryRow(ctx, participantQuery, queue.ID, fromUserID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.SwapRequest{}, storage.ErrParticipantMissing
+		}
+		return models.SwapRequest{}, fmt.Errorf("postgres: get participant: %w", err)
+	}
+	var exists bool
+	if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM queue_participants WHERE queue_id = $1 AND user_id = $2)`,
+		queue.ID, toUserID).Scan(&exists); err != nil {
+		return models.SwapRequest{}, fmt.Errorf("postgres: check participant: %w", err)
+	}
+	if !exists {
+		return models.SwapRequest{}, storage.ErrParticipantMissing
+	}
+
+	r, err := scanSwap(tx.QueryRow(ctx, insertQuery, queue.ID, fromUserID, toUserID, expiresAt))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return models.SwapRequest{}, storage.ErrSwapRequestExists
+		}
+		return models.SwapRequest{}, fmt.Errorf("postgres: create swap request: %w", err)
+	}
+
+	if err := enqueue(ctx, tx, models.OutboxSwapRequested, models.SwapRequestedPayload{
+		UserID:     toUserID,
+		QueueID:    queue.ID,
+		QueueTitle: queue.Title,
+		RequestID:  r.ID,
+		FromName:   from.FullName,
+		Position:   from.Position,
+		Timeout:    int32(r.ExpiresAt.Sub(r.CreatedAt) / time.Second),
+	}); err != nil {
+		return models.SwapRequest{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return models.SwapRequest{}, fmt.Errorf("postgres: commit: %w", err)
+	}
+	return r, nil
+}
+
+func (s *Storage) GetSwapRequest(ctx context.Context, id int64) (models.SwapRequest, error) {
+	r, err := scanSwap(s.pool.QueryRow(ctx, `SELECT `+swapColumns+` FROM swap_requests WHERE id = $1`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.SwapRequest{}, storage.ErrSwapNotFound
+		}
+		return models.SwapRequest{}, fmt.Errorf("postgres: get swap request: %w", err)
+	}
+	return r, nil
+}
+
+// AnswerSwapRequest accepts or declines a pending request on behalf of its
+// receiver. Accepting exchanges the places in the same transaction; the
+// requester is told the answer either way. Returns the request and, when
+// accepted, the new order.
+func (s *Storage) AnswerSwapRequest(ctx context.Context, queue models.Queue, id int64, accept bool, now time.Time) (models.SwapRequest, []models.Participant, error) {
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return models.SwapRequest{}, nil, fmt.Errorf("postgres: begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	r, err := scanSwap(tx.QueryRow(ctx, `SELECT `+swapColumns+` FROM swap_requests WHERE id = $1 FOR UPDATE`, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.SwapRequest{}, nil, storage.ErrSwapNotFound
+		}
+		return models.SwapRequest{}, nil, fmt.Errorf("postgres: get swap request: %w", err)
+	}
+	if r.Status != models.SwapPending || !now.Before(r.ExpiresAt) {
+		return models.SwapRequest{}, nil, storage.ErrSwapNotPending
+	}
+
+	r.Status = models.SwapDeclined
+	answer := models.SwapAnsweredPayload{UserID: r.FromUserID, QueueID: queue.ID, QueueTitle: queue.Title}
+	var list []models.Participant
+	if accept {
+		list, err = swapPlaces(ctx, tx, queue, r.FromUserID, r.ToUserID, r.ToUserID)
+		if err != nil {
+			return models.SwapRequest{}, nil, err
+		}
+		r.Status = models.SwapAccepted
+		answer.Accepted = true
+		for _, p := range list {
+			if p.UserID == r.FromUserID {
+				answer.Position = p.Position
+			}
+		}
+	}
+
+	if err := tx.QueryRow(ctx, `UPDATE swap_requests SET status = $2, answered_at = $3 WHERE id = $1 RETURNING answered_at`,
+		r.ID, r.Status, now).Scan(&r.AnsweredAt); err != nil {
+		return models.SwapRequest{}, nil, fmt.Errorf("postgres: answer swap request: %w", err)
+	}
+	if err := enqueue(ctx, tx, models.OutboxSwapAnswered, answer); err != nil {
+		return models.SwapRequest{}, nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return models.SwapRequest{}, nil, fmt.Errorf("postgres: commit: %w", err)
+	}
+	return r, list, nil
+}
+
+// CancelSwapRequest withdraws a pending request.
+func (s *Storage) CancelSwapRequest(ctx context.Context, id int64) (models.SwapRequest, error) {
+	r, err := scanSwap(s.pool.QueryRow(ctx, `UPDATE swap_requests SET status = $2, answered_at = NOW()
+WHERE id = $1 AND status = 'pending' RETURNING `+swapColumns, id, models.SwapCancelled))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.SwapRequest{}, storage.ErrSwapNotPending
+		}
+		return models.SwapRequest{}, fmt.Errorf("postgres: cancel swap request: %w", err)
+	}
+	return r, nil
+}
+
+// ListSwapRequests returns the pending requests of the queue sent or received
+// by the user, oldest first.
+func (s *Storage) ListSwapRequests(ctx context.Context, queueID int64, userID int64, now time.Time) ([]models.SwapRequest, error) {
+	const query = `SELECT ` + swapColumns + ` FROM swap_requests
+WHERE queue_id = $1 AND (from_user_id = $2 OR to_user_id = $2) AND status = 'pending' AND expires_at > $3
+ORDER BY created_at ASC`
+
+	rows, err := s.pool.Query(ctx, query, queueID, userID, now)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: list swap requests: %w", err)
+	}
+	defer rows.Close()
+
+	var list []models.SwapRequest
+	for rows.Next() {
+		r, err := scanSwap(rows)
+		if err != nil {
+			return nil, fmt.Errorf("postgres: scan swap request: %w", err)
+		}
+		list = append(list, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres: rows error: %w", err)
+	}
+	return list, nil
+}
+
+// ExpireSwapRequests closes the pending requests nobody answered in time.
+// Returns how many were expired.
+func (s *Storage) ExpireSwapRequests(ctx context.Context, now time.Time) (int64, error) {
+	tag, err := s.pool.Exec(ctx, `UPDATE swap_requests SET status = $2, answered_at = $1
+WHERE status = 'pending' AND expires_at <= $1`, now, models.SwapExpired)
+	if err != nil {
+		return 0, fmt.Errorf("postgres: expire swap requests: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,232 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/domain/models"
+	"github.com/s1lentmol/q-flow-backend/services/queue/internal/storage"
+)
+
+const templateColumns = `id, title, description, mode, group_code, owner_id, weekdays, start_minute, timezone,
+publish_before_minutes, duration_minutes, slot_minutes, slot_capacity, lottery_after_minutes, auto_archive, paused,
+COALESCE(last_queue_id, 0), next_run_at, created_at, updated_at`
+
+func scanTemplate(row pgx.Row) (models.QueueTemplate, error) {
+	var (
+		t                                                                      models.QueueTemplate
+		weekdays                                                               []int16
+		startMinute, publishBefore, duration, slotMinutes, slots, lotteryAfter int32
+	)
+	err := row.Scan(&t.ID, &t.Title, &t.Description, &t.Mode, &t.GroupCode, &t.OwnerID, &weekdays, &startMinute, &t.Recurrence.Timezone,
+		&publishBefore, &duration, &slotMinutes, &slots, &lotteryAfter, &t.AutoArchive, &t.Paused,
+		&t.LastQueueID, &t.NextRunAt, &t.CreatedAt, &t.UpdatedAt)
+	if err != nil {
+		return models.QueueTemplate{}, err
+	}
+
+	for _, d := range weekdays {
+		t.Recurrence.Weekdays = append(t.Recurrence.Weekdays, time.Weekday(d))
+	}
+	t.Recurrence.StartTime = time.Duration(startMinute) * time.Minute
+	t.PublishBefore = time.Duration(publishBefore) * time.Minute
+	t.Duration = time.Duration(duration) * time.Minute
+	t.SlotDuration = time.Duration(slotMinutes) * time.Minute
+	t.SlotCapacity = slots
+	t.LotteryAfter = time.Duration(lotteryAfter) * time.Minute
+	return t, nil
+}
+
+// templateArgs lists the editable columns of a template in the order used by
+// CreateTemplate and UpdateTemplate.
+func templateArgs(t models.QueueTemplate) []any {
+	weekdays := make([]int16, 0, len(t.Recurrence.Weekdays))
+	for _, d := range t.Recurrence.Weekdays {
+		weekdays = append(weekdays, int16(d))
+	}
+	return []any{
+		t.Title, t.Description, t.Mode, t.GroupCode, weekdays,
+		int32(t.Recurrence.StartTime / time.Minute), t.Recurrence.Timezone,
+		int32(t.PublishBefore / time.Minute), int32(t.Duration / time.Minute),
+		int32(t.SlotDuration / time.Minute), t.SlotCapacity, int32(t.LotteryAfter / time.Minute),
+		t.AutoArchive, t.Paused, t.NextRunAt,
+	}
+}
+
+func (s *Storage) CreateTemplate(ctx context.Context, t models.QueueTemplate) (models.QueueTemplate, error) {
+	const query = `INSERT INTO queue_templates (title, description, mode, group_code, weekdays, start_minute, timezone,
+publish_before_minutes, duration_minutes, slot_minutes, slot_capacity, lottery_after_minutes, auto_archive, paused, next_run_at, owner_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16) RETURNING ` + templateColumns
+
+	saved, err := scanTemplate(s.pool.QueryRow(ctx, query, append(templateArgs(t), t.OwnerID)...))
+	if err != nil {
+		return models.QueueTemplate{}, fmt.Errorf("postgres: create template: %w", err)
+	}
+	return saved, nil
+}
+
+func (s *Storage) GetTemplate(ctx context.Context, templateID int64) (models.QueueTemplate, error) {
+	t, err := scanTemplate(s.pool.QueryRow(ctx, `SELECT `+templateColumns+` FROM queue_templates WHERE id = $1`, templateID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.QueueTemplate{}, storage.ErrTemplateNotFound
+		}
+		return models.QueueTemplate{}, fmt.Errorf("postgres: get template: %w", err)
+	}
+	return t, nil
+}
+
+// ListTemplates returns templates of the group, or the owner's templates when group is empty.
+func (s *Storage) ListTemplates(ctx context.Context, group string, ownerID int64) ([]models.QueueTemplate, error) {
+	query := `SELECT ` + templateColumns + ` FROM queue_templates WHERE group_code = $1 ORDER BY id`
+	arg := any(group)
+	if group == "" {
+		query = `SELECT ` + templateColumns + ` FROM queue_templates WHERE owner_id = $1 ORDER BY id`
+		arg = ownerID
+	}
+	return s.queryTemplates(ctx, query, arg)
+}
+
+// DueTemplates returns active templates whose next instance should be published by now.
+func (s *Storage) DueTemplates(ctx context.Context, now time.Time) ([]models.QueueTemplate, error) {
+	const query = `SELECT ` + templateColumns + ` FROM queue_templates
+WHERE NOT paused AND next_run_at - make_interval(mins => publish_before_minutes) <= $1 ORDER BY next_run_at`
+
+	return s.queryTemplates(ctx, query, now)
+}
+
+func (s *Storage) queryTemplates(ctx context.Context, query string, args ...any) ([]models.QueueTemplate, error) {
+	rows, err := s.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: list templates: %w", err)
+	}
+	defer rows.Close()
+
+	var templates []models.QueueTemplate
+	for rows.Next() {
+		t, err := scanTemplate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("postgres: scan template: %w", err)
+		}
+		templates = append(templates, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres: rows error: %w", err)
+	}
+	return templates, nil
+}
+
+func (s *Storage) UpdateTemplate(ctx context.Context, t models.QueueTemplate) (models.QueueTemplate, error) {
+	const query = `UPDATE queue_templates SET title = $1, description = $2, mode = $3, group_code = $4, weekdays = $5,
+start_minute = $6, timezone = $7, publish_before_minutes = $8, duration_minutes = $9, slot_minutes = $10,
+slot_capacity = $11, lottery_after_minutes = $12, auto_archive = $13, paused = $14, next_run_at = $15, updated_at = NOW()
+WHERE id = $16 RETURNING ` + templateColumns
+
+	saved, err := scanTemplate(s.pool.QueryRow(ctx, query, append(templateArgs(t), t.ID)...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return models.QueueTemplate{}, storage.ErrTemplateNotFound
+		}
+		return models.QueueTemplate{}, fmt.Errorf("postgres: update template: %w", err)
+	}
+	return saved, nil
+}
+
+func (s *Storage) DeleteTemplate(ctx context.Context, templateID int64) error {
+	cmd, err := s.pool.Exec(ctx, `DELETE FROM queue_templates WHERE id = $1`, templateID)
+	if err != nil {
+		return fmt.Errorf("postgres: delete template: %w", err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return storage.ErrTemplateNotFound
+	}
+	return nil
+}
+
+// InstantiateTemplate creates the next queue of the template, with its slot
+// schedule if one is given, queues the "queue opened" notification if it is
+// open right away, archives the previous instance when the template
+// asks for it and moves the template on to nextRunAt. It fails with
+// ErrTemplateNotFound if the template was changed or removed meanwhile.
+func (s *Storage) InstantiateTemplate(ctx context.Context, t models.QueueTemplate, q models.Queue, slots *models.SlotSchedule, nextRunAt time.Time) (models.Queue, error) {
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return models.Queue{}, fmt.Errorf("postgres: begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q, err = insertQueue(ctx, tx, q)
+	if err != nil {
+		return models.Queue{}, err
+	}
+
+	if q.Status == models.StatusActive {
+		if err := enqueueQueueOpened(ctx, tx, q); err != nil {
+			return models.Queue{}, err
+		}
+	}
+
+	if slots != nil {
+		slots.QueueID = q.ID
+		if _, err := saveSlotSchedule(ctx, tx, *slots, q.OwnerID); err != nil {
+			return models.Queue{}, err
+		}
+	}
+
+	if t.AutoArchive && t.LastQueueID != 0 {
+		cmd, err := tx.Exec(ctx, `UPDATE queues SET status = 'archived', updated_at = NOW() WHERE id = $1 AND status <> 'archived'`, t.LastQueueID)
+		if err != nil {
+			return models.Queue{}, fmt.Errorf("postgres: archive previous instance: %w", err)
+		}
+		if cmd.RowsAffected() > 0 {
+			if err := appendEvent(ctx, tx, models.QueueEvent{QueueID: t.LastQueueID, Type: models.EventArchived}); err != nil {
+				return models.Queue{}, err
+			}
+		}
+	}
+
+	if err := advanceTemplate(ctx, tx, t, q.ID, nextRunAt); err != nil {
+		return models.Queue{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return models.Queue{}, fmt.Errorf("postgres: commit: %w", err)
+	}
+	return q, nil
+}
+
+// SkipTemplateRun moves the template on to nextRunAt without creating a queue.
+func (s *Storage) SkipTemplateRun(ctx context.Context, t models.QueueTemplate, nextRunAt time.Time) error {
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("postgres: begin tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := advanceTemplate(ctx, tx, t, t.LastQueueID, nextRunAt); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("postgres: commit: %w", err)
+	}
+	return nil
+}
+
+// advanceTemplate only succeeds if the template still waits for the run it was loaded with.
+func advanceTemplate(ctx context.Context, tx pgx.Tx, t models.QueueTemplate, lastQueueID int64, nextRunAt time.Time) error {
+	const query = `UPDATE queue_templates SET last_queue_id = NULLIF($1, 0), next_run_at = $2
+WHERE id = $3 AND next_run_at = $4 AND NOT paused`
+
+	cmd, err := tx.Exec(ctx, query, lastQueueID, nextRunAt, t.ID, t.NextRunAt)
+	if err != nil {
+		return fmt.Errorf("postgres: advance template: %w", err)
+	}
+	if cmd.RowsAffected() == 0 {
+		return storage.ErrTemplateNotFound
+	}
+	return nil
+}
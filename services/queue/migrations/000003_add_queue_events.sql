@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Append-only audit trail. No FK to queues: the log must outlive a deleted queue.
+CREATE TABLE IF NOT EXISTS queue_events (
+    id BIGSERIAL PRIMARY KEY,
+    queue_id BIGINT NOT NULL,
+    type TEXT NOT NULL,
+    actor_id BIGINT NOT NULL,
+    user_id BIGINT,
+    position INT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_queue_events_queue_id ON queue_events(queue_id, id DESC);
+CREATE INDEX IF NOT EXISTS idx_queue_events_queue_actor ON queue_events(queue_id, actor_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS queue_events;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Participants who left the queue, with the reason. Rows are moved here from
+-- queue_participants instead of being deleted.
+CREATE TABLE IF NOT EXISTS queue_history (
+    id BIGSERIAL PRIMARY KEY,
+    queue_id BIGINT NOT NULL REFERENCES queues(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    full_name TEXT NOT NULL DEFAULT '',
+    position INT NOT NULL,
+    slot_time TIMESTAMPTZ,
+    outcome TEXT NOT NULL,
+    actor_id BIGINT NOT NULL,
+    joined_at TIMESTAMPTZ NOT NULL,
+    finished_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_queue_history_queue_id ON queue_history(queue_id, id DESC);
+CREATE INDEX IF NOT EXISTS idx_queue_history_user ON queue_history(user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS queue_history;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Per-queue roles. The creator (queues.owner_id) always has an owner row;
+-- further owners are co-owners.
+CREATE TABLE IF NOT EXISTS queue_members (
+    queue_id BIGINT NOT NULL REFERENCES queues(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    role TEXT NOT NULL CHECK (role IN ('owner', 'moderator', 'viewer')),
+    granted_by BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (queue_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_queue_members_user ON queue_members(user_id);
+
+INSERT INTO queue_members (queue_id, user_id, role, granted_by)
+SELECT id, owner_id, 'owner', owner_id FROM queues
+ON CONFLICT DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS queue_members;
+-- +goose StatementEnd
@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS groups (
+    id BIGSERIAL PRIMARY KEY,
+    code TEXT NOT NULL UNIQUE,
+    name TEXT NOT NULL,
+    owner_id BIGINT NOT NULL,
+    invite_code TEXT NOT NULL UNIQUE,
+    require_approval BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Only active members see the group's queues; pending ones wait for a group admin.
+CREATE TABLE IF NOT EXISTS group_members (
+    group_id BIGINT NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    full_name TEXT NOT NULL DEFAULT '',
+    role TEXT NOT NULL CHECK (role IN ('admin', 'member')),
+    status TEXT NOT NULL CHECK (status IN ('pending', 'active')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (group_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_group_members_user ON group_members(user_id);
+
+-- Turn the free-text group codes already in use into groups, so current
+-- queue owners and participants keep their access.
+INSERT INTO groups (code, name, owner_id, invite_code)
+SELECT DISTINCT ON (group_code) group_code, group_code, owner_id, md5(random()::text || group_code)
+FROM queues ORDER BY group_code, created_at;
+
+INSERT INTO group_members (group_id, user_id, role, status)
+SELECT DISTINCT g.id, q.owner_id, 'admin', 'active'
+FROM queues q JOIN groups g ON g.code = q.group_code
+ON CONFLICT DO NOTHING;
+
+INSERT INTO group_members (group_id, user_id, full_name, role, status)
+SELECT DISTINCT ON (g.id, p.user_id) g.id, p.user_id, p.full_name, 'member', 'active'
+FROM queue_participants p JOIN queues q ON q.id = p.queue_id JOIN groups g ON g.code = q.group_code
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE queues ADD CONSTRAINT fk_queues_group_code
+    FOREIGN KEY (group_code) REFERENCES groups(code) ON UPDATE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE queues DROP CONSTRAINT IF EXISTS fk_queues_group_code;
+DROP TABLE IF EXISTS group_members;
+DROP TABLE IF EXISTS groups;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+ALTER TYPE queue_status ADD VALUE IF NOT EXISTS 'scheduled' BEFORE 'active';
+ALTER TYPE queue_status ADD VALUE IF NOT EXISTS 'closed' AFTER 'active';
+
+-- +goose StatementBegin
+-- A queue is scheduled until opens_at and closed after closes_at; the
+-- scheduler flips the status, both bounds are optional.
+ALTER TABLE queues
+    ADD COLUMN IF NOT EXISTS opens_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS closes_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_queues_opens_at ON queues(opens_at) WHERE status = 'scheduled';
+CREATE INDEX IF NOT EXISTS idx_queues_closes_at ON queues(closes_at) WHERE status = 'active';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Enum values cannot be dropped; scheduled and closed queues fall back to the old statuses.
+UPDATE queues SET status = 'active' WHERE status = 'scheduled';
+UPDATE queues SET status = 'archived' WHERE status = 'closed';
+DROP INDEX IF EXISTS idx_queues_closes_at;
+DROP INDEX IF EXISTS idx_queues_opens_at;
+ALTER TABLE queues DROP COLUMN IF EXISTS closes_at, DROP COLUMN IF EXISTS opens_at;
+-- +goose StatementEnd
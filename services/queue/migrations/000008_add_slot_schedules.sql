@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Office hours of a slots queue: [starts_at, ends_at) cut into slots of
+-- slot_minutes, each taking up to capacity participants.
+CREATE TABLE IF NOT EXISTS queue_slot_schedules (
+    queue_id BIGINT PRIMARY KEY REFERENCES queues(id) ON DELETE CASCADE,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    slot_minutes INT NOT NULL CHECK (slot_minutes > 0),
+    capacity INT NOT NULL CHECK (capacity > 0),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (ends_at > starts_at)
+);
+
+-- Slots overlapping a break are not offered.
+CREATE TABLE IF NOT EXISTS queue_slot_breaks (
+    id BIGSERIAL PRIMARY KEY,
+    queue_id BIGINT NOT NULL REFERENCES queue_slot_schedules(queue_id) ON DELETE CASCADE,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_queue_slot_breaks_queue ON queue_slot_breaks(queue_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS queue_slot_breaks;
+DROP TABLE IF EXISTS queue_slot_schedules;
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A template recreates the same queue every week: on each of weekdays
+-- (0 = Sunday) at start_minute after midnight in timezone. The queue is
+-- published publish_before_minutes ahead and stays open for duration_minutes
+-- (0 = until closed by hand).
+CREATE TABLE IF NOT EXISTS queue_templates (
+    id BIGSERIAL PRIMARY KEY,
+    title TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    mode queue_mode NOT NULL,
+    group_code TEXT NOT NULL REFERENCES groups(code) ON UPDATE CASCADE ON DELETE CASCADE,
+    owner_id BIGINT NOT NULL,
+    weekdays SMALLINT[] NOT NULL,
+    start_minute INT NOT NULL CHECK (start_minute >= 0 AND start_minute < 1440),
+    timezone TEXT NOT NULL DEFAULT 'UTC',
+    publish_before_minutes INT NOT NULL DEFAULT 0,
+    duration_minutes INT NOT NULL DEFAULT 0,
+    slot_minutes INT NOT NULL DEFAULT 0,
+    slot_capacity INT NOT NULL DEFAULT 0,
+    auto_archive BOOLEAN NOT NULL DEFAULT FALSE,
+    paused BOOLEAN NOT NULL DEFAULT FALSE,
+    last_queue_id BIGINT REFERENCES queues(id) ON DELETE SET NULL,
+    next_run_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_queue_templates_group ON queue_templates(group_code);
+CREATE INDEX IF NOT EXISTS idx_queue_templates_next_run ON queue_templates(next_run_at) WHERE NOT paused;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS queue_templates;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE queues ADD COLUMN IF NOT EXISTS notify_top INT NOT NULL DEFAULT 3;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE queues DROP COLUMN IF EXISTS notify_top;
+-- +goose StatementEnd
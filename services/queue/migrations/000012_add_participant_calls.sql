@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- call_timeout_seconds = 0 turns calls off: advancing serves the head at once.
+ALTER TABLE queues ADD COLUMN IF NOT EXISTS call_timeout_seconds INT NOT NULL DEFAULT 0;
+-- How many places a participant who missed the call is moved back; 0 skips them.
+ALTER TABLE queues ADD COLUMN IF NOT EXISTS call_miss_shift INT NOT NULL DEFAULT 0;
+
+ALTER TABLE queue_participants ADD COLUMN IF NOT EXISTS called_at TIMESTAMPTZ;
+ALTER TABLE queue_participants ADD COLUMN IF NOT EXISTS checked_in_at TIMESTAMPTZ;
+ALTER TABLE queue_participants ADD COLUMN IF NOT EXISTS missed_calls INT NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_participants_called ON queue_participants(called_at)
+    WHERE called_at IS NOT NULL AND checked_in_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_participants_called;
+ALTER TABLE queue_participants DROP COLUMN IF EXISTS missed_calls;
+ALTER TABLE queue_participants DROP COLUMN IF EXISTS checked_in_at;
+ALTER TABLE queue_participants DROP COLUMN IF EXISTS called_at;
+ALTER TABLE queues DROP COLUMN IF EXISTS call_miss_shift;
+ALTER TABLE queues DROP COLUMN IF EXISTS call_timeout_seconds;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE queues ADD COLUMN IF NOT EXISTS swaps_allowed BOOLEAN NOT NULL DEFAULT TRUE;
+
+-- A participant asks another one to swap places; the places are exchanged
+-- only when the other side accepts before expires_at.
+CREATE TABLE IF NOT EXISTS swap_requests (
+    id BIGSERIAL PRIMARY KEY,
+    queue_id BIGINT NOT NULL REFERENCES queues(id) ON DELETE CASCADE,
+    from_user_id BIGINT NOT NULL,
+    to_user_id BIGINT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    answered_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_swap_requests_pending ON swap_requests(queue_id, from_user_id, to_user_id)
+    WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_swap_requests_expires ON swap_requests(expires_at) WHERE status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS swap_requests;
+ALTER TABLE queues DROP COLUMN IF EXISTS swaps_allowed;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- How many times the participant let others go first; limited per queue entry.
+ALTER TABLE queue_participants ADD COLUMN IF NOT EXISTS deferrals INT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE queue_participants DROP COLUMN IF EXISTS deferrals;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A random queue collects registrations until lottery_at, then its order is
+-- drawn once with lottery_seed and frozen from drawn_at on.
+ALTER TABLE queues ADD COLUMN IF NOT EXISTS lottery_at TIMESTAMPTZ;
+ALTER TABLE queues ADD COLUMN IF NOT EXISTS lottery_seed TEXT NOT NULL DEFAULT '';
+ALTER TABLE queues ADD COLUMN IF NOT EXISTS drawn_at TIMESTAMPTZ;
+
+-- Existing random queues were shuffled on every join; keep their current order.
+UPDATE queues SET drawn_at = NOW() WHERE mode = 'random';
+
+CREATE INDEX IF NOT EXISTS idx_queues_lottery ON queues(lottery_at) WHERE drawn_at IS NULL AND lottery_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_queues_lottery;
+ALTER TABLE queues DROP COLUMN IF EXISTS drawn_at;
+ALTER TABLE queues DROP COLUMN IF EXISTS lottery_seed;
+ALTER TABLE queues DROP COLUMN IF EXISTS lottery_at;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+ALTER TYPE queue_mode ADD VALUE IF NOT EXISTS 'priority';
+
+-- +goose StatementBegin
+-- Set by the queue owner; in priority mode higher levels go first and the
+-- order of joining decides within a level.
+ALTER TABLE queue_participants ADD COLUMN IF NOT EXISTS priority INT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Enum values cannot be dropped; priority queues become live ones.
+UPDATE queues SET mode = 'live' WHERE mode = 'priority';
+UPDATE queue_templates SET mode = 'live' WHERE mode = 'priority';
+ALTER TABLE queue_participants DROP COLUMN IF EXISTS priority;
+-- +goose StatementEnd
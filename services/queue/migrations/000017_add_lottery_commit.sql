@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Random queues get their seed at creation; only its SHA-256 is shown until
+-- the draw, so the owner cannot pick a seed after seeing who joined.
+ALTER TABLE queues ADD COLUMN IF NOT EXISTS lottery_commit TEXT NOT NULL DEFAULT '';
+-- Minutes after opening when queues of a random template draw; 0 draws at closing.
+ALTER TABLE queue_templates ADD COLUMN IF NOT EXISTS lottery_after_minutes INT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE queue_templates DROP COLUMN IF EXISTS lottery_after_minutes;
+ALTER TABLE queues DROP COLUMN IF EXISTS lottery_commit;
+-- +goose StatementEnd